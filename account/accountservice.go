@@ -2,6 +2,7 @@ package account
 
 import (
 	"sync"
+	"time"
 
 	"github.com/aergoio/aergo-actor/actor"
 	"github.com/aergoio/aergo-lib/log"
@@ -67,7 +68,15 @@ func (as *AccountService) resolveName(namedAddress []byte) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	return name.GetAddress(scs, namedAddress), nil
+	rsp, err := as.RequestToFuture(message.ChainSvc, &message.GetBestBlock{}, time.Second).Result()
+	if err != nil {
+		return nil, err
+	}
+	best := rsp.(message.GetBestBlockRsp)
+	if best.Err != nil {
+		return nil, best.Err
+	}
+	return name.GetAddress(scs, namedAddress, best.Block.BlockNo()+1), nil
 }
 
 func (as *AccountService) Receive(context actor.Context) {
@@ -12,6 +12,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sync"
 	"sync/atomic"
 
 	"github.com/aergoio/aergo-lib/db"
@@ -54,6 +55,9 @@ var (
 	raftConfChangeProgressPrefix = []byte("r_ccstatus.")
 
 	hardforkKey = []byte("hardfork")
+
+	contractSourcePrefix = []byte("contract_source.")
+	scheduledTxPrefix    = []byte("scheduled_tx.")
 )
 
 // ErrNoBlock reports there is no such a block with id (hash or block number).
@@ -81,6 +85,8 @@ type ChainDB struct {
 	bestBlock atomic.Value // *types.Block
 	//	blocks []*types.Block
 	store db.DB
+
+	sourceLock sync.Mutex
 }
 
 func NewChainDB() *ChainDB {
@@ -725,6 +731,50 @@ func (cdb *ChainDB) writeReceipts(blockHash []byte, blockNo types.BlockNo, recei
 	dbTx.Commit()
 }
 
+// writeScheduledTxIdx indexes the receipts of the scheduled contract calls,
+// which follow the ones of the nTxs transactions of the block.
+func (cdb *ChainDB) writeScheduledTxIdx(blockHash []byte, nTxs int, receipts *types.Receipts) {
+	rs := receipts.Get()
+	if len(rs) <= nTxs {
+		return
+	}
+	dbTx := cdb.store.NewTx()
+	defer dbTx.Discard()
+
+	for i := nTxs; i < len(rs); i++ {
+		txIdx := types.TxIdx{
+			BlockHash: blockHash,
+			Idx:       int32(i),
+		}
+		val, err := proto.Marshal(&txIdx)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to marshal scheduled tx index")
+			return
+		}
+		dbTx.Set(scheduledTxKey(rs[i].TxHash), val)
+	}
+
+	dbTx.Commit()
+}
+
+func (cdb *ChainDB) getScheduledTxIdx(txHash []byte) (*types.TxIdx, error) {
+	data := cdb.store.Get(scheduledTxKey(txHash))
+	if len(data) == 0 {
+		return nil, fmt.Errorf("scheduled tx not found: txHash=%v", enc.ToString(txHash))
+	}
+	txIdx := &types.TxIdx{}
+	if err := proto.Unmarshal(data, txIdx); err != nil {
+		return nil, err
+	}
+	return txIdx, nil
+}
+
+func scheduledTxKey(txHash []byte) []byte {
+	key := make([]byte, 0, len(scheduledTxPrefix)+len(txHash))
+	key = append(key, scheduledTxPrefix...)
+	return append(key, txHash...)
+}
+
 func (cdb *ChainDB) deleteReceipts(dbTx *db.Transaction, blockHash []byte, blockNo types.BlockNo) {
 	(*dbTx).Delete(receiptsKey(blockHash, blockNo))
 }
@@ -739,6 +789,43 @@ func receiptsKey(blockHash []byte, blockNo types.BlockNo) []byte {
 	return key.Bytes()
 }
 
+// writeContractSource keeps the source verified first for the deployed code;
+// it is replaced only after the contract has been redeployed.
+func (cdb *ChainDB) writeContractSource(source *types.ContractSource) error {
+	cdb.sourceLock.Lock()
+	defer cdb.sourceLock.Unlock()
+
+	if prev, err := cdb.getContractSource(source.GetContractAddress()); err == nil &&
+		bytes.Equal(prev.GetCodeHash(), source.GetCodeHash()) {
+		return types.ErrSourceAlreadyVerified
+	}
+	val, err := proto.Marshal(source)
+	if err != nil {
+		return err
+	}
+	cdb.store.Set(contractSourceKey(source.GetContractAddress()), val)
+	return nil
+}
+
+func (cdb *ChainDB) getContractSource(address []byte) (*types.ContractSource, error) {
+	data := cdb.store.Get(contractSourceKey(address))
+	if len(data) == 0 {
+		return nil, types.ErrSourceNotVerified
+	}
+	var source types.ContractSource
+	if err := proto.Unmarshal(data, &source); err != nil {
+		return nil, err
+	}
+	return &source, nil
+}
+
+func contractSourceKey(address []byte) []byte {
+	var key bytes.Buffer
+	key.Write(contractSourcePrefix)
+	key.Write(address)
+	return key.Bytes()
+}
+
 func (cdb *ChainDB) writeReorgMarker(marker *ReorgMarker) error {
 	dbTx := cdb.store.NewTx()
 	defer dbTx.Discard()
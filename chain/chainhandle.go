@@ -116,6 +116,9 @@ func (cs *ChainService) getTx(txHash []byte) (*types.Tx, *types.TxIdx, error) {
 func (cs *ChainService) getReceipt(txHash []byte) (*types.Receipt, error) {
 	tx, i, err := cs.cdb.getTx(txHash)
 	if err != nil {
+		if r, sErr := cs.getScheduledReceipt(txHash); sErr == nil {
+			return r, nil
+		}
 		return nil, err
 	}
 
@@ -135,8 +138,34 @@ func (cs *ChainService) getReceipt(txHash []byte) (*types.Receipt, error) {
 	return r, nil
 }
 
+// getScheduledReceipt returns the receipt of a scheduled contract call, which
+// is attributed to a synthetic tx hash not included in any block.
+func (cs *ChainService) getScheduledReceipt(txHash []byte) (*types.Receipt, error) {
+	i, err := cs.cdb.getScheduledTxIdx(txHash)
+	if err != nil {
+		return nil, err
+	}
+	block, err := cs.cdb.getBlock(i.BlockHash)
+	if err != nil {
+		return nil, err
+	}
+	blockInMainChain, err := cs.cdb.GetBlockByNo(block.Header.BlockNo)
+	if err != nil || !bytes.Equal(block.BlockHash(), blockInMainChain.BlockHash()) {
+		return nil, errors.New("cannot find a receipt")
+	}
+
+	r, err := cs.cdb.getReceipt(block.BlockHash(), block.GetHeader().BlockNo, i.Idx, cs.cfg.Hardfork)
+	if err != nil {
+		return r, err
+	}
+	r.ContractAddress = types.AddressOrigin(r.ContractAddress)
+	r.From = r.ContractAddress
+	r.To = r.ContractAddress
+	return r, nil
+}
+
 func (cs *ChainService) getEvents(events *[]*types.Event, blkNo types.BlockNo, filter *types.FilterInfo,
-	argFilter []types.ArgFilter) uint64 {
+	argFilter []types.ArgFilter, indexed []types.IndexedFilter) uint64 {
 	blkHash, err := cs.cdb.getHashByNo(blkNo)
 	if err != nil {
 		return 0
@@ -145,16 +174,16 @@ func (cs *ChainService) getEvents(events *[]*types.Event, blkNo types.BlockNo, f
 	if err != nil {
 		return 0
 	}
-	if receipts.BloomFilter(filter) == false {
+	if receipts.BloomFilter(filter, indexed) == false {
 		return 0
 	}
 	var totalSize uint64
 	for idx, r := range receipts.Get() {
-		if r.BloomFilter(filter) == false {
+		if r.BloomFilter(filter, indexed) == false {
 			continue
 		}
 		for _, e := range r.Events {
-			if e.Filter(filter, argFilter) {
+			if e.Filter(filter, argFilter, indexed) {
 				e.SetMemoryInfo(r, blkHash, blkNo, int32(idx))
 				*events = append(*events, e)
 				totalSize += uint64(proto.Size(e))
@@ -190,18 +219,22 @@ func (cs *ChainService) listEvents(filter *types.FilterInfo) ([]*types.Event, er
 	if err != nil {
 		return nil, err
 	}
+	indexed, err := filter.GetExIndexedFilter()
+	if err != nil {
+		return nil, err
+	}
 	events := []*types.Event{}
 	var totalSize uint64
 	if filter.Desc {
 		for i := to; i >= from && i != 0; i-- {
-			totalSize += cs.getEvents(&events, types.BlockNo(i), filter, argFilter)
+			totalSize += cs.getEvents(&events, types.BlockNo(i), filter, argFilter, indexed)
 			if totalSize > MaxEventSize {
 				return nil, errors.New(fmt.Sprintf("too large size of event (%v)", totalSize))
 			}
 		}
 	} else {
 		for i := from; i <= to; i++ {
-			totalSize += cs.getEvents(&events, types.BlockNo(i), filter, argFilter)
+			totalSize += cs.getEvents(&events, types.BlockNo(i), filter, argFilter, indexed)
 			if totalSize > MaxEventSize {
 				return nil, errors.New(fmt.Sprintf("too large size of event (%v)", totalSize))
 			}
@@ -560,6 +593,7 @@ type blockExecutor struct {
 	*state.BlockState
 	sdb              *state.ChainStateDB
 	execTx           TxExecFn
+	execScheduled    ScheduledExecFn
 	txs              []*types.Tx
 	validatePost     ValidatePostFn
 	coinbaseAcccount []byte
@@ -571,6 +605,7 @@ type blockExecutor struct {
 
 func newBlockExecutor(cs *ChainService, bState *state.BlockState, block *types.Block, verifyOnly bool) (*blockExecutor, error) {
 	var exec TxExecFn
+	var execScheduled ScheduledExecFn
 	var validateSignWait ValidateSignWaitFn
 	var bi *types.BlockHeaderInfo
 
@@ -591,6 +626,7 @@ func newBlockExecutor(cs *ChainService, bState *state.BlockState, block *types.B
 		)
 		bi = types.NewBlockHeaderInfo(block)
 		exec = NewTxExecutor(cs.ChainConsensus, cs.cdb, bi, contract.ChainService)
+		execScheduled = NewScheduledExecutor(cs.cdb, bi, contract.ChainService)
 
 		validateSignWait = func() error {
 			return cs.validator.WaitVerifyDone()
@@ -608,6 +644,7 @@ func newBlockExecutor(cs *ChainService, bState *state.BlockState, block *types.B
 		BlockState:       bState,
 		sdb:              cs.sdb,
 		execTx:           exec,
+		execScheduled:    execScheduled,
 		txs:              block.GetBody().GetTxs(),
 		coinbaseAcccount: block.GetHeader().GetCoinbaseAccount(),
 		validatePost: func() error {
@@ -650,6 +687,10 @@ func (e *blockExecutor) execute() error {
 	// Receipt must be committed unconditionally.
 	if !e.commitOnly {
 		defer contract.CloseDatabase()
+		scheduled, err := e.execScheduled(e.BlockState)
+		if err != nil {
+			return err
+		}
 		var preLoadTx *types.Tx
 		nCand := len(e.txs)
 		for i, tx := range e.txs {
@@ -664,6 +705,11 @@ func (e *blockExecutor) execute() error {
 			}
 			contract.SetPreloadTx(preLoadTx, contract.ChainService)
 		}
+		for _, r := range scheduled {
+			if err := e.AddReceipt(r); err != nil {
+				return err
+			}
+		}
 
 		if e.validateSignWait != nil {
 			if err := e.validateSignWait(); err != nil {
@@ -746,6 +792,7 @@ func (cs *ChainService) executeBlock(bstate *state.BlockState, block *types.Bloc
 
 	if len(ex.BlockState.Receipts().Get()) != 0 {
 		cs.cdb.writeReceipts(block.BlockHash(), block.BlockNo(), ex.BlockState.Receipts())
+		cs.cdb.writeScheduledTxIdx(block.BlockHash(), len(ex.txs), ex.BlockState.Receipts())
 	}
 
 	cs.notifyEvents(block, ex.BlockState)
@@ -892,7 +939,7 @@ func executeTx(
 		err       error
 	)
 
-	if account, err = name.Resolve(bs, txBody.GetAccount(), isQuirkTx); err != nil {
+	if account, err = name.Resolve(bs, txBody.GetAccount(), isQuirkTx, bi.No); err != nil {
 		return err
 	}
 
@@ -904,7 +951,7 @@ func executeTx(
 		}
 	}
 
-	err = tx.Validate(bi.ChainIdHash(), IsPublic())
+	err = tx.Validate(bi.ChainIdHash(), IsPublic(), bi.Version)
 	if err != nil {
 		return err
 	}
@@ -919,7 +966,7 @@ func executeTx(
 		return err
 	}
 
-	if recipient, err = name.Resolve(bs, txBody.Recipient, isQuirkTx); err != nil {
+	if recipient, err = name.Resolve(bs, txBody.Recipient, isQuirkTx, bi.No); err != nil {
 		return err
 	}
 	var receiver *state.V
@@ -940,6 +987,7 @@ func executeTx(
 
 	var txFee *big.Int
 	var rv string
+	var revert *contract.RevertError
 	var events []*types.Event
 	switch txBody.Type {
 	case types.TxType_NORMAL, types.TxType_REDEPLOY, types.TxType_TRANSFER, types.TxType_CALL, types.TxType_DEPLOY:
@@ -1000,6 +1048,7 @@ func executeTx(
 		}
 		status = "ERROR"
 		rv = err.Error()
+		revert = contract.GetRevertError(err)
 	} else {
 		if txBody.Type != types.TxType_FEEDELEGATION {
 			if sender.Balance().Sign() < 0 {
@@ -1031,6 +1080,11 @@ func executeTx(
 	receipt.Events = events
 	receipt.FeeDelegation = txBody.Type == types.TxType_FEEDELEGATION
 	receipt.GasUsed = contract.GasUsed(txFee, bs.GasPrice, txBody.Type, bi.Version)
+	if revert != nil {
+		receipt.RevertCode = revert.Code
+		receipt.RevertMessage = revert.Message
+		receipt.RevertData = revert.Data
+	}
 
 	return bs.AddReceipt(receipt)
 }
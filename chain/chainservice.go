@@ -6,6 +6,7 @@
 package chain
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"math/big"
@@ -15,7 +16,6 @@ import (
 	"sync/atomic"
 
 	"github.com/aergoio/aergo-actor/actor"
-	"github.com/aergoio/aergo-lib/log"
 	cfg "github.com/aergoio/aergo/config"
 	"github.com/aergoio/aergo/consensus"
 	"github.com/aergoio/aergo/contract"
@@ -24,6 +24,7 @@ import (
 	"github.com/aergoio/aergo/contract/system"
 	"github.com/aergoio/aergo/fee"
 	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/internal/loglevel"
 	"github.com/aergoio/aergo/message"
 	"github.com/aergoio/aergo/pkg/component"
 	"github.com/aergoio/aergo/state"
@@ -32,17 +33,32 @@ import (
 )
 
 var (
-	logger = log.NewLogger("chain")
+	logger = loglevel.NewLogger("chain")
 
 	dfltErrBlocks = 128
 
 	ErrNotSupportedConsensus = errors.New("not supported by this consensus")
 	ErrRecoNoBestStateRoot   = errors.New("state root of best block is not exist")
 	ErrRecoInvalidSdbRoot    = errors.New("state root of sdb is invalid")
+	ErrVerifySourceBusy      = errors.New("too many source verifications in progress")
 
 	TestDebugger *Debugger
+
+	// verifySourceSlots bounds the sources being compiled at the same time
+	verifySourceSlots = make(chan struct{}, maxVerifySource)
 )
 
+const maxVerifySource = 2
+
+func acquireVerifySource() bool {
+	select {
+	case verifySourceSlots <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
 // Core represents a storage layer of a blockchain (chain & state DB).
 type Core struct {
 	cdb *ChainDB
@@ -179,6 +195,7 @@ type IChainHandler interface {
 	getReceipt(txHash []byte) (*types.Receipt, error)
 	getAccountVote(addr []byte) (*types.AccountVoteInfo, error)
 	getVotes(id string, n uint32) (*types.VoteList, error)
+	listProposals() (*types.ProposalList, error)
 	getStaking(addr []byte) (*types.Staking, error)
 	getNameInfo(name string, blockNo types.BlockNo) (*types.NameInfo, error)
 	getEnterpriseConf(key string) (*types.EnterpriseConfig, error)
@@ -242,7 +259,7 @@ func NewChainService(cfg *cfg.Config) *ChainService {
 	var verifyMode = cs.cfg.Blockchain.VerifyOnly || cs.cfg.Blockchain.VerifyBlock != 0
 
 	cs.validator = NewBlockValidator(cs, cs.sdb, cs.cfg.Blockchain.VerifyBlock != 0)
-	cs.BaseComponent = component.NewBaseComponent(message.ChainSvc, cs, logger)
+	cs.BaseComponent = component.NewBaseComponent(message.ChainSvc, cs, logger.Logger)
 	cs.chainManager = newChainManager(cs, cs.Core)
 	cs.chainWorker = newChainWorker(cs, cs.cfg.Blockchain.NumWorkers, cs.Core)
 	// TODO set VerifyOnly true if cs.cfg.Blockchain.VerifyBlock is not 0
@@ -438,10 +455,16 @@ func (cs *ChainService) Receive(context actor.Context) {
 		*message.GetTx,
 		*message.GetReceipt,
 		*message.GetABI,
+		*message.GetCodeHistory,
+		*message.VerifyContractSource,
+		*message.GetContractSource,
+		*message.GetContractDBStats,
+		*message.QueryContractSQL,
 		*message.GetQuery,
 		*message.GetStateQuery,
 		*message.GetElected,
 		*message.GetVote,
+		*message.ListProposals,
 		*message.GetStaking,
 		*message.GetNameInfo,
 		*message.GetEnterpriseConf,
@@ -525,7 +548,7 @@ func (cs *ChainService) getAccountVote(addr []byte) (*types.AccountVoteInfo, err
 	if err != nil {
 		return nil, err
 	}
-	voteInfo, err := system.GetVotes(scs, name.GetAddress(namescs, addr))
+	voteInfo, err := system.GetVotes(scs, name.GetAddress(namescs, addr, cs.getBestBlockNo()+1))
 	if err != nil {
 		return nil, err
 	}
@@ -533,6 +556,19 @@ func (cs *ChainService) getAccountVote(addr []byte) (*types.AccountVoteInfo, err
 	return &types.AccountVoteInfo{Voting: voteInfo}, nil
 }
 
+func (cs *ChainService) listProposals() (*types.ProposalList, error) {
+	if cs.GetType() != consensus.ConsensusDPOS {
+		return nil, ErrNotSupportedConsensus
+	}
+
+	sdb := cs.sdb.OpenNewStateDB(cs.sdb.GetRoot())
+	scs, err := sdb.GetSystemAccountState()
+	if err != nil {
+		return nil, err
+	}
+	return system.GetProposals(scs)
+}
+
 func (cs *ChainService) getStaking(addr []byte) (*types.Staking, error) {
 	if cs.GetType() != consensus.ConsensusDPOS {
 		return nil, ErrNotSupportedConsensus
@@ -547,7 +583,7 @@ func (cs *ChainService) getStaking(addr []byte) (*types.Staking, error) {
 	if err != nil {
 		return nil, err
 	}
-	staking, err := system.GetStaking(scs, name.GetAddress(namescs, addr))
+	staking, err := system.GetStaking(scs, name.GetAddress(namescs, addr, cs.getBestBlockNo()+1))
 	if err != nil {
 		return nil, err
 	}
@@ -563,9 +599,11 @@ func (cs *ChainService) getNameInfo(qname string, blockNo types.BlockNo) (*types
 		}
 		stateDB = cs.sdb.OpenNewStateDB(block.GetHeader().GetBlocksRootHash())
 	} else {
+		blockNo = cs.getBestBlockNo()
 		stateDB = cs.sdb.OpenNewStateDB(cs.sdb.GetRoot())
 	}
-	return name.GetNameInfo(stateDB, qname)
+	// the state after blockNo is seen by the next block
+	return name.GetNameInfo(stateDB, qname, blockNo+1)
 }
 
 func (cs *ChainService) getEnterpriseConf(key string) (*types.EnterpriseConfig, error) {
@@ -686,14 +724,14 @@ func (cm *ChainManager) Receive(context actor.Context) {
 	}
 }
 
-func getAddressNameResolved(sdb *state.StateDB, account []byte) ([]byte, error) {
+func getAddressNameResolved(sdb *state.StateDB, account []byte, blockNo types.BlockNo) ([]byte, error) {
 	if len(account) == types.NameLength {
 		scs, err := sdb.OpenContractStateAccount(types.ToAccountID([]byte(types.AergoName)))
 		if err != nil {
 			logger.Error().Str("hash", enc.ToString(account)).Err(err).Msg("failed to get state for account")
 			return nil, err
 		}
-		return name.GetAddress(scs, account), nil
+		return name.GetAddress(scs, account, blockNo), nil
 	}
 	return account, nil
 }
@@ -722,7 +760,7 @@ func (cw *ChainWorker) Receive(context actor.Context) {
 		})
 	case *message.GetState:
 		sdb = cw.sdb.OpenNewStateDB(cw.sdb.GetRoot())
-		address, err := getAddressNameResolved(sdb, msg.Account)
+		address, err := getAddressNameResolved(sdb, msg.Account, cw.cdb.getBestBlockNo()+1)
 		if err != nil {
 			context.Respond(message.GetStateRsp{
 				Account: msg.Account,
@@ -743,7 +781,7 @@ func (cw *ChainWorker) Receive(context actor.Context) {
 		})
 	case *message.GetStateAndProof:
 		sdb = cw.sdb.OpenNewStateDB(cw.sdb.GetRoot())
-		address, err := getAddressNameResolved(sdb, msg.Account)
+		address, err := getAddressNameResolved(sdb, msg.Account, cw.cdb.getBestBlockNo()+1)
 		if err != nil {
 			context.Respond(message.GetStateAndProofRsp{
 				StateProof: nil,
@@ -776,7 +814,7 @@ func (cw *ChainWorker) Receive(context actor.Context) {
 		})
 	case *message.GetABI:
 		sdb = cw.sdb.OpenNewStateDB(cw.sdb.GetRoot())
-		address, err := getAddressNameResolved(sdb, msg.Contract)
+		address, err := getAddressNameResolved(sdb, msg.Contract, cw.cdb.getBestBlockNo()+1)
 		if err != nil {
 			context.Respond(message.GetABIRsp{
 				ABI: nil,
@@ -797,11 +835,128 @@ func (cw *ChainWorker) Receive(context actor.Context) {
 				Err: err,
 			})
 		}
+	case *message.GetCodeHistory:
+		sdb = cw.sdb.OpenNewStateDB(cw.sdb.GetRoot())
+		address, err := getAddressNameResolved(sdb, msg.Contract, cw.cdb.getBestBlockNo()+1)
+		if err != nil {
+			context.Respond(message.GetCodeHistoryRsp{
+				History: nil,
+				Err:     err,
+			})
+			break
+		}
+		contractState, err := sdb.OpenContractStateAccount(types.ToAccountID(address))
+		if err == nil {
+			history, err := contract.GetCodeHistory(contractState)
+			context.Respond(message.GetCodeHistoryRsp{
+				History: history,
+				Err:     err,
+			})
+		} else {
+			context.Respond(message.GetCodeHistoryRsp{
+				History: nil,
+				Err:     err,
+			})
+		}
+	case *message.VerifyContractSource:
+		sdb = cw.sdb.OpenNewStateDB(cw.sdb.GetRoot())
+		address, err := getAddressNameResolved(sdb, msg.Contract, cw.cdb.getBestBlockNo()+1)
+		if err != nil {
+			context.Respond(message.VerifyContractSourceRsp{Source: nil, Err: err})
+			break
+		}
+		contractState, err := sdb.OpenContractStateAccount(types.ToAccountID(address))
+		if err != nil {
+			context.Respond(message.VerifyContractSourceRsp{Source: nil, Err: err})
+			break
+		}
+		if prev, err := cw.cdb.getContractSource(address); err == nil &&
+			bytes.Equal(prev.GetCodeHash(), contractState.State.GetCodeHash()) {
+			context.Respond(message.VerifyContractSourceRsp{Source: nil, Err: types.ErrSourceAlreadyVerified})
+			break
+		}
+		if !acquireVerifySource() {
+			context.Respond(message.VerifyContractSourceRsp{Source: nil, Err: ErrVerifySourceBusy})
+			break
+		}
+		// compiling an arbitrary source must not hold up the chain worker
+		sender := context.Sender()
+		go func() {
+			defer func() { <-verifySourceSlots }()
+			source, err := contract.VerifySource(contractState, msg.Source)
+			if err == nil {
+				source.ContractAddress = address
+				err = cw.cdb.writeContractSource(source)
+			}
+			sender.Tell(message.VerifyContractSourceRsp{Source: source, Err: err})
+		}()
+	case *message.GetContractSource:
+		sdb = cw.sdb.OpenNewStateDB(cw.sdb.GetRoot())
+		address, err := getAddressNameResolved(sdb, msg.Contract, cw.cdb.getBestBlockNo()+1)
+		if err != nil {
+			context.Respond(message.GetContractSourceRsp{Source: nil, Err: err})
+			break
+		}
+		source, err := cw.cdb.getContractSource(address)
+		if err != nil {
+			context.Respond(message.GetContractSourceRsp{Source: nil, Err: err})
+			break
+		}
+		contractState, err := sdb.OpenContractStateAccount(types.ToAccountID(address))
+		if err != nil {
+			context.Respond(message.GetContractSourceRsp{Source: nil, Err: err})
+			break
+		}
+		// the verified source is stale once the contract is upgraded
+		if !bytes.Equal(source.GetCodeHash(), contractState.State.GetCodeHash()) {
+			source, err = nil, types.ErrSourceNotVerified
+		}
+		context.Respond(message.GetContractSourceRsp{Source: source, Err: err})
+	case *message.GetContractDBStats:
+		sdb = cw.sdb.OpenNewStateDB(cw.sdb.GetRoot())
+		address, err := getAddressNameResolved(sdb, msg.Contract, cw.cdb.getBestBlockNo()+1)
+		if err != nil {
+			context.Respond(message.GetContractDBStatsRsp{Stats: nil, Err: err})
+			break
+		}
+		contractState, err := sdb.OpenContractStateAccount(types.ToAccountID(address))
+		if err != nil {
+			context.Respond(message.GetContractDBStatsRsp{Stats: nil, Err: err})
+			break
+		}
+		stats, err := contract.GetDBStats(contractState)
+		if stats != nil {
+			stats.ContractAddress = address
+		}
+		context.Respond(message.GetContractDBStatsRsp{Stats: stats, Err: err})
+	case *message.QueryContractSQL:
+		if msg.BlockNo != 0 {
+			block, err := cw.cdb.GetBlockByNo(msg.BlockNo)
+			if err != nil {
+				context.Respond(message.QueryContractSQLRsp{Result: nil, Err: err})
+				break
+			}
+			sdb = cw.sdb.OpenNewStateDB(block.GetHeader().GetBlocksRootHash())
+		} else {
+			sdb = cw.sdb.OpenNewStateDB(cw.sdb.GetRoot())
+		}
+		address, err := getAddressNameResolved(sdb, msg.Contract, cw.cdb.getBestBlockNo()+1)
+		if err != nil {
+			context.Respond(message.QueryContractSQLRsp{Result: nil, Err: err})
+			break
+		}
+		contractState, err := sdb.OpenContractStateAccount(types.ToAccountID(address))
+		if err != nil {
+			context.Respond(message.QueryContractSQLRsp{Result: nil, Err: err})
+			break
+		}
+		result, err := contract.QuerySQL(contractState, msg.Query, msg.Limit)
+		context.Respond(message.QueryContractSQLRsp{Result: result, Err: err})
 	case *message.GetQuery:
 		runtime.LockOSThread()
 		defer runtime.UnlockOSThread()
 		sdb = cw.sdb.OpenNewStateDB(cw.sdb.GetRoot())
-		address, err := getAddressNameResolved(sdb, msg.Contract)
+		address, err := getAddressNameResolved(sdb, msg.Contract, cw.cdb.getBestBlockNo()+1)
 		if err != nil {
 			context.Respond(message.GetQueryRsp{Result: nil, Err: err})
 			break
@@ -821,7 +976,7 @@ func (cw *ChainWorker) Receive(context actor.Context) {
 		var err error
 
 		sdb = cw.sdb.OpenNewStateDB(cw.sdb.GetRoot())
-		address, err := getAddressNameResolved(sdb, msg.ContractAddress)
+		address, err := getAddressNameResolved(sdb, msg.ContractAddress, cw.cdb.getBestBlockNo()+1)
 		if err != nil {
 			context.Respond(message.GetStateQueryRsp{
 				Result: nil,
@@ -865,6 +1020,12 @@ func (cw *ChainWorker) Receive(context actor.Context) {
 			Info: info,
 			Err:  err,
 		})
+	case *message.ListProposals:
+		proposals, err := cw.listProposals()
+		context.Respond(&message.ListProposalsRsp{
+			Proposals: proposals,
+			Err:       err,
+		})
 	case *message.GetStaking:
 		staking, err := cw.getStaking(msg.Addr)
 		context.Respond(&message.GetStakingRsp{
@@ -0,0 +1,112 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package chain
+
+import (
+	"math/big"
+
+	"github.com/aergoio/aergo/contract"
+	"github.com/aergoio/aergo/contract/system"
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+)
+
+// ScheduledExecFn executes the contract calls scheduled at a block and
+// returns their receipts.
+type ScheduledExecFn func(bState *state.BlockState) ([]*types.Receipt, error)
+
+// NewScheduledExecutor returns a new ScheduledExecFn. The calls must be
+// executed before the transactions of the block, while their receipts must
+// be added after the ones of the transactions so that the receipt index of
+// each transaction is kept. The gas of the calls is bounded by
+// system.MaxScheduledBlockGas and, when a block is generated, their execution
+// time is taken from the one of the block.
+func NewScheduledExecutor(cdb contract.ChainAccessor, bi *types.BlockHeaderInfo, preLoadService int) ScheduledExecFn {
+	return func(bState *state.BlockState) ([]*types.Receipt, error) {
+		if bState == nil {
+			logger.Error().Msg("bstate is nil in scheduled exec")
+			return nil, ErrGatherChain
+		}
+		if bi.Version < 3 {
+			return nil, nil
+		}
+		return executeScheduledCalls(cdb, bState, bi, preLoadService)
+	}
+}
+
+func executeScheduledCalls(
+	cdb contract.ChainAccessor,
+	bs *state.BlockState,
+	bi *types.BlockHeaderInfo,
+	preLoadService int,
+) ([]*types.Receipt, error) {
+	sysAcc, err := bs.GetAccountStateV([]byte(types.AergoSystem))
+	if err != nil {
+		return nil, err
+	}
+	scs, err := bs.OpenContractState(sysAcc.AccountID(), sysAcc.State())
+	if err != nil {
+		return nil, err
+	}
+	calls, err := system.GetScheduledCalls(scs, bi.No)
+	if err != nil || len(calls) == 0 {
+		return nil, err
+	}
+
+	// withdraw all the prepaid fees from the system account before the
+	// calls, which may schedule other calls in turn
+	prepaid := new(big.Int)
+	for _, call := range calls {
+		prepaid.Add(prepaid, call.Fee)
+	}
+	if sysAcc.Balance().Cmp(prepaid) < 0 {
+		return nil, &types.InternalError{Reason: "prepaid fee is greater than balance"}
+	}
+	sysAcc.SubBalance(prepaid)
+	if err = system.RemoveScheduledCalls(scs, bi.No); err != nil {
+		return nil, err
+	}
+	if err = bs.StageContractState(scs); err != nil {
+		return nil, err
+	}
+	if err = sysAcc.PutState(); err != nil {
+		return nil, err
+	}
+
+	receipts := make([]*types.Receipt, 0, len(calls))
+	for i, call := range calls {
+		txHash := system.ScheduledTxHash(bi.No, i, call.Contract)
+		rv, events, usedFee, err := contract.ExecuteScheduledCall(bs, cdb, call, txHash, bi, preLoadService)
+		status := "SUCCESS"
+		var revert *contract.RevertError
+		if err != nil {
+			if !contract.IsRuntimeError(err) {
+				return nil, err
+			}
+			logger.Debug().Err(err).Str("hash", enc.ToString(txHash)).Msg("scheduled call failed")
+			status = "ERROR"
+			rv = err.Error()
+			revert = contract.GetRevertError(err)
+		} else {
+			rv = adjustRv(rv)
+		}
+		bs.BpReward.Add(&bs.BpReward, usedFee)
+
+		receipt := types.NewReceipt(call.Contract, status, rv)
+		receipt.FeeUsed = usedFee.Bytes()
+		receipt.TxHash = txHash
+		receipt.Events = events
+		receipt.GasUsed = contract.GasUsed(usedFee, bs.GasPrice, types.TxType_CALL, bi.Version)
+		if revert != nil {
+			receipt.RevertCode = revert.Code
+			receipt.RevertMessage = revert.Message
+			receipt.RevertData = revert.Data
+		}
+		receipts = append(receipts, receipt)
+	}
+	return receipts, nil
+}
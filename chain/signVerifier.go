@@ -33,6 +33,7 @@ type verifyWork struct {
 	idx        int
 	tx         *types.Tx
 	useMempool bool // not to use aop for performance
+	blockNo    types.BlockNo
 }
 
 type verifyWorkRes struct {
@@ -80,7 +81,7 @@ func (sv *SignVerifier) verifyTxLoop(workerNo int) {
 
 	for txWork := range sv.workCh {
 		//logger.Debug().Int("worker", workerNo).Int("idx", txWork.idx).Msg("get work to verify tx")
-		hit, err := sv.verifyTx(sv.comm, txWork.tx, txWork.useMempool)
+		hit, err := sv.verifyTx(sv.comm, txWork.tx, txWork.useMempool, txWork.blockNo)
 
 		if err != nil {
 			logger.Error().Int("worker", workerNo).Bool("hit", hit).Str("hash", enc.ToString(txWork.tx.GetHash())).
@@ -116,7 +117,7 @@ func (sv *SignVerifier) isExistInMempool(comm component.IComponentRequester, tx
 	return false, nil
 }
 
-func (sv *SignVerifier) verifyTx(comm component.IComponentRequester, tx *types.Tx, useMempool bool) (hit bool, err error) {
+func (sv *SignVerifier) verifyTx(comm component.IComponentRequester, tx *types.Tx, useMempool bool, blockNo types.BlockNo) (hit bool, err error) {
 	account := tx.GetBody().GetAccount()
 	if account == nil {
 		return false, ErrTxFormatInvalid
@@ -137,7 +138,7 @@ func (sv *SignVerifier) verifyTx(comm component.IComponentRequester, tx *types.T
 			logger.Error().Err(err).Msg("failed to get verify because of openning contract error")
 			return false, err
 		}
-		address := name.GetOwner(cs, tx.Body.Account)
+		address := name.GetOwner(cs, tx.Body.Account, blockNo)
 		err = key.VerifyTxWithAddress(tx, address)
 		if err != nil {
 			return false, err
@@ -151,7 +152,8 @@ func (sv *SignVerifier) verifyTx(comm component.IComponentRequester, tx *types.T
 	return false, nil
 }
 
-func (sv *SignVerifier) RequestVerifyTxs(txlist *types.TxList) {
+// RequestVerifyTxs verifies the txs of the block blockNo.
+func (sv *SignVerifier) RequestVerifyTxs(txlist *types.TxList, blockNo types.BlockNo) {
 	txs := txlist.GetTxs()
 	txLen := len(txs)
 
@@ -168,7 +170,7 @@ func (sv *SignVerifier) RequestVerifyTxs(txlist *types.TxList) {
 	go func() {
 		for i, tx := range txs {
 			//logger.Debug().Int("idx", i).Msg("push tx start")
-			sv.workCh <- verifyWork{idx: i, tx: tx, useMempool: useMempool}
+			sv.workCh <- verifyWork{idx: i, tx: tx, useMempool: useMempool, blockNo: blockNo}
 		}
 	}()
 
@@ -226,7 +228,7 @@ func (sv *SignVerifier) WaitDone() (bool, []error) {
 	}
 }
 
-func (sv *SignVerifier) verifyTxsInplace(txlist *types.TxList) (bool, []error) {
+func (sv *SignVerifier) verifyTxsInplace(txlist *types.TxList, blockNo types.BlockNo) (bool, []error) {
 	txs := txlist.GetTxs()
 	txLen := len(txs)
 	errs := make([]error, txLen, txLen)
@@ -236,7 +238,7 @@ func (sv *SignVerifier) verifyTxsInplace(txlist *types.TxList) (bool, []error) {
 	logger.Debug().Int("txlen", txLen).Msg("verify tx inplace start")
 
 	for i, tx := range txs {
-		hit, errs[i] = sv.verifyTx(sv.comm, tx, false)
+		hit, errs[i] = sv.verifyTx(sv.comm, tx, false, blockNo)
 		failed = true
 
 		if hit {
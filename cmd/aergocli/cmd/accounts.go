@@ -64,7 +64,17 @@ func init() {
 	unstakeCmd.MarkFlagRequired("amount")
 	unstakeCmd.Flags().StringVar(&pw, "password", "", "password (optional, will be asked on the terminal if not given)")
 
-	accountCmd.AddCommand(newCmd, listCmd, unlockCmd, lockCmd, importCmd, exportCmd, voteCmd, stakeCmd, unstakeCmd)
+	unbondCmd.Flags().StringVar(&address, "address", "", "account address")
+	unbondCmd.MarkFlagRequired("address")
+	unbondCmd.Flags().StringVar(&amount, "amount", "0", "amount to unbond")
+	unbondCmd.MarkFlagRequired("amount")
+	unbondCmd.Flags().StringVar(&pw, "password", "", "password (optional, will be asked on the terminal if not given)")
+
+	withdrawCmd.Flags().StringVar(&address, "address", "", "account address")
+	withdrawCmd.MarkFlagRequired("address")
+	withdrawCmd.Flags().StringVar(&pw, "password", "", "password (optional, will be asked on the terminal if not given)")
+
+	accountCmd.AddCommand(newCmd, listCmd, unlockCmd, lockCmd, importCmd, exportCmd, voteCmd, stakeCmd, unstakeCmd, unbondCmd, withdrawCmd)
 	rootCmd.AddCommand(accountCmd)
 }
 
@@ -29,6 +29,7 @@ var (
 	feeDelegation bool
 	contractID    string
 	gas           uint64
+	sqlLimit      uint32
 )
 
 func intListToString(ns []int, word string) string {
@@ -108,6 +109,15 @@ func init() {
 	stateQueryCmd.Flags().StringVar(&stateroot, "root", "", "Query the state at a specified state root")
 	stateQueryCmd.Flags().BoolVar(&compressed, "compressed", false, "Get a compressed proof for the state")
 
+	sqlCmd := &cobra.Command{
+		Use:   "sql [flags] <contractAddress> <query>",
+		Short: "Run a read-only sql query against the database of the contract",
+		Args:  cobra.ExactArgs(2),
+		RunE:  runQuerySQLCmd,
+	}
+	sqlCmd.Flags().Uint64VarP(&blockNo, "blockno", "n", 0, "Block height (default: best block)")
+	sqlCmd.Flags().Uint32Var(&sqlLimit, "limit", 0, "maximum number of rows (default: 100)")
+
 	contractCmd.AddCommand(
 		deployCmd,
 		callCmd,
@@ -117,6 +127,30 @@ func init() {
 			Args:  cobra.ExactArgs(1),
 			RunE:  runGetABICmd,
 		},
+		&cobra.Command{
+			Use:   "history [flags] <contractAddress>",
+			Short: "Get code history of an upgradeable contract",
+			Args:  cobra.ExactArgs(1),
+			RunE:  runGetCodeHistoryCmd,
+		},
+		&cobra.Command{
+			Use:   "verify [flags] <contractAddress> <srcfile>",
+			Short: "Verify that the lua source produces the deployed contract",
+			Args:  cobra.ExactArgs(2),
+			RunE:  runVerifySourceCmd,
+		},
+		&cobra.Command{
+			Use:   "source [flags] <contractAddress>",
+			Short: "Get the verified source of the contract",
+			Args:  cobra.ExactArgs(1),
+			RunE:  runGetSourceCmd,
+		},
+		&cobra.Command{
+			Use:   "dbstats [flags] <contractAddress>",
+			Short: "Get the size and the table row counts of the sql database of the contract",
+			Args:  cobra.ExactArgs(1),
+			RunE:  runGetDBStatsCmd,
+		},
 		&cobra.Command{
 			Use:   "query [flags] <contractAddress> <funcname> [args]",
 			Short: "Query contract by executing read-only function",
@@ -124,6 +158,7 @@ func init() {
 			RunE:  runQueryCmd,
 		},
 		stateQueryCmd,
+		sqlCmd,
 	)
 	rootCmd.AddCommand(contractCmd)
 }
@@ -251,9 +286,13 @@ func runCallCmd(cmd *cobra.Command, args []string) error {
 		if err != nil {
 			return fmt.Errorf("failed to get abi: %v", err.Error())
 		}
-		if !abi.HasFunction(args[2]) {
+		fn := abi.GetFunction(args[2])
+		if fn == nil {
 			return fmt.Errorf("function %v not found in contract at address %s", args[2], args[1])
 		}
+		if err := fn.ValidateArgs(ci.Args); err != nil {
+			return fmt.Errorf("invalid arguments: %v", err)
+		}
 	}
 
 	amountBigInt, err := util.ParseUnit(amount)
@@ -342,6 +381,91 @@ func runGetABICmd(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func runGetCodeHistoryCmd(cmd *cobra.Command, args []string) error {
+	cmd.SilenceUsage = true
+
+	contract, err := types.DecodeAddress(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to decode address: %v", err.Error())
+	}
+	history, err := client.GetCodeHistory(context.Background(), &types.SingleBytes{Value: contract})
+	if err != nil {
+		return fmt.Errorf("failed to get code history: %v", err.Error())
+	}
+	cmd.Println(util.JSON(history))
+	return nil
+}
+
+func runVerifySourceCmd(cmd *cobra.Command, args []string) error {
+	cmd.SilenceUsage = true
+
+	contract, err := types.DecodeAddress(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to decode address: %v", err.Error())
+	}
+	src, err := ioutil.ReadFile(args[1])
+	if err != nil {
+		return fmt.Errorf("failed to read source file: %v", err.Error())
+	}
+	source, err := client.VerifyContractSource(context.Background(),
+		&types.ContractSource{ContractAddress: contract, Source: string(src)})
+	if err != nil {
+		return fmt.Errorf("failed to verify source: %v", err.Error())
+	}
+	cmd.Println(util.JSON(source))
+	return nil
+}
+
+func runGetSourceCmd(cmd *cobra.Command, args []string) error {
+	cmd.SilenceUsage = true
+
+	contract, err := types.DecodeAddress(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to decode address: %v", err.Error())
+	}
+	source, err := client.GetContractSource(context.Background(), &types.SingleBytes{Value: contract})
+	if err != nil {
+		return fmt.Errorf("failed to get source: %v", err.Error())
+	}
+	cmd.Println(util.JSON(source))
+	return nil
+}
+
+func runGetDBStatsCmd(cmd *cobra.Command, args []string) error {
+	cmd.SilenceUsage = true
+
+	contract, err := types.DecodeAddress(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to decode address: %v", err.Error())
+	}
+	stats, err := client.GetContractDBStats(context.Background(), &types.SingleBytes{Value: contract})
+	if err != nil {
+		return fmt.Errorf("failed to get db stats: %v", err.Error())
+	}
+	cmd.Println(util.JSON(stats))
+	return nil
+}
+
+func runQuerySQLCmd(cmd *cobra.Command, args []string) error {
+	cmd.SilenceUsage = true
+
+	contract, err := types.DecodeAddress(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to decode address: %v", err.Error())
+	}
+	result, err := client.QueryContractSQL(context.Background(), &types.SQLQuery{
+		ContractAddress: contract,
+		Query:           args[1],
+		BlockNo:         blockNo,
+		Limit:           sqlLimit,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to query: %v", err.Error())
+	}
+	cmd.Println(util.JSON(result))
+	return nil
+}
+
 func runQueryCmd(cmd *cobra.Command, args []string) error {
 	cmd.SilenceUsage = true
 
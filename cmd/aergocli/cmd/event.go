@@ -17,6 +17,7 @@ import (
 var contractAddress string
 var eventName string
 var argFilter string
+var indexedFilter string
 var start uint64
 var end uint64
 var desc bool
@@ -40,6 +41,7 @@ func init() {
 	listCmd.Flags().StringVarP(&contractAddress, "address", "", "", "Contract Address")
 	listCmd.Flags().BoolVar(&desc, "desc", false, "descending order")
 	listCmd.Flags().StringVarP(&argFilter, "argfilter", "", "", "argument filter")
+	listCmd.Flags().StringVarP(&indexedFilter, "indexed", "", "", "indexed argument filter")
 	listCmd.Flags().Int32Var(&recentBlockCnt, "recent", 0, "recent block count")
 	listCmd.MarkFlagRequired("address")
 
@@ -52,6 +54,7 @@ func init() {
 	streamCmd.Flags().StringVarP(&contractAddress, "address", "", "", "Contract Address")
 	streamCmd.Flags().StringVarP(&eventName, "event", "", "", "Event Name")
 	streamCmd.Flags().StringVarP(&argFilter, "argfilter", "", "", "argument filter")
+	streamCmd.Flags().StringVarP(&indexedFilter, "indexed", "", "", "indexed argument filter")
 	streamCmd.MarkFlagRequired("address")
 
 	eventCmd.AddCommand(
@@ -73,6 +76,7 @@ func execListEvent(cmd *cobra.Command, args []string) {
 		EventName:       eventName,
 		Desc:            desc,
 		ArgFilter:       []byte(argFilter),
+		IndexedFilter:   []byte(indexedFilter),
 		RecentBlockCnt:  recentBlockCnt,
 	}
 
@@ -95,6 +99,7 @@ func execStreamEvent(cmd *cobra.Command, args []string) {
 		ContractAddress: ba,
 		EventName:       eventName,
 		ArgFilter:       []byte(argFilter),
+		IndexedFilter:   []byte(indexedFilter),
 	}
 
 	stream, err := client.ListEventStream(context.Background(), filter)
@@ -7,6 +7,8 @@ package cmd
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/aergoio/aergo/cmd/aergocli/util"
 	"github.com/aergoio/aergo/types"
@@ -58,8 +60,23 @@ func execGetState(cmd *cobra.Command, args []string) {
 			cmd.Printf("Failed: %s", err.Error())
 			return
 		}
-		cmd.Printf(`{"account":"%s", "staked":"%s", "when":%d}`+"\n",
-			address, amount, msg.GetWhen())
+		if len(msg.GetUnbonding()) == 0 {
+			cmd.Printf(`{"account":"%s", "staked":"%s", "when":%d}`+"\n",
+				address, amount, msg.GetWhen())
+			return
+		}
+		var unbonding []string
+		for _, u := range msg.GetUnbonding() {
+			unbondingAmount, err := util.ConvertUnit(u.GetAmountBigInt(), unit)
+			if err != nil {
+				cmd.Printf("Failed: %s", err.Error())
+				return
+			}
+			unbonding = append(unbonding,
+				fmt.Sprintf(`{"amount":"%s", "release":%d}`, unbondingAmount, u.GetRelease()))
+		}
+		cmd.Printf(`{"account":"%s", "staked":"%s", "when":%d, "unbonding":[%s]}`+"\n",
+			address, amount, msg.GetWhen(), strings.Join(unbonding, ", "))
 
 		return
 	}
@@ -0,0 +1,63 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package cmd
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aergoio/aergo/cmd/aergocli/util"
+	"github.com/aergoio/aergo/types"
+	"github.com/spf13/cobra"
+)
+
+var revertAfter time.Duration
+
+func init() {
+	logCmd := &cobra.Command{
+		Use:               "log [flags] subcommand",
+		Short:             "Log level command",
+		PersistentPreRun:  preConnectAergo,
+		PersistentPostRun: disconnectAergo,
+	}
+	logCmd.PersistentFlags().StringVarP(&sock, "sock", "s", "",
+		"Unix domain socket file path to connect an aergo server (required)")
+	logCmd.MarkPersistentFlagRequired("sock")
+	setLogLevelCmd.Flags().DurationVar(&revertAfter, "revert", 0, "revert to previous level after the duration (e.g. 10m)")
+
+	logCmd.AddCommand(setLogLevelCmd, listLogLevelsCmd)
+	rootCmd.AddCommand(logCmd)
+}
+
+var setLogLevelCmd = &cobra.Command{
+	Use:   "set [flags] <module> [level]",
+	Short: "Change log level of the module (p2p, chain, mempool, raft, syncer, contract). Configured level is used if level is omitted",
+	Args:  cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		req := &types.LogLevelRequest{Module: args[0], RevertSeconds: uint32(revertAfter / time.Second)}
+		if len(args) > 1 {
+			req.Level = args[1]
+		}
+		_, err := admClient.SetLogLevel(context.Background(), req)
+		if err != nil {
+			log.Fatalf("failed to execute: %v", err)
+		}
+		cmd.Println("log level of", args[0], "is changed")
+	},
+}
+
+var listLogLevelsCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Show current log levels of modules",
+	Run: func(cmd *cobra.Command, args []string) {
+		r, err := admClient.ListLogLevels(context.Background(), &types.Empty{})
+		if err != nil {
+			log.Fatalf("failed to execute: %v", err)
+		}
+		cmd.Println(util.JSON(r))
+	},
+}
@@ -895,3 +895,123 @@ func (mr *MockAergoRPCServiceClientMockRecorder) VerifyTX(arg0, arg1 interface{}
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyTX", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).VerifyTX), varargs...)
 }
+
+// ListProposals mocks base method
+func (m *MockAergoRPCServiceClient) ListProposals(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*types.ProposalList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProposals", varargs...)
+	ret0, _ := ret[0].(*types.ProposalList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProposals indicates an expected call of ListProposals
+func (mr *MockAergoRPCServiceClientMockRecorder) ListProposals(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProposals", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).ListProposals), varargs...)
+}
+
+// GetCodeHistory mocks base method
+func (m *MockAergoRPCServiceClient) GetCodeHistory(arg0 context.Context, arg1 *types.SingleBytes, arg2 ...grpc.CallOption) (*types.CodeHistory, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCodeHistory", varargs...)
+	ret0, _ := ret[0].(*types.CodeHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCodeHistory indicates an expected call of GetCodeHistory
+func (mr *MockAergoRPCServiceClientMockRecorder) GetCodeHistory(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCodeHistory", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetCodeHistory), varargs...)
+}
+
+// VerifyContractSource mocks base method
+func (m *MockAergoRPCServiceClient) VerifyContractSource(arg0 context.Context, arg1 *types.ContractSource, arg2 ...grpc.CallOption) (*types.ContractSource, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VerifyContractSource", varargs...)
+	ret0, _ := ret[0].(*types.ContractSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyContractSource indicates an expected call of VerifyContractSource
+func (mr *MockAergoRPCServiceClientMockRecorder) VerifyContractSource(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyContractSource", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).VerifyContractSource), varargs...)
+}
+
+// GetContractSource mocks base method
+func (m *MockAergoRPCServiceClient) GetContractSource(arg0 context.Context, arg1 *types.SingleBytes, arg2 ...grpc.CallOption) (*types.ContractSource, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetContractSource", varargs...)
+	ret0, _ := ret[0].(*types.ContractSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContractSource indicates an expected call of GetContractSource
+func (mr *MockAergoRPCServiceClientMockRecorder) GetContractSource(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractSource", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetContractSource), varargs...)
+}
+
+// GetContractDBStats mocks base method
+func (m *MockAergoRPCServiceClient) GetContractDBStats(arg0 context.Context, arg1 *types.SingleBytes, arg2 ...grpc.CallOption) (*types.ContractDBStats, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetContractDBStats", varargs...)
+	ret0, _ := ret[0].(*types.ContractDBStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContractDBStats indicates an expected call of GetContractDBStats
+func (mr *MockAergoRPCServiceClientMockRecorder) GetContractDBStats(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractDBStats", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetContractDBStats), varargs...)
+}
+
+// QueryContractSQL mocks base method
+func (m *MockAergoRPCServiceClient) QueryContractSQL(arg0 context.Context, arg1 *types.SQLQuery, arg2 ...grpc.CallOption) (*types.SQLQueryResult, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "QueryContractSQL", varargs...)
+	ret0, _ := ret[0].(*types.SQLQueryResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryContractSQL indicates an expected call of QueryContractSQL
+func (mr *MockAergoRPCServiceClientMockRecorder) QueryContractSQL(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryContractSQL", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).QueryContractSQL), varargs...)
+}
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"log"
 	"math/big"
+	"strconv"
 
 	"github.com/aergoio/aergo/cmd/aergocli/util"
 	"github.com/aergoio/aergo/types"
@@ -24,6 +25,7 @@ var nameCmd = &cobra.Command{
 }
 var spending string
 var blockNo uint64
+var subOwner string
 
 func init() {
 	rootCmd.AddCommand(nameCmd)
@@ -65,7 +67,57 @@ func init() {
 	ownerCmd.MarkFlagRequired("name")
 	ownerCmd.Flags().Uint64VarP(&blockNo, "blockno", "n", 0, "Block height")
 
-	nameCmd.AddCommand(newCmd, updateCmd, ownerCmd)
+	renewCmd := &cobra.Command{
+		Use:                   "renew",
+		Short:                 "Renew account name. It spend at least an amount of aergo according to nameprice",
+		RunE:                  execNameRenew,
+		DisableFlagsInUseLine: true,
+	}
+	renewCmd.Flags().StringVar(&from, "from", "", "owner account address")
+	renewCmd.MarkFlagRequired("from")
+	renewCmd.Flags().StringVar(&name, "name", "", "name of account to renew")
+	renewCmd.MarkFlagRequired("name")
+	renewCmd.Flags().StringVar(&spending, "amount", "20aergo", "spending for renew name. Must be set to the current nameprice")
+	renewCmd.Flags().StringVar(&pw, "password", "", "password")
+
+	reverseCmd := &cobra.Command{
+		Use:                   "reverse",
+		Short:                 "Name of account address",
+		Run:                   execNameReverse,
+		DisableFlagsInUseLine: true,
+	}
+	reverseCmd.Flags().StringVar(&address, "address", "", "account address to look up")
+	reverseCmd.MarkFlagRequired("address")
+	reverseCmd.Flags().Uint64VarP(&blockNo, "blockno", "n", 0, "Block height")
+
+	setReverseCmd := &cobra.Command{
+		Use:                   "setreverse",
+		Short:                 "Set the name shown for the account address. The name must resolve to the address",
+		RunE:                  execNameSetReverse,
+		DisableFlagsInUseLine: true,
+	}
+	setReverseCmd.Flags().StringVar(&from, "from", "", "account address which the name resolves to")
+	setReverseCmd.MarkFlagRequired("from")
+	setReverseCmd.Flags().StringVar(&name, "name", "", "name of account")
+	setReverseCmd.MarkFlagRequired("name")
+	setReverseCmd.Flags().StringVar(&pw, "password", "", "password")
+
+	subCmd := &cobra.Command{
+		Use:                   "sub",
+		Short:                 "Create or update a subname (e.g. shop.mycompany) of an owned name",
+		RunE:                  execNameSub,
+		DisableFlagsInUseLine: true,
+	}
+	subCmd.Flags().StringVar(&from, "from", "", "owner account address of the parent name")
+	subCmd.MarkFlagRequired("from")
+	subCmd.Flags().StringVar(&name, "name", "", "dotted subname")
+	subCmd.MarkFlagRequired("name")
+	subCmd.Flags().StringVar(&to, "to", "", "account address which the subname resolves to")
+	subCmd.MarkFlagRequired("to")
+	subCmd.Flags().StringVar(&subOwner, "owner", "", "account address to delegate the subname to (default: --from)")
+	subCmd.Flags().StringVar(&pw, "password", "", "password")
+
+	nameCmd.AddCommand(newCmd, updateCmd, ownerCmd, renewCmd, reverseCmd, setReverseCmd, subCmd)
 }
 
 func execNameNew(cmd *cobra.Command, args []string) error {
@@ -161,7 +213,97 @@ func execNameOwner(cmd *cobra.Command, args []string) {
 		cmd.Println(err.Error())
 		return
 	}
+	printNameInfo(cmd, msg)
+}
+
+func execNameRenew(cmd *cobra.Command, args []string) error {
+	account, err := types.DecodeAddress(from)
+	if err != nil {
+		return fmt.Errorf("wrong address in --from flag: %v", err.Error())
+	}
+	if len(name) != types.NameLength {
+		return errors.New("the name must be 12 alphabetic characters")
+	}
+	amount, err := util.ParseUnit(spending)
+	if err != nil {
+		return fmt.Errorf("wrong value in --amount flag: %v", err.Error())
+	}
+	return sendNameTx(cmd, account, amount, types.NameRenew)
+}
+
+func execNameSetReverse(cmd *cobra.Command, args []string) error {
+	account, err := types.DecodeAddress(from)
+	if err != nil {
+		return fmt.Errorf("wrong address in --from flag: %v", err.Error())
+	}
+	if len(name) != types.NameLength {
+		return errors.New("the name must be 12 alphabetic characters")
+	}
+	return sendNameTx(cmd, account, big.NewInt(0), types.NameSetReverse)
+}
+
+func execNameSub(cmd *cobra.Command, args []string) error {
+	account, err := types.DecodeAddress(from)
+	if err != nil {
+		return fmt.Errorf("wrong address in --from flag: %v", err.Error())
+	}
+	if !types.IsSubName([]byte(name)) {
+		return errors.New("the name must be a dotted subname of a name")
+	}
+	if _, err = types.DecodeAddress(to); err != nil {
+		return fmt.Errorf("wrong address in --to flag: %v", err.Error())
+	}
+	nameArgs := []interface{}{name, to}
+	if subOwner != "" {
+		if _, err = types.DecodeAddress(subOwner); err != nil {
+			return fmt.Errorf("wrong address in --owner flag: %v", err.Error())
+		}
+		nameArgs = append(nameArgs, subOwner)
+	}
+	return sendNameTx(cmd, account, big.NewInt(0), types.NameSetSub, nameArgs...)
+}
+
+func sendNameTx(cmd *cobra.Command, account []byte, amount *big.Int, op string, args ...interface{}) error {
+	if len(args) == 0 {
+		args = []interface{}{name}
+	}
+	payload, err := json.Marshal(types.CallInfo{Name: op, Args: args})
+	if err != nil {
+		log.Fatal(err)
+	}
+	tx := &types.Tx{
+		Body: &types.TxBody{
+			Account:   account,
+			Recipient: []byte(types.AergoName),
+			Amount:    amount.Bytes(),
+			Payload:   payload,
+			GasLimit:  0,
+			Type:      types.TxType_GOVERNANCE,
+		},
+	}
+	cmd.Println(sendTX(cmd, tx, account))
+	return nil
+}
+
+func execNameReverse(cmd *cobra.Command, args []string) {
+	if _, err := types.DecodeAddress(address); err != nil {
+		cmd.Printf("Failed: wrong address in --address flag: %s\n", err.Error())
+		return
+	}
+	msg, err := client.GetNameInfo(context.Background(), &types.Name{Name: address, BlockNo: blockNo})
+	if err != nil {
+		cmd.Println(err.Error())
+		return
+	}
+	printNameInfo(cmd, msg)
+}
+
+func printNameInfo(cmd *cobra.Command, msg *types.NameInfo) {
+	expire := ""
+	if msg.Expire != 0 {
+		expire = ",\n  \"Expire\": " + strconv.FormatUint(msg.Expire, 10)
+	}
 	cmd.Println("{\n \"" + msg.Name.Name + "\": {\n  " +
 		"\"Owner\": \"" + types.EncodeAddress(msg.Owner) + "\",\n  " +
-		"\"Destination\": \"" + types.EncodeAddress(msg.Destination) + "\"\n  }\n}")
+		"\"Destination\": \"" + types.EncodeAddress(msg.Destination) + "\"" + expire + "\n  }\n}")
 }
@@ -0,0 +1,150 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/aergoio/aergo/cmd/aergocli/util"
+	"github.com/aergoio/aergo/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	banPeerID string
+	banAddr   string
+	banCidr   string
+)
+
+func init() {
+	peerCmd := &cobra.Command{
+		Use:               "peer [flags] subcommand",
+		Short:             "Peer management command",
+		PersistentPreRun:  preConnectAergo,
+		PersistentPostRun: disconnectAergo,
+	}
+	peerCmd.PersistentFlags().StringVarP(&sock, "sock", "s", "",
+		"Unix domain socket file path to connect an aergo server (required)")
+	peerCmd.MarkPersistentFlagRequired("sock")
+
+	for _, c := range []*cobra.Command{banCmd, unbanCmd} {
+		c.Flags().StringVar(&banPeerID, "peerid", "", "peer id to ban")
+		c.Flags().StringVar(&banAddr, "address", "", "ip address to ban")
+		c.Flags().StringVar(&banCidr, "cidr", "", "ip address range to ban, in CIDR notation")
+	}
+
+	peerCmd.AddCommand(addDesignatedCmd, removeDesignatedCmd, disconnectCmd, banCmd, unbanCmd, banListCmd, waitingCmd)
+	rootCmd.AddCommand(peerCmd)
+}
+
+var addDesignatedCmd = &cobra.Command{
+	Use:   "add <multiaddr>",
+	Short: "Add designated peer and connect to it",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		_, err := admClient.AddDesignatedPeer(context.Background(), &types.SingleString{Value: args[0]})
+		if err != nil {
+			log.Fatalf("failed to execute: %v", err)
+		}
+		cmd.Println("added designated peer", args[0])
+	},
+}
+
+var removeDesignatedCmd = &cobra.Command{
+	Use:   "remove <peerID>",
+	Short: "Remove designated peer",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		_, err := admClient.RemoveDesignatedPeer(context.Background(), &types.SingleBytes{Value: mustPeerID(args[0])})
+		if err != nil {
+			log.Fatalf("failed to execute: %v", err)
+		}
+		cmd.Println("removed designated peer", args[0])
+	},
+}
+
+var disconnectCmd = &cobra.Command{
+	Use:   "disconnect <peerID>",
+	Short: "Close connection to the peer",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		_, err := admClient.DisconnectPeer(context.Background(), &types.SingleBytes{Value: mustPeerID(args[0])})
+		if err != nil {
+			log.Fatalf("failed to execute: %v", err)
+		}
+		cmd.Println("disconnected peer", args[0])
+	},
+}
+
+var banCmd = &cobra.Command{
+	Use:   "ban [flags]",
+	Short: "Add peer id and/or address range to local blacklist",
+	Run: func(cmd *cobra.Command, args []string) {
+		entry := banEntry()
+		_, err := admClient.AddBlacklist(context.Background(), &types.SingleString{Value: entry})
+		if err != nil {
+			log.Fatalf("failed to execute: %v", err)
+		}
+		cmd.Println("banned", entry)
+	},
+}
+
+var unbanCmd = &cobra.Command{
+	Use:   "unban [flags]",
+	Short: "Remove peer id and/or address range from local blacklist",
+	Run: func(cmd *cobra.Command, args []string) {
+		entry := banEntry()
+		_, err := admClient.RemoveBlacklist(context.Background(), &types.SingleString{Value: entry})
+		if err != nil {
+			log.Fatalf("failed to execute: %v", err)
+		}
+		cmd.Println("unbanned", entry)
+	},
+}
+
+var banListCmd = &cobra.Command{
+	Use:   "banlist",
+	Short: "Show entries of local blacklist",
+	Run: func(cmd *cobra.Command, args []string) {
+		r, err := admClient.ListBlacklist(context.Background(), &types.Empty{})
+		if err != nil {
+			log.Fatalf("failed to execute: %v", err)
+		}
+		cmd.Println(util.JSON(r))
+	},
+}
+
+var waitingCmd = &cobra.Command{
+	Use:   "waiting",
+	Short: "Show peers waiting for connection and their next retry time",
+	Run: func(cmd *cobra.Command, args []string) {
+		r, err := admClient.ListWaitingPeers(context.Background(), &types.Empty{})
+		if err != nil {
+			log.Fatalf("failed to execute: %v", err)
+		}
+		cmd.Println(util.WaitingPeerListToString(r))
+	},
+}
+
+func mustPeerID(str string) []byte {
+	pid, err := types.IDB58Decode(str)
+	if err != nil {
+		log.Fatalf("invalid peer id %s: %v", str, err)
+	}
+	return []byte(pid)
+}
+
+// banEntry builds blacklist entry, which is in the same format of whitelist entry, from flags
+func banEntry() string {
+	raw := types.RawEntry{PeerId: banPeerID, Address: banAddr, Cidr: banCidr}
+	if _, err := types.NewListEntry(raw); err != nil {
+		log.Fatalf("invalid ban entry: %v", err)
+	}
+	entry, _ := json.Marshal(raw)
+	return string(entry)
+}
@@ -0,0 +1,120 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/aergoio/aergo/cmd/aergocli/util"
+	"github.com/aergoio/aergo/types"
+	"github.com/spf13/cobra"
+)
+
+var proposalCmd = &cobra.Command{
+	Use:   "proposal [flags] subcommand",
+	Short: "Proposal command",
+}
+
+var proposalID string
+var proposalTarget string
+var proposalValue string
+var proposalDesc string
+var proposalPeriod uint64
+var proposalQuorum uint32
+var proposalTimelock uint64
+
+func init() {
+	rootCmd.AddCommand(proposalCmd)
+	createCmd := &cobra.Command{
+		Use:                   "create",
+		Short:                 "Create a proposal to change a system parameter",
+		RunE:                  execProposalCreate,
+		DisableFlagsInUseLine: true,
+	}
+	createCmd.Flags().StringVar(&address, "address", "", "base58 address of proposer")
+	createCmd.MarkFlagRequired("address")
+	createCmd.Flags().StringVar(&proposalTarget, "target", "", "id of the system parameter to change")
+	createCmd.MarkFlagRequired("target")
+	createCmd.Flags().StringVar(&proposalValue, "value", "", "new value of the system parameter")
+	createCmd.MarkFlagRequired("value")
+	createCmd.Flags().StringVar(&proposalDesc, "description", "", "description of the proposal")
+	createCmd.Flags().Uint64Var(&proposalPeriod, "period", 0, "voting period in blocks")
+	createCmd.MarkFlagRequired("period")
+	createCmd.Flags().Uint32Var(&proposalQuorum, "quorum", 0, "minimum turnout in percent of the total staking (10-100)")
+	createCmd.MarkFlagRequired("quorum")
+	createCmd.Flags().Uint64Var(&proposalTimelock, "timelock", 0, "blocks to wait after voting ends before execution")
+	createCmd.Flags().StringVar(&pw, "password", "", "password")
+
+	executeCmd := &cobra.Command{
+		Use:                   "execute",
+		Short:                 "Execute an approved proposal",
+		RunE:                  execProposalExecute,
+		DisableFlagsInUseLine: true,
+	}
+	executeCmd.Flags().StringVar(&address, "address", "", "base58 address of executor")
+	executeCmd.MarkFlagRequired("address")
+	executeCmd.Flags().StringVar(&proposalID, "id", "", "id of the proposal")
+	executeCmd.MarkFlagRequired("id")
+	executeCmd.Flags().StringVar(&pw, "password", "", "password")
+
+	listCmd := &cobra.Command{
+		Use:                   "list",
+		Short:                 "List proposals",
+		Run:                   execProposalList,
+		DisableFlagsInUseLine: true,
+	}
+
+	proposalCmd.AddCommand(createCmd, executeCmd, listCmd)
+}
+
+func execProposalCreate(cmd *cobra.Command, args []string) error {
+	return sendProposal(cmd, types.OpcreateProposal, []interface{}{
+		proposalTarget,
+		proposalValue,
+		proposalDesc,
+		strconv.FormatUint(proposalPeriod, 10),
+		strconv.FormatUint(uint64(proposalQuorum), 10),
+		strconv.FormatUint(proposalTimelock, 10),
+	})
+}
+
+func execProposalExecute(cmd *cobra.Command, args []string) error {
+	return sendProposal(cmd, types.OpexecuteProposal, []interface{}{proposalID})
+}
+
+func sendProposal(cmd *cobra.Command, op types.OpSysTx, args []interface{}) error {
+	account, err := types.DecodeAddress(address)
+	if err != nil {
+		return fmt.Errorf("wrong address in --address flag: %v", err.Error())
+	}
+	payload, err := json.Marshal(types.CallInfo{Name: op.Cmd(), Args: args})
+	if err != nil {
+		return fmt.Errorf("failed to encode payload: %v", err.Error())
+	}
+	tx := &types.Tx{
+		Body: &types.TxBody{
+			Account:   account,
+			Recipient: []byte(types.AergoSystem),
+			Payload:   payload,
+			GasLimit:  0,
+			Type:      types.TxType_GOVERNANCE,
+		},
+	}
+	cmd.Println(sendTX(cmd, tx, account))
+	return nil
+}
+
+func execProposalList(cmd *cobra.Command, args []string) {
+	msg, err := client.ListProposals(context.Background(), &types.Empty{})
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+	cmd.Println(util.JSON(msg))
+}
@@ -21,7 +21,7 @@ var stakeCmd = &cobra.Command{
 }
 
 func execStake(cmd *cobra.Command, args []string) error {
-	return sendStake(cmd, true)
+	return sendStake(cmd, types.Opstake)
 }
 
 var unstakeCmd = &cobra.Command{
@@ -32,20 +32,39 @@ var unstakeCmd = &cobra.Command{
 }
 
 func execUnstake(cmd *cobra.Command, args []string) error {
-	return sendStake(cmd, false)
+	return sendStake(cmd, types.Opunstake)
 }
 
-func sendStake(cmd *cobra.Command, s bool) error {
+var unbondCmd = &cobra.Command{
+	Use:    "unbond",
+	Short:  "Request unstaking which is released after the staking delay",
+	RunE:   execUnbond,
+	PreRun: connectAergo,
+}
+
+func execUnbond(cmd *cobra.Command, args []string) error {
+	return sendStake(cmd, types.Opunbond)
+}
+
+var withdrawCmd = &cobra.Command{
+	Use:    "withdraw",
+	Short:  "Withdraw the released unbonding requests",
+	RunE:   execWithdraw,
+	PreRun: connectAergo,
+}
+
+func execWithdraw(cmd *cobra.Command, args []string) error {
+	amount = "0"
+	return sendStake(cmd, types.Opwithdraw)
+}
+
+func sendStake(cmd *cobra.Command, op types.OpSysTx) error {
 	account, err := types.DecodeAddress(address)
 	if err != nil {
 		return errors.New("Failed to parse --address flag (" + address + ")\n" + err.Error())
 	}
 	var ci types.CallInfo
-	if s {
-		ci.Name = types.Opstake.Cmd()
-	} else {
-		ci.Name = types.Opunstake.Cmd()
-	}
+	ci.Name = op.Cmd()
 	amountBigInt, err := util.ParseUnit(amount)
 	if err != nil {
 		return errors.New("Failed to parse --amount flag\n" + err.Error())
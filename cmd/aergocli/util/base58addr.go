@@ -51,14 +51,23 @@ type InOutPeerAddress struct {
 }
 
 type InOutPeer struct {
-	Role      string
-	Address   InOutPeerAddress
-	BestBlock InOutBlockIdx
-	LastCheck time.Time
-	State     string
-	Hidden    bool
-	Self      bool
-	Version   string
+	Role       string
+	Address    InOutPeerAddress
+	BestBlock  InOutBlockIdx
+	LastCheck  time.Time
+	State      string
+	Hidden     bool
+	Self       bool
+	Version    string
+	Reputation int32
+}
+
+type InOutWaitingPeer struct {
+	Address    InOutPeerAddress
+	Designated bool
+	TrialCnt   int32
+	NextTrial  time.Time
+	LastError  string
 }
 
 type LongInOutPeer struct {
@@ -260,6 +269,7 @@ func ConvPeer(p *types.Peer) *InOutPeer {
 	out.State = types.PeerState(p.State).String()
 	out.Hidden = p.Hidden
 	out.Self = p.Selfpeer
+	out.Reputation = p.GetReputation()
 	if p.Version != "" {
 		out.Version = p.Version
 	} else {
@@ -268,6 +278,18 @@ func ConvPeer(p *types.Peer) *InOutPeer {
 	return out
 }
 
+func ConvWaitingPeer(p *types.WaitingPeer) *InOutWaitingPeer {
+	out := &InOutWaitingPeer{}
+	out.Address.Address = p.GetAddress().GetAddress()
+	out.Address.Port = strconv.Itoa(int(p.GetAddress().GetPort()))
+	out.Address.PeerId = base58.Encode(p.GetAddress().GetPeerID())
+	out.Designated = p.Designated
+	out.TrialCnt = p.TrialCnt
+	out.NextTrial = time.Unix(0, p.NextTrial)
+	out.LastError = p.LastError
+	return out
+}
+
 func ConvPeerLong(p *types.Peer) *LongInOutPeer {
 	out := &LongInOutPeer{InOutPeer: *ConvPeer(p)}
 	out.ProducerIDs = make([]string, len(p.Address.ProducerIDs))
@@ -344,6 +366,13 @@ func LongPeerListToString(p *types.PeerList) string {
 	}
 	return toString(peers)
 }
+func WaitingPeerListToString(p *types.WaitingPeerList) string {
+	peers := []*InOutWaitingPeer{}
+	for _, peer := range p.GetPeers() {
+		peers = append(peers, ConvWaitingPeer(peer))
+	}
+	return toString(peers)
+}
 func toString(out interface{}) string {
 	jsonout, err := json.MarshalIndent(out, "", " ")
 	if err != nil {
@@ -0,0 +1,233 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package lint
+
+import (
+	"fmt"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokName
+	tokKeyword
+	tokString
+	tokNumber
+	tokOp
+)
+
+type token struct {
+	kind tokenKind
+	val  string
+	line int
+}
+
+var keywords = map[string]bool{
+	"and": true, "break": true, "do": true, "else": true, "elseif": true,
+	"end": true, "false": true, "for": true, "function": true, "goto": true,
+	"if": true, "in": true, "local": true, "nil": true, "not": true,
+	"or": true, "repeat": true, "return": true, "then": true, "true": true,
+	"until": true, "while": true,
+}
+
+// operators are ordered so that longer ones are matched first
+var operators = []string{
+	"...", "..", "==", "~=", "<=", ">=", "::",
+	"+", "-", "*", "/", "%", "^", "#", "<", ">", "=",
+	"(", ")", "{", "}", "[", "]", ";", ":", ",", ".",
+}
+
+type lexer struct {
+	src  string
+	pos  int
+	line int
+}
+
+func tokenize(src string) ([]token, error) {
+	lx := &lexer{src: src, line: 1}
+	// skip a shebang line like the lua loader does
+	if strings.HasPrefix(src, "#") {
+		for lx.pos < len(src) && src[lx.pos] != '\n' {
+			lx.pos++
+		}
+	}
+	var toks []token
+	for {
+		tok, err := lx.next()
+		if err != nil {
+			return nil, err
+		}
+		toks = append(toks, tok)
+		if tok.kind == tokEOF {
+			return toks, nil
+		}
+	}
+}
+
+func (lx *lexer) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("line %d: %s", lx.line, fmt.Sprintf(format, args...))
+}
+
+func (lx *lexer) peek(off int) byte {
+	if lx.pos+off < len(lx.src) {
+		return lx.src[lx.pos+off]
+	}
+	return 0
+}
+
+func (lx *lexer) next() (token, error) {
+	for lx.pos < len(lx.src) {
+		c := lx.src[lx.pos]
+		switch {
+		case c == '\n':
+			lx.line++
+			lx.pos++
+		case c == ' ' || c == '\t' || c == '\r' || c == '\f' || c == '\v':
+			lx.pos++
+		case c == '-' && lx.peek(1) == '-':
+			lx.pos += 2
+			if lx.peek(0) == '[' {
+				if level := lx.longBracketLevel(); level >= 0 {
+					if _, err := lx.longString(level); err != nil {
+						return token{}, err
+					}
+					continue
+				}
+			}
+			for lx.pos < len(lx.src) && lx.src[lx.pos] != '\n' {
+				lx.pos++
+			}
+		default:
+			return lx.scan()
+		}
+	}
+	return token{kind: tokEOF, line: lx.line}, nil
+}
+
+func (lx *lexer) scan() (token, error) {
+	c := lx.src[lx.pos]
+	line := lx.line
+	switch {
+	case isAlpha(c):
+		start := lx.pos
+		for lx.pos < len(lx.src) && (isAlpha(lx.src[lx.pos]) || isDigit(lx.src[lx.pos])) {
+			lx.pos++
+		}
+		word := lx.src[start:lx.pos]
+		if keywords[word] {
+			return token{kind: tokKeyword, val: word, line: line}, nil
+		}
+		return token{kind: tokName, val: word, line: line}, nil
+	case isDigit(c) || (c == '.' && isDigit(lx.peek(1))):
+		start := lx.pos
+		for lx.pos < len(lx.src) {
+			d := lx.src[lx.pos]
+			if (d == '+' || d == '-') && strings.ContainsRune("eEpP", rune(lx.src[lx.pos-1])) {
+				lx.pos++
+				continue
+			}
+			if !isAlpha(d) && !isDigit(d) && d != '.' {
+				break
+			}
+			lx.pos++
+		}
+		return token{kind: tokNumber, val: lx.src[start:lx.pos], line: line}, nil
+	case c == '"' || c == '\'':
+		s, err := lx.shortString(c)
+		if err != nil {
+			return token{}, err
+		}
+		return token{kind: tokString, val: s, line: line}, nil
+	case c == '[':
+		if level := lx.longBracketLevel(); level >= 0 {
+			s, err := lx.longString(level)
+			if err != nil {
+				return token{}, err
+			}
+			return token{kind: tokString, val: s, line: line}, nil
+		}
+	}
+	for _, op := range operators {
+		if strings.HasPrefix(lx.src[lx.pos:], op) {
+			lx.pos += len(op)
+			return token{kind: tokOp, val: op, line: line}, nil
+		}
+	}
+	return token{}, lx.errorf("unexpected symbol near '%c'", c)
+}
+
+func (lx *lexer) shortString(quote byte) (string, error) {
+	var sb strings.Builder
+	lx.pos++
+	for lx.pos < len(lx.src) {
+		c := lx.src[lx.pos]
+		switch c {
+		case quote:
+			lx.pos++
+			return sb.String(), nil
+		case '\n':
+			return "", lx.errorf("unfinished string")
+		case '\\':
+			lx.pos++
+			if lx.pos >= len(lx.src) {
+				return "", lx.errorf("unfinished string")
+			}
+			e := lx.src[lx.pos]
+			if e == '\n' {
+				lx.line++
+			}
+			switch e {
+			case 'n':
+				sb.WriteByte('\n')
+			case 't':
+				sb.WriteByte('\t')
+			default:
+				sb.WriteByte(e)
+			}
+			lx.pos++
+		default:
+			sb.WriteByte(c)
+			lx.pos++
+		}
+	}
+	return "", lx.errorf("unfinished string")
+}
+
+// longBracketLevel returns the level of a long bracket at the current
+// position, or -1 if there is none.
+func (lx *lexer) longBracketLevel() int {
+	i := lx.pos + 1
+	for i < len(lx.src) && lx.src[i] == '=' {
+		i++
+	}
+	if i < len(lx.src) && lx.src[i] == '[' {
+		return i - lx.pos - 1
+	}
+	return -1
+}
+
+func (lx *lexer) longString(level int) (string, error) {
+	lx.pos += level + 2
+	closing := "]" + strings.Repeat("=", level) + "]"
+	end := strings.Index(lx.src[lx.pos:], closing)
+	if end < 0 {
+		return "", lx.errorf("unfinished long string or comment")
+	}
+	s := lx.src[lx.pos : lx.pos+end]
+	lx.line += strings.Count(s, "\n")
+	lx.pos += end + len(closing)
+	return strings.TrimPrefix(s, "\n"), nil
+}
+
+func isAlpha(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
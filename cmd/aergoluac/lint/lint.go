@@ -0,0 +1,565 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+// Package lint reports nondeterminism and gas hazards in lua contracts.
+package lint
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Issue is a hazard found in a contract source.
+type Issue struct {
+	Line int
+	Msg  string
+}
+
+func (i Issue) String() string {
+	return fmt.Sprintf("%d: %s", i.Line, i.Msg)
+}
+
+// builtins are the globals provided by the contract VM
+var builtins = map[string]bool{
+	"_G": true, "_VERSION": true, "assert": true, "error": true, "getmetatable": true,
+	"ipairs": true, "next": true, "pairs": true, "pcall": true, "print": true,
+	"rawequal": true, "rawget": true, "rawlen": true, "rawset": true, "select": true,
+	"setmetatable": true, "tonumber": true, "tostring": true, "type": true,
+	"unpack": true, "xpcall": true,
+	"bit": true, "math": true, "string": true, "table": true, "utf8": true,
+	"abi": true, "bignum": true, "contract": true, "crypto": true, "db": true,
+	"decimal": true, "json": true, "state": true, "system": true,
+}
+
+// stateWriteMethods are the methods of state variables that update them
+var stateWriteMethods = map[string]bool{
+	"set": true, "delete": true, "append": true,
+}
+
+// stateChangingCalls are the module functions that cannot be called in a view
+var stateChangingCalls = map[string]map[string]bool{
+	"contract": {
+		"event": true, "send": true, "deploy": true, "delegatecall": true,
+		"stake": true, "unstake": true, "vote": true, "setUpgradeOwner": true, "upgrade": true,
+		"schedule": true, "event_indexed": true,
+	},
+	"system": {"setItem": true},
+	"db":     {"exec": true},
+}
+
+type position struct {
+	line int
+	desc string
+}
+
+type funcCall struct {
+	line int
+	name string
+}
+
+// funcInfo is what a function does directly, without its callees
+type funcInfo struct {
+	line        int
+	writes      []position
+	readsAmount []int
+	calls       []funcCall
+}
+
+type linter struct {
+	issues     []Issue
+	stateVars  map[string]string
+	globals    map[string]bool
+	funcs      map[string]*funcInfo
+	registered []string
+	views      map[string]bool
+	payables   map[string]bool
+}
+
+// Lint parses the contract source and returns the hazards found in it.
+func Lint(src string) ([]Issue, error) {
+	chunk, err := parse(src)
+	if err != nil {
+		return nil, err
+	}
+	l := &linter{
+		stateVars: make(map[string]string),
+		globals:   make(map[string]bool),
+		funcs:     make(map[string]*funcInfo),
+		views:     make(map[string]bool),
+		payables:  make(map[string]bool),
+	}
+	l.declarations(chunk)
+	for name, fn := range l.topFuncs(chunk) {
+		info := &funcInfo{line: fn.line}
+		l.collect(fn.body, info)
+		l.funcs[name] = info
+	}
+	l.checkViews()
+	l.checkPayables()
+	l.walkBlock(chunk, []map[string]bool{{}}, false)
+
+	sort.SliceStable(l.issues, func(i, j int) bool {
+		return l.issues[i].Line < l.issues[j].Line
+	})
+	return l.issues, nil
+}
+
+func (l *linter) report(line int, format string, args ...interface{}) {
+	l.issues = append(l.issues, Issue{Line: line, Msg: fmt.Sprintf(format, args...)})
+}
+
+// declarations collects the state variables, the globals and the abi
+// declarations of the main chunk.
+func (l *linter) declarations(chunk []stmt) {
+	for _, s := range chunk {
+		switch s := s.(type) {
+		case *funcStmt:
+			if n, ok := s.name.(*nameExpr); ok && !s.local {
+				l.globals[n.name] = true
+			}
+		case *assignStmt:
+			for _, t := range s.targets {
+				if n, ok := t.(*nameExpr); ok {
+					l.globals[n.name] = true
+				}
+			}
+		case *callStmt:
+			mod, fn := moduleCall(s.call)
+			if mod == "state" && fn == "var" {
+				l.stateVarDecls(s.call)
+			} else if mod == "abi" {
+				for _, name := range argNames(s.call) {
+					switch fn {
+					case "register":
+						l.registered = append(l.registered, name)
+					case "register_view":
+						l.registered = append(l.registered, name)
+						l.views[name] = true
+					case "payable":
+						l.payables[name] = true
+					}
+				}
+			}
+		}
+	}
+}
+
+func (l *linter) stateVarDecls(call *callExpr) {
+	for _, arg := range call.args {
+		tbl, ok := arg.(*tableExpr)
+		if !ok {
+			continue
+		}
+		for i, key := range tbl.keys {
+			name, ok := key.(*stringExpr)
+			if !ok {
+				continue
+			}
+			kind := "value"
+			if c, ok := tbl.values[i].(*callExpr); ok {
+				if mod, fn := moduleCall(c); mod == "state" {
+					kind = fn
+				}
+			}
+			l.stateVars[name.value] = kind
+		}
+	}
+}
+
+// topFuncs returns the named functions declared in the main chunk.
+func (l *linter) topFuncs(chunk []stmt) map[string]*funcExpr {
+	funcs := make(map[string]*funcExpr)
+	for _, s := range chunk {
+		switch s := s.(type) {
+		case *funcStmt:
+			if n, ok := s.name.(*nameExpr); ok {
+				funcs[n.name] = s.fn
+			}
+		case *localStmt:
+			for i, e := range s.exprs {
+				if fn, ok := e.(*funcExpr); ok && i < len(s.names) {
+					funcs[s.names[i]] = fn
+				}
+			}
+		case *assignStmt:
+			for i, e := range s.exprs {
+				fn, ok := e.(*funcExpr)
+				if !ok || i >= len(s.targets) {
+					continue
+				}
+				if n, ok := s.targets[i].(*nameExpr); ok {
+					funcs[n.name] = fn
+				}
+			}
+		}
+	}
+	return funcs
+}
+
+// collect records the state writes, the amount reads and the calls of a
+// function body, including the closures declared in it.
+func (l *linter) collect(node interface{}, info *funcInfo) {
+	inspect(node, func(n interface{}) {
+		switch n := n.(type) {
+		case *assignStmt:
+			for _, t := range n.targets {
+				if name := l.stateRoot(t); name != "" {
+					info.writes = append(info.writes, position{n.line, fmt.Sprintf("writes state variable '%s'", name)})
+				}
+			}
+		case *callExpr:
+			if n.method != "" {
+				if name := l.stateRoot(n.fn); name != "" && stateWriteMethods[n.method] {
+					info.writes = append(info.writes, position{n.line, fmt.Sprintf("writes state variable '%s'", name)})
+				}
+				return
+			}
+			mod, fn := moduleCall(n)
+			if stateChangingCalls[mod][fn] {
+				info.writes = append(info.writes, position{n.line, fmt.Sprintf("calls %s.%s", mod, fn)})
+			}
+			if mod == "system" && fn == "getAmount" {
+				info.readsAmount = append(info.readsAmount, n.line)
+			}
+			if name, ok := n.fn.(*nameExpr); ok {
+				info.calls = append(info.calls, funcCall{n.line, name.name})
+			}
+		}
+	})
+}
+
+// reaches reports whether the function or one of its callees satisfies pred.
+func (l *linter) reaches(name string, pred func(*funcInfo) bool, visited map[string]bool) bool {
+	info, ok := l.funcs[name]
+	if !ok || visited[name] {
+		return false
+	}
+	visited[name] = true
+	if pred(info) {
+		return true
+	}
+	for _, c := range info.calls {
+		if l.reaches(c.name, pred, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+func writesState(info *funcInfo) bool {
+	return len(info.writes) > 0
+}
+
+func readsAmount(info *funcInfo) bool {
+	return len(info.readsAmount) > 0
+}
+
+func (l *linter) checkViews() {
+	for _, name := range l.registered {
+		info, ok := l.funcs[name]
+		if !ok || !l.views[name] {
+			continue
+		}
+		for _, w := range info.writes {
+			l.report(w.line, "view function '%s' %s", name, w.desc)
+		}
+		for _, c := range info.calls {
+			if c.name != name && l.reaches(c.name, writesState, map[string]bool{name: true}) {
+				l.report(c.line, "view function '%s' calls '%s' which modifies the state", name, c.name)
+			}
+		}
+	}
+}
+
+func (l *linter) checkPayables() {
+	entries := append([]string{"constructor"}, l.registered...)
+	checked := make(map[string]bool)
+	for _, name := range entries {
+		info, ok := l.funcs[name]
+		if !ok || checked[name] || l.payables[name] || l.views[name] {
+			continue
+		}
+		checked[name] = true
+		if l.reaches(name, readsAmount, map[string]bool{}) {
+			l.report(info.line, "function '%s' reads system.getAmount but is not declared with abi.payable", name)
+		}
+	}
+}
+
+// walkBlock resolves the names of a block and reports the loops over state
+// variables, the system.random calls and the undeclared globals.
+func (l *linter) walkBlock(block []stmt, scopes []map[string]bool, inFunc bool) {
+	scopes = append(scopes, map[string]bool{})
+	for _, s := range block {
+		l.walkStmt(s, scopes, inFunc)
+	}
+}
+
+func declare(scopes []map[string]bool, names ...string) {
+	for _, name := range names {
+		scopes[len(scopes)-1][name] = true
+	}
+}
+
+func (l *linter) walkStmt(s stmt, scopes []map[string]bool, inFunc bool) {
+	switch s := s.(type) {
+	case *localStmt:
+		l.walkExprs(s.exprs, scopes, inFunc)
+		declare(scopes, s.names...)
+	case *assignStmt:
+		l.walkExprs(s.exprs, scopes, inFunc)
+		for _, t := range s.targets {
+			n, ok := t.(*nameExpr)
+			if !ok {
+				l.walkExpr(t, scopes, inFunc)
+				continue
+			}
+			if isLocal(scopes, n.name) {
+				continue
+			}
+			if builtins[n.name] || l.stateVars[n.name] != "" {
+				continue
+			}
+			if !l.globals[n.name] {
+				l.report(n.line, "assignment to undeclared global '%s'", n.name)
+			} else if inFunc && l.funcs[n.name] == nil {
+				l.report(n.line, "global '%s' is not persisted across calls; declare it with state.var", n.name)
+			}
+		}
+	case *callStmt:
+		l.walkExpr(s.call, scopes, inFunc)
+	case *doStmt:
+		l.walkBlock(s.body, scopes, inFunc)
+	case *whileStmt:
+		l.checkLoop(s.line, s.cond)
+		l.walkExpr(s.cond, scopes, inFunc)
+		l.walkBlock(s.body, scopes, inFunc)
+	case *repeatStmt:
+		l.checkLoop(s.line, s.cond)
+		// the condition of repeat can see the locals of its body
+		inner := append(scopes, map[string]bool{})
+		for _, b := range s.body {
+			l.walkStmt(b, inner, inFunc)
+		}
+		l.walkExpr(s.cond, inner, inFunc)
+	case *ifStmt:
+		l.walkExprs(s.conds, scopes, inFunc)
+		for _, b := range s.blocks {
+			l.walkBlock(b, scopes, inFunc)
+		}
+		l.walkBlock(s.orElse, scopes, inFunc)
+	case *numForStmt:
+		l.checkLoop(s.line, s.limit)
+		l.walkExprs([]expr{s.start, s.limit, s.step}, scopes, inFunc)
+		inner := append(scopes, map[string]bool{s.name: true})
+		l.walkBlock(s.body, inner, inFunc)
+	case *genForStmt:
+		l.checkLoop(s.line, s.exprs...)
+		l.walkExprs(s.exprs, scopes, inFunc)
+		inner := append(scopes, map[string]bool{})
+		declare(inner, s.names...)
+		l.walkBlock(s.body, inner, inFunc)
+	case *funcStmt:
+		if s.local {
+			declare(scopes, s.name.(*nameExpr).name)
+		} else if _, ok := s.name.(*nameExpr); !ok {
+			l.walkExpr(s.name, scopes, inFunc)
+		}
+		l.walkExpr(s.fn, scopes, inFunc)
+	case *returnStmt:
+		l.walkExprs(s.exprs, scopes, inFunc)
+	}
+}
+
+func (l *linter) walkExprs(exprs []expr, scopes []map[string]bool, inFunc bool) {
+	for _, e := range exprs {
+		l.walkExpr(e, scopes, inFunc)
+	}
+}
+
+func (l *linter) walkExpr(e expr, scopes []map[string]bool, inFunc bool) {
+	switch e := e.(type) {
+	case *nameExpr:
+		if !isLocal(scopes, e.name) && !builtins[e.name] && !l.globals[e.name] && l.stateVars[e.name] == "" {
+			l.report(e.line, "undeclared global '%s'", e.name)
+		}
+	case *indexExpr:
+		l.walkExpr(e.obj, scopes, inFunc)
+		l.walkExpr(e.key, scopes, inFunc)
+	case *callExpr:
+		if mod, fn := moduleCall(e); mod == "system" && fn == "random" && !isLocal(scopes, "system") {
+			l.report(e.line, "system.random takes no seed; its result is derived from the block and transaction hashes")
+		}
+		l.walkExpr(e.fn, scopes, inFunc)
+		l.walkExprs(e.args, scopes, inFunc)
+	case *funcExpr:
+		inner := append(scopes, map[string]bool{})
+		declare(inner, e.params...)
+		l.walkBlock(e.body, inner, true)
+	case *tableExpr:
+		for i, v := range e.values {
+			if e.keys[i] != nil {
+				l.walkExpr(e.keys[i], scopes, inFunc)
+			}
+			l.walkExpr(v, scopes, inFunc)
+		}
+	case *binExpr:
+		l.walkExpr(e.l, scopes, inFunc)
+		l.walkExpr(e.r, scopes, inFunc)
+	case *unExpr:
+		l.walkExpr(e.x, scopes, inFunc)
+	case *parenExpr:
+		l.walkExpr(e.x, scopes, inFunc)
+	}
+}
+
+// checkLoop reports a loop whose iteration count depends on the size of a
+// state array or map.
+func (l *linter) checkLoop(line int, exprs ...expr) {
+	for _, e := range exprs {
+		var found string
+		inspect(e, func(n interface{}) {
+			c, ok := n.(*callExpr)
+			if !ok || found != "" {
+				return
+			}
+			if c.method != "" {
+				if name := l.stateRoot(c.fn); name != "" && l.stateVars[name] != "value" {
+					found = name
+				}
+				return
+			}
+			if fn, ok := c.fn.(*nameExpr); ok && (fn.name == "pairs" || fn.name == "ipairs") && len(c.args) > 0 {
+				found = l.stateRoot(c.args[0])
+			}
+		})
+		if found != "" {
+			l.report(line, "unbounded loop over state variable '%s'", found)
+			return
+		}
+	}
+}
+
+// stateRoot returns the state variable an expression refers to, if any.
+func (l *linter) stateRoot(e expr) string {
+	for {
+		switch x := e.(type) {
+		case *nameExpr:
+			if l.stateVars[x.name] != "" {
+				return x.name
+			}
+			return ""
+		case *indexExpr:
+			e = x.obj
+		case *parenExpr:
+			e = x.x
+		default:
+			return ""
+		}
+	}
+}
+
+func isLocal(scopes []map[string]bool, name string) bool {
+	for i := len(scopes) - 1; i >= 0; i-- {
+		if scopes[i][name] {
+			return true
+		}
+	}
+	return false
+}
+
+// moduleCall returns the module and the function name of a call like
+// system.getAmount().
+func moduleCall(c *callExpr) (string, string) {
+	if c.method != "" {
+		return "", ""
+	}
+	idx, ok := c.fn.(*indexExpr)
+	if !ok {
+		return "", ""
+	}
+	mod, ok := idx.obj.(*nameExpr)
+	if !ok {
+		return "", ""
+	}
+	fn, ok := idx.key.(*stringExpr)
+	if !ok {
+		return "", ""
+	}
+	return mod.name, fn.value
+}
+
+// argNames returns the names passed to a call like abi.register(f, g).
+func argNames(c *callExpr) []string {
+	var names []string
+	for _, arg := range c.args {
+		if n, ok := arg.(*nameExpr); ok {
+			names = append(names, n.name)
+		}
+	}
+	return names
+}
+
+// inspect calls f for every statement and expression under node.
+func inspect(node interface{}, f func(interface{})) {
+	if node == nil {
+		return
+	}
+	f(node)
+	each := func(nodes ...interface{}) {
+		for _, n := range nodes {
+			inspect(n, f)
+		}
+	}
+	switch n := node.(type) {
+	case []stmt:
+		for _, s := range n {
+			inspect(s, f)
+		}
+	case []expr:
+		for _, e := range n {
+			inspect(e, f)
+		}
+	case *indexExpr:
+		each(n.obj, n.key)
+	case *callExpr:
+		each(n.fn, n.args)
+	case *funcExpr:
+		each(n.body)
+	case *tableExpr:
+		each(n.keys, n.values)
+	case *binExpr:
+		each(n.l, n.r)
+	case *unExpr:
+		each(n.x)
+	case *parenExpr:
+		each(n.x)
+	case *localStmt:
+		each(n.exprs)
+	case *assignStmt:
+		each(n.targets, n.exprs)
+	case *callStmt:
+		each(n.call)
+	case *doStmt:
+		each(n.body)
+	case *whileStmt:
+		each(n.cond, n.body)
+	case *repeatStmt:
+		each(n.body, n.cond)
+	case *ifStmt:
+		each(n.conds, n.orElse)
+		for _, b := range n.blocks {
+			each(b)
+		}
+	case *numForStmt:
+		each(n.start, n.limit, n.step, n.body)
+	case *genForStmt:
+		each(n.exprs, n.body)
+	case *funcStmt:
+		each(n.name, n.fn)
+	case *returnStmt:
+		each(n.exprs)
+	}
+}
@@ -0,0 +1,142 @@
+package lint
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLint(t *testing.T) {
+	src := `
+state.var {
+  Balances = state.map(),
+  Holders = state.array(),
+  Owner = state.value(),
+}
+
+counter = 0
+
+local function credit(to, amount)
+  Balances[to] = (Balances[to] or 0) + amount
+end
+
+function constructor()
+  Owner:set(system.getSender())
+end
+
+function deposit()
+  credit(system.getSender(), bignum.number(system.getAmount()))
+end
+
+function balanceOf(addr)
+  counter = counter + 1
+  return Balances[addr]
+end
+
+function total()
+  local sum = 0
+  for i, h in Holders:ipairs() do
+    sum = sum + Balances[h]
+  end
+  return sum
+end
+
+function lottery()
+  local n = system.random(10)
+  winner = n
+  return n + bonus
+end
+
+abi.register(deposit, lottery)
+abi.register_view(balanceOf, total)
+`
+	issues, err := Lint(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		"18: function 'deposit' reads system.getAmount but is not declared with abi.payable",
+		"23: global 'counter' is not persisted across calls; declare it with state.var",
+		"29: unbounded loop over state variable 'Holders'",
+		"36: system.random takes no seed; its result is derived from the block and transaction hashes",
+		"37: assignment to undeclared global 'winner'",
+		"38: undeclared global 'bonus'",
+	}
+	var got []string
+	for _, issue := range issues {
+		got = append(got, issue.String())
+	}
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected issues:\n%s", strings.Join(got, "\n"))
+	}
+}
+
+func TestLintView(t *testing.T) {
+	src := `
+state.var { Names = state.map() }
+
+local function remember(k, v)
+  Names[k] = v
+end
+
+function get(k)
+  contract.event("get", k)
+  remember(k, "seen")
+  return Names[k]
+end
+
+abi.register_view(get)
+abi.payable(get)
+`
+	issues, err := Lint(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues) != 2 {
+		t.Fatalf("expected 2 issues, but got %v", issues)
+	}
+	if issues[0].Line != 9 || !strings.Contains(issues[0].Msg, "calls contract.event") {
+		t.Errorf("unexpected issue: %v", issues[0])
+	}
+	if issues[1].Line != 10 || !strings.Contains(issues[1].Msg, "calls 'remember' which modifies the state") {
+		t.Errorf("unexpected issue: %v", issues[1])
+	}
+}
+
+func TestLintSyntaxError(t *testing.T) {
+	for _, src := range []string{
+		"function f(",
+		"local x = ",
+		"x + 1",
+		"s = 'unfinished",
+		"--[[ unfinished",
+	} {
+		if _, err := Lint(src); err == nil {
+			t.Errorf("expected a syntax error for %q", src)
+		}
+	}
+}
+
+func TestParseSyntax(t *testing.T) {
+	src := `#!/usr/bin/env lua
+local a, b = 1, 0x1F
+local s = [==[long
+string]==] .. "esc\"aped" .. 'x'
+--[[ block
+comment ]]
+local t = { 1, 2; x = 3, ["y"] = 4, f = function(...) return ... end }
+local function f(x, ...) return -x ^ 2, not x, #t end
+repeat local z = a until z
+while a < 10 do a = a + 1 if a == 5 then break elseif a > 6 then goto done else end end
+::done::
+for i = 1, 10, 2 do end
+for k, v in pairs(t) do end
+do local u = t.x; t:insert(u) end
+local obj = {}
+function obj.m:call(x) return self, x end
+print(string.format("%d", #t), f{1}, f"str")
+return t
+`
+	if _, err := parse(src); err != nil {
+		t.Error(err)
+	}
+}
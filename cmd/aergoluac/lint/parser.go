@@ -0,0 +1,717 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package lint
+
+import "fmt"
+
+type (
+	expr interface{}
+	stmt interface{}
+)
+
+type constExpr struct {
+	line int
+}
+
+type stringExpr struct {
+	line  int
+	value string
+}
+
+type nameExpr struct {
+	line int
+	name string
+}
+
+type indexExpr struct {
+	line int
+	obj  expr
+	key  expr
+}
+
+// callExpr is a function call, or a method call if method is set
+type callExpr struct {
+	line   int
+	fn     expr
+	method string
+	args   []expr
+}
+
+type funcExpr struct {
+	line   int
+	params []string
+	body   []stmt
+}
+
+type tableExpr struct {
+	line   int
+	keys   []expr // nil for positional fields
+	values []expr
+}
+
+type binExpr struct {
+	line int
+	op   string
+	l, r expr
+}
+
+type unExpr struct {
+	line int
+	op   string
+	x    expr
+}
+
+type parenExpr struct {
+	x expr
+}
+
+type localStmt struct {
+	line  int
+	names []string
+	exprs []expr
+}
+
+type assignStmt struct {
+	line    int
+	targets []expr
+	exprs   []expr
+}
+
+type callStmt struct {
+	call *callExpr
+}
+
+type doStmt struct {
+	body []stmt
+}
+
+type whileStmt struct {
+	line int
+	cond expr
+	body []stmt
+}
+
+type repeatStmt struct {
+	line int
+	body []stmt
+	cond expr
+}
+
+type ifStmt struct {
+	conds  []expr
+	blocks [][]stmt
+	orElse []stmt
+}
+
+type numForStmt struct {
+	line               int
+	name               string
+	start, limit, step expr
+	body               []stmt
+}
+
+type genForStmt struct {
+	line  int
+	names []string
+	exprs []expr
+	body  []stmt
+}
+
+// funcStmt is a function declaration; name is a nameExpr or an indexExpr
+type funcStmt struct {
+	line  int
+	name  expr
+	local bool
+	fn    *funcExpr
+}
+
+type returnStmt struct {
+	exprs []expr
+}
+
+type parser struct {
+	toks []token
+	pos  int
+}
+
+func parse(src string) ([]stmt, error) {
+	toks, err := tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{toks: toks}
+	block, err := p.block()
+	if err != nil {
+		return nil, err
+	}
+	if p.cur().kind != tokEOF {
+		return nil, p.errorf("'<eof>' expected")
+	}
+	return block, nil
+}
+
+func (p *parser) cur() token {
+	return p.toks[p.pos]
+}
+
+func (p *parser) peekTok() token {
+	if p.pos+1 < len(p.toks) {
+		return p.toks[p.pos+1]
+	}
+	return p.toks[len(p.toks)-1]
+}
+
+func (p *parser) advance() token {
+	t := p.toks[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) is(val string) bool {
+	t := p.cur()
+	return (t.kind == tokOp || t.kind == tokKeyword) && t.val == val
+}
+
+func (p *parser) accept(val string) bool {
+	if p.is(val) {
+		p.advance()
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(val string) error {
+	if !p.accept(val) {
+		return p.errorf("'%s' expected", val)
+	}
+	return nil
+}
+
+func (p *parser) name() (string, error) {
+	t := p.cur()
+	if t.kind != tokName {
+		return "", p.errorf("<name> expected")
+	}
+	p.advance()
+	return t.val, nil
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	t := p.cur()
+	near := t.val
+	if t.kind == tokEOF {
+		near = "<eof>"
+	}
+	return fmt.Errorf("line %d: %s near '%s'", t.line, fmt.Sprintf(format, args...), near)
+}
+
+func (p *parser) blockEnd() bool {
+	t := p.cur()
+	if t.kind == tokEOF {
+		return true
+	}
+	if t.kind != tokKeyword {
+		return false
+	}
+	switch t.val {
+	case "end", "else", "elseif", "until":
+		return true
+	}
+	return false
+}
+
+func (p *parser) block() ([]stmt, error) {
+	var stmts []stmt
+	for !p.blockEnd() {
+		if p.is("return") {
+			p.advance()
+			ret := &returnStmt{}
+			if !p.blockEnd() && !p.is(";") {
+				exprs, err := p.exprList()
+				if err != nil {
+					return nil, err
+				}
+				ret.exprs = exprs
+			}
+			p.accept(";")
+			stmts = append(stmts, ret)
+			if !p.blockEnd() {
+				return nil, p.errorf("'end' expected")
+			}
+			break
+		}
+		s, err := p.statement()
+		if err != nil {
+			return nil, err
+		}
+		if s != nil {
+			stmts = append(stmts, s)
+		}
+	}
+	return stmts, nil
+}
+
+func (p *parser) blockUntil(end string) ([]stmt, error) {
+	body, err := p.block()
+	if err != nil {
+		return nil, err
+	}
+	return body, p.expect(end)
+}
+
+func (p *parser) statement() (stmt, error) {
+	line := p.cur().line
+	switch {
+	case p.accept(";"):
+		return nil, nil
+	case p.accept("if"):
+		return p.ifStatement()
+	case p.accept("while"):
+		cond, err := p.expr(0)
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect("do"); err != nil {
+			return nil, err
+		}
+		body, err := p.blockUntil("end")
+		return &whileStmt{line: line, cond: cond, body: body}, err
+	case p.accept("do"):
+		body, err := p.blockUntil("end")
+		return &doStmt{body: body}, err
+	case p.accept("for"):
+		return p.forStatement(line)
+	case p.accept("repeat"):
+		body, err := p.blockUntil("until")
+		if err != nil {
+			return nil, err
+		}
+		cond, err := p.expr(0)
+		return &repeatStmt{line: line, body: body, cond: cond}, err
+	case p.accept("function"):
+		return p.funcStatement(line)
+	case p.accept("local"):
+		if p.accept("function") {
+			name, err := p.name()
+			if err != nil {
+				return nil, err
+			}
+			fn, err := p.funcBody(line, false)
+			return &funcStmt{line: line, name: &nameExpr{line: line, name: name}, local: true, fn: fn}, err
+		}
+		names, err := p.nameList()
+		if err != nil {
+			return nil, err
+		}
+		s := &localStmt{line: line, names: names}
+		if p.accept("=") {
+			s.exprs, err = p.exprList()
+		}
+		return s, err
+	case p.accept("break"):
+		return nil, nil
+	case p.accept("goto"):
+		_, err := p.name()
+		return nil, err
+	case p.accept("::"):
+		if _, err := p.name(); err != nil {
+			return nil, err
+		}
+		return nil, p.expect("::")
+	}
+	return p.exprStatement(line)
+}
+
+func (p *parser) ifStatement() (stmt, error) {
+	s := &ifStmt{}
+	for {
+		cond, err := p.expr(0)
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect("then"); err != nil {
+			return nil, err
+		}
+		body, err := p.block()
+		if err != nil {
+			return nil, err
+		}
+		s.conds = append(s.conds, cond)
+		s.blocks = append(s.blocks, body)
+		if !p.accept("elseif") {
+			break
+		}
+	}
+	if p.accept("else") {
+		body, err := p.block()
+		if err != nil {
+			return nil, err
+		}
+		s.orElse = body
+	}
+	return s, p.expect("end")
+}
+
+func (p *parser) forStatement(line int) (stmt, error) {
+	first, err := p.name()
+	if err != nil {
+		return nil, err
+	}
+	if p.accept("=") {
+		s := &numForStmt{line: line, name: first}
+		if s.start, err = p.expr(0); err != nil {
+			return nil, err
+		}
+		if err = p.expect(","); err != nil {
+			return nil, err
+		}
+		if s.limit, err = p.expr(0); err != nil {
+			return nil, err
+		}
+		if p.accept(",") {
+			if s.step, err = p.expr(0); err != nil {
+				return nil, err
+			}
+		}
+		if err = p.expect("do"); err != nil {
+			return nil, err
+		}
+		s.body, err = p.blockUntil("end")
+		return s, err
+	}
+	s := &genForStmt{line: line, names: []string{first}}
+	for p.accept(",") {
+		name, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		s.names = append(s.names, name)
+	}
+	if err = p.expect("in"); err != nil {
+		return nil, err
+	}
+	if s.exprs, err = p.exprList(); err != nil {
+		return nil, err
+	}
+	if err = p.expect("do"); err != nil {
+		return nil, err
+	}
+	s.body, err = p.blockUntil("end")
+	return s, err
+}
+
+func (p *parser) funcStatement(line int) (stmt, error) {
+	first, err := p.name()
+	if err != nil {
+		return nil, err
+	}
+	var name expr = &nameExpr{line: line, name: first}
+	method := false
+	for p.is(".") || p.is(":") {
+		method = p.advance().val == ":"
+		key, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		name = &indexExpr{line: line, obj: name, key: &stringExpr{line: line, value: key}}
+		if method {
+			break
+		}
+	}
+	fn, err := p.funcBody(line, method)
+	return &funcStmt{line: line, name: name, fn: fn}, err
+}
+
+func (p *parser) funcBody(line int, method bool) (*funcExpr, error) {
+	fn := &funcExpr{line: line}
+	if method {
+		fn.params = append(fn.params, "self")
+	}
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	for !p.is(")") {
+		if p.accept("...") {
+			break
+		}
+		name, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		fn.params = append(fn.params, name)
+		if !p.accept(",") {
+			break
+		}
+	}
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+	body, err := p.blockUntil("end")
+	fn.body = body
+	return fn, err
+}
+
+func (p *parser) exprStatement(line int) (stmt, error) {
+	e, err := p.suffixedExpr()
+	if err != nil {
+		return nil, err
+	}
+	if p.is("=") || p.is(",") {
+		targets := []expr{e}
+		for p.accept(",") {
+			t, err := p.suffixedExpr()
+			if err != nil {
+				return nil, err
+			}
+			targets = append(targets, t)
+		}
+		for _, t := range targets {
+			switch t.(type) {
+			case *nameExpr, *indexExpr:
+			default:
+				return nil, p.errorf("syntax error")
+			}
+		}
+		if err := p.expect("="); err != nil {
+			return nil, err
+		}
+		exprs, err := p.exprList()
+		return &assignStmt{line: line, targets: targets, exprs: exprs}, err
+	}
+	call, ok := e.(*callExpr)
+	if !ok {
+		return nil, p.errorf("syntax error")
+	}
+	return &callStmt{call: call}, nil
+}
+
+func (p *parser) nameList() ([]string, error) {
+	var names []string
+	for {
+		name, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+		if !p.accept(",") {
+			return names, nil
+		}
+	}
+}
+
+func (p *parser) exprList() ([]expr, error) {
+	var exprs []expr
+	for {
+		e, err := p.expr(0)
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, e)
+		if !p.accept(",") {
+			return exprs, nil
+		}
+	}
+}
+
+// binary operator priorities from the lua parser
+var binaryPriority = map[string][2]int{
+	"+": {6, 6}, "-": {6, 6}, "*": {7, 7}, "/": {7, 7}, "%": {7, 7},
+	"^": {10, 9}, "..": {5, 4},
+	"==": {3, 3}, "~=": {3, 3}, "<": {3, 3}, "<=": {3, 3}, ">": {3, 3}, ">=": {3, 3},
+	"and": {2, 2}, "or": {1, 1},
+}
+
+const unaryPriority = 8
+
+func (p *parser) binaryOp() (string, [2]int, bool) {
+	t := p.cur()
+	if t.kind != tokOp && t.kind != tokKeyword {
+		return "", [2]int{}, false
+	}
+	prio, ok := binaryPriority[t.val]
+	return t.val, prio, ok
+}
+
+func (p *parser) expr(limit int) (expr, error) {
+	var left expr
+	var err error
+	line := p.cur().line
+	if p.is("not") || p.is("-") || p.is("#") {
+		op := p.advance().val
+		x, err := p.expr(unaryPriority)
+		if err != nil {
+			return nil, err
+		}
+		left = &unExpr{line: line, op: op, x: x}
+	} else if left, err = p.simpleExpr(); err != nil {
+		return nil, err
+	}
+	for {
+		op, prio, ok := p.binaryOp()
+		if !ok || prio[0] <= limit {
+			return left, nil
+		}
+		p.advance()
+		right, err := p.expr(prio[1])
+		if err != nil {
+			return nil, err
+		}
+		left = &binExpr{line: line, op: op, l: left, r: right}
+	}
+}
+
+func (p *parser) simpleExpr() (expr, error) {
+	t := p.cur()
+	switch t.kind {
+	case tokNumber:
+		p.advance()
+		return &constExpr{line: t.line}, nil
+	case tokString:
+		p.advance()
+		return &stringExpr{line: t.line, value: t.val}, nil
+	case tokKeyword:
+		switch t.val {
+		case "nil", "true", "false":
+			p.advance()
+			return &constExpr{line: t.line}, nil
+		case "function":
+			p.advance()
+			return p.funcBody(t.line, false)
+		}
+	case tokOp:
+		switch t.val {
+		case "...":
+			p.advance()
+			return &constExpr{line: t.line}, nil
+		case "{":
+			return p.table()
+		}
+	}
+	return p.suffixedExpr()
+}
+
+func (p *parser) primaryExpr() (expr, error) {
+	t := p.cur()
+	if t.kind == tokName {
+		p.advance()
+		return &nameExpr{line: t.line, name: t.val}, nil
+	}
+	if p.accept("(") {
+		e, err := p.expr(0)
+		if err != nil {
+			return nil, err
+		}
+		return &parenExpr{x: e}, p.expect(")")
+	}
+	return nil, p.errorf("unexpected symbol")
+}
+
+func (p *parser) suffixedExpr() (expr, error) {
+	e, err := p.primaryExpr()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := p.cur()
+		switch {
+		case p.accept("."):
+			key, err := p.name()
+			if err != nil {
+				return nil, err
+			}
+			e = &indexExpr{line: t.line, obj: e, key: &stringExpr{line: t.line, value: key}}
+		case p.accept("["):
+			key, err := p.expr(0)
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect("]"); err != nil {
+				return nil, err
+			}
+			e = &indexExpr{line: t.line, obj: e, key: key}
+		case p.accept(":"):
+			method, err := p.name()
+			if err != nil {
+				return nil, err
+			}
+			args, err := p.callArgs()
+			if err != nil {
+				return nil, err
+			}
+			e = &callExpr{line: t.line, fn: e, method: method, args: args}
+		case p.is("(") || p.is("{") || t.kind == tokString:
+			args, err := p.callArgs()
+			if err != nil {
+				return nil, err
+			}
+			e = &callExpr{line: t.line, fn: e, args: args}
+		default:
+			return e, nil
+		}
+	}
+}
+
+func (p *parser) callArgs() ([]expr, error) {
+	t := p.cur()
+	switch {
+	case t.kind == tokString:
+		p.advance()
+		return []expr{&stringExpr{line: t.line, value: t.val}}, nil
+	case p.is("{"):
+		tbl, err := p.table()
+		return []expr{tbl}, err
+	case p.accept("("):
+		if p.accept(")") {
+			return nil, nil
+		}
+		args, err := p.exprList()
+		if err != nil {
+			return nil, err
+		}
+		return args, p.expect(")")
+	}
+	return nil, p.errorf("function arguments expected")
+}
+
+func (p *parser) table() (expr, error) {
+	tbl := &tableExpr{line: p.cur().line}
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	for !p.is("}") {
+		var key expr
+		switch {
+		case p.accept("["):
+			k, err := p.expr(0)
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect("]"); err != nil {
+				return nil, err
+			}
+			if err := p.expect("="); err != nil {
+				return nil, err
+			}
+			key = k
+		case p.cur().kind == tokName && p.peekTok().kind == tokOp && p.peekTok().val == "=":
+			t := p.advance()
+			p.advance()
+			key = &stringExpr{line: t.line, value: t.val}
+		}
+		value, err := p.expr(0)
+		if err != nil {
+			return nil, err
+		}
+		tbl.keys = append(tbl.keys, key)
+		tbl.values = append(tbl.values, value)
+		if !p.accept(",") && !p.accept(";") {
+			break
+		}
+	}
+	return tbl, p.expect("}")
+}
@@ -7,8 +7,11 @@ package main
 
 import (
 	"errors"
+	"fmt"
+	"io/ioutil"
 	"os"
 
+	"github.com/aergoio/aergo/cmd/aergoluac/lint"
 	"github.com/aergoio/aergo/cmd/aergoluac/util"
 	"github.com/spf13/cobra"
 )
@@ -27,6 +30,7 @@ func init() {
 		Use:   "aergoluac --payload srcfile\n  aergoluac --abi abifile srcfile bcfile",
 		Short: "Compile a lua contract",
 		Long:  "Compile a lua contract. This command makes a bytecode file and a ABI file or prints a payload data.",
+		Args:  cobra.ArbitraryArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			var err error
 
@@ -52,6 +56,38 @@ func init() {
 	rootCmd.PersistentFlags().StringVarP(&abiFile, "abi", "a", "", "abi filename")
 	rootCmd.PersistentFlags().BoolVar(&payload, "payload", false, "print the compilation result consisting of bytecode and abi")
 	rootCmd.PersistentFlags().BoolVar(&version, "version", false, "print the version number of aergoluac")
+
+	rootCmd.AddCommand(&cobra.Command{
+		Use:   "lint srcfile...",
+		Short: "Report unsafe patterns in lua contracts",
+		Long:  "Report writes in view functions, unbounded loops over state variables, system.random calls, functions reading system.getAmount without abi.payable and undeclared globals.",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  runLint,
+	})
+}
+
+func runLint(cmd *cobra.Command, args []string) error {
+	cmd.SilenceUsage = true
+
+	count := 0
+	for _, srcFileName := range args {
+		src, err := ioutil.ReadFile(srcFileName)
+		if err != nil {
+			return err
+		}
+		issues, err := lint.Lint(string(src))
+		if err != nil {
+			return fmt.Errorf("%s: %v", srcFileName, err)
+		}
+		for _, issue := range issues {
+			fmt.Printf("%s:%s\n", srcFileName, issue)
+		}
+		count += len(issues)
+	}
+	if count > 0 {
+		return fmt.Errorf("%d issue(s) found", count)
+	}
+	return nil
 }
 
 func main() {
@@ -0,0 +1,200 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+#include <string.h>
+#include <lualib.h>
+#include <lauxlib.h>
+#include <luajit.h>
+
+#define ABI_TYPES   "__abi_types__"
+#define ABI_EVENTS  "__abi_events__"
+
+static int is_name_char(char c)
+{
+    return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') ||
+           (c >= '0' && c <= '9') || c == '_';
+}
+
+/* a name or a type, which may be followed by "[]" */
+static void check_annotation(lua_State *L, int arg, const char *s, int allow_array)
+{
+    size_t len = strlen(s);
+    size_t i;
+
+    if (allow_array && len > 2 && strcmp(s + len - 2, "[]") == 0) {
+        len -= 2;
+    }
+    if (len == 0) {
+        luaL_argerror(L, arg, "empty name");
+    }
+    for (i = 0; i < len; i++) {
+        if (!is_name_char(s[i])) {
+            luaL_argerror(L, arg, lua_pushfstring(L, "invalid name: %s", s));
+        }
+    }
+}
+
+static void add_type_list(lua_State *L, luaL_Buffer *b, int arg)
+{
+    int i, n;
+
+    if (lua_isnoneornil(L, arg)) {
+        return;
+    }
+    luaL_checktype(L, arg, LUA_TTABLE);
+    n = (int)lua_objlen(L, arg);
+    for (i = 1; i <= n; i++) {
+        const char *t;
+
+        lua_rawgeti(L, arg, i);
+        if (lua_type(L, -1) != LUA_TSTRING) {
+            luaL_argerror(L, arg, "type names expected");
+        }
+        t = lua_tostring(L, -1);
+        check_annotation(L, arg, t, 1);
+        lua_pop(L, 1);
+        if (i > 1) {
+            luaL_addchar(b, ',');
+        }
+        luaL_addstring(b, t);
+    }
+}
+
+static void push_annotation_list(lua_State *L, const char *list)
+{
+    lua_getfield(L, LUA_REGISTRYINDEX, list);
+    if (lua_isnil(L, -1)) {
+        lua_pop(L, 1);
+        lua_newtable(L);
+        lua_pushvalue(L, -1);
+        lua_setfield(L, LUA_REGISTRYINDEX, list);
+    }
+}
+
+/* the first field of every line is the annotated name */
+static void append_annotation(lua_State *L, const char *list, const char *name, int line)
+{
+    int i, n;
+    size_t len = strlen(name);
+
+    push_annotation_list(L, list);
+    n = (int)lua_objlen(L, -1);
+    for (i = 1; i <= n; i++) {
+        const char *s;
+
+        lua_rawgeti(L, -1, i);
+        s = lua_tostring(L, -1);
+        if (strncmp(s, name, len) == 0 && s[len] == '\t') {
+            luaL_error(L, "duplicated annotation: %s", name);
+        }
+        lua_pop(L, 1);
+    }
+    lua_pushvalue(L, line);
+    lua_rawseti(L, -2, n + 1);
+    lua_pop(L, 1);
+}
+
+/* abi.types(fname, {argument types}, {return types}) */
+static int abi_types(lua_State *L)
+{
+    const char *fname;
+    luaL_Buffer b;
+
+    fname = luaL_checkstring(L, 1);
+    check_annotation(L, 1, fname, 0);
+
+    luaL_buffinit(L, &b);
+    luaL_addstring(&b, fname);
+    luaL_addchar(&b, '\t');
+    add_type_list(L, &b, 2);
+    luaL_addchar(&b, '\t');
+    add_type_list(L, &b, 3);
+    luaL_pushresult(&b);
+
+    append_annotation(L, ABI_TYPES, fname, lua_gettop(L));
+    return 0;
+}
+
+/* abi.event(name, "arg:type", ...), the type of an argument is optional */
+static int abi_event(lua_State *L)
+{
+    const char *name;
+    int i, n = lua_gettop(L);
+    luaL_Buffer b;
+
+    name = luaL_checkstring(L, 1);
+    check_annotation(L, 1, name, 0);
+    for (i = 2; i <= n; i++) {
+        const char *arg = luaL_checkstring(L, i);
+        const char *sep = strchr(arg, ':');
+
+        if (sep == NULL) {
+            check_annotation(L, i, arg, 0);
+        } else {
+            lua_pushlstring(L, arg, sep - arg);
+            check_annotation(L, i, lua_tostring(L, -1), 0);
+            lua_pop(L, 1);
+            check_annotation(L, i, sep + 1, 1);
+        }
+    }
+
+    luaL_buffinit(L, &b);
+    luaL_addstring(&b, name);
+    luaL_addchar(&b, '\t');
+    for (i = 2; i <= n; i++) {
+        if (i > 2) {
+            luaL_addchar(&b, ',');
+        }
+        luaL_addstring(&b, lua_tostring(L, i));
+    }
+    luaL_pushresult(&b);
+
+    append_annotation(L, ABI_EVENTS, name, lua_gettop(L));
+    return 0;
+}
+
+/* appends the lines of the list to the string on the top */
+static void append_annotation_lines(lua_State *L, const char *list, const char *prefix)
+{
+    int i, n;
+
+    lua_getfield(L, LUA_REGISTRYINDEX, list);
+    if (lua_isnil(L, -1)) {
+        lua_pop(L, 1);
+        return;
+    }
+    lua_insert(L, -2);
+    n = (int)lua_objlen(L, -2);
+    for (i = 1; i <= n; i++) {
+        lua_pushstring(L, prefix);
+        lua_rawgeti(L, -3, i);
+        lua_pushliteral(L, "\n");
+        lua_concat(L, 4);
+    }
+    lua_remove(L, -2);
+}
+
+/* luac_abi_annotations pushes the annotations as lines of
+ * "f\tfname\targ types\treturn types" and "e\tname\targs" */
+void luac_abi_annotations(lua_State *L)
+{
+    lua_pushliteral(L, "");
+    append_annotation_lines(L, ABI_TYPES, "f\t");
+    append_annotation_lines(L, ABI_EVENTS, "e\t");
+}
+
+int luac_open_abi_types(lua_State *L)
+{
+    static const luaL_Reg abi_lib[] = {
+        {"types", abi_types},
+        {"event", abi_event},
+        {NULL, NULL}
+    };
+
+    luaL_register(L, "abi", abi_lib);
+    lua_pop(L, 1);
+
+    return 1;
+}
@@ -0,0 +1,14 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+#ifndef _ABI_MODULE_H
+#define _ABI_MODULE_H
+
+#include <lua.h>
+
+extern int luac_open_abi_types(lua_State *L);
+extern void luac_abi_annotations(lua_State *L);
+
+#endif /* _ABI_MODULE_H */
@@ -5,6 +5,7 @@
 #include <lauxlib.h>
 #include <luajit.h>
 #include "state_module.h"
+#include "abi_module.h"
 #include "_cgo_export.h"
 
 lua_State *luac_vm_newstate()
@@ -15,9 +16,22 @@ lua_State *luac_vm_newstate()
 	}
 	luaL_openlibs(L);
 	luac_open_state(L);
+	luac_open_abi_types(L);
 	return L;
 }
 
+/* luac_vm_barestate returns a state without any library, for loading
+ * and dumping sources that must not be run */
+lua_State *luac_vm_barestate()
+{
+	return luaL_newstate();
+}
+
+const char *luac_version()
+{
+	return LUAJIT_VERSION;
+}
+
 void luac_vm_close(lua_State *L)
 {
 	if (L != NULL)
@@ -48,7 +62,8 @@ static int kpt_lua_Writer(struct lua_State *L, const void *p, size_t sz, void *u
 		} \
     } while(0)
 
-const char *vm_compile(lua_State *L, const char *code, const char *byte, const char *abi)
+/* vm_compile leaves the ABI on the stack if gen_abi is set */
+const char *vm_compile(lua_State *L, const char *code, const char *byte, int gen_abi)
 {
 	FILE *f = NULL;
 
@@ -65,14 +80,8 @@ const char *vm_compile(lua_State *L, const char *code, const char *byte, const c
 	}
 	fclose(f);
 
-	if (abi != NULL && strlen(abi) > 0) {
-		f = fopen(abi, "wb");
-		if (f == NULL) {
-		    return "cannot open a abi file";
-		}
+	if (gen_abi) {
 		GEN_ABI();
-		fwrite((char *)lua_tostring(L, -1), 1, lua_strlen(L, -1), f);
-		fclose(f);
 	}
 
 	return NULL;
@@ -100,7 +109,8 @@ const char *vm_loadstring(lua_State *L, const char *code)
 	return NULL;
 }
 
-const char *vm_stringdump(lua_State *L)
+/* vm_bytecode pushes the dump of the loaded chunk without running it */
+const char *vm_bytecode(lua_State *L)
 {
 	luaL_Buffer b;
 
@@ -112,6 +122,16 @@ const char *vm_stringdump(lua_State *L)
 	if (!lua_isstring(L, -1)) {
 		return "empty bytecode";
 	}
+	return NULL;
+}
+
+const char *vm_stringdump(lua_State *L)
+{
+	const char *errMsg = vm_bytecode(L);
+
+	if (errMsg != NULL) {
+		return errMsg;
+	}
 	lua_pushvalue(L, -2);   /* code dump code */
     GEN_ABI();              /* code dump code abi */
     lua_remove(L, -2);      /* code dump abi */
@@ -4,10 +4,14 @@
 typedef struct lua_State lua_State;
 
 lua_State *luac_vm_newstate();
+lua_State *luac_vm_barestate();
 void luac_vm_close(lua_State *L);
-const char *vm_compile(lua_State *L, const char *code, const char *byte, const char *abi);
+const char *vm_compile(lua_State *L, const char *code, const char *byte, int gen_abi);
 const char *vm_loadfile(lua_State *L, const char *filename);
 const char *vm_loadstring(lua_State *L, const char *source);
+const char *vm_bytecode(lua_State *L);
 const char *vm_stringdump(lua_State *L);
+void luac_abi_annotations(lua_State *L);
+const char *luac_version();
 
 #endif /* _COMPILE_H */
\ No newline at end of file
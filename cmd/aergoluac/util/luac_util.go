@@ -13,14 +13,17 @@ import (
 	"bufio"
 	"bytes"
 	"encoding/binary"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"runtime"
+	"strings"
 	"unsafe"
 
 	"github.com/aergoio/aergo/cmd/aergoluac/encoding"
+	"github.com/aergoio/aergo/types"
 )
 
 func NewLState() *C.lua_State {
@@ -38,6 +41,11 @@ func CloseLState(L *C.lua_State) {
 	}
 }
 
+// CompilerVersion returns the version of the Lua compiler used by Compile.
+func CompilerVersion() string {
+	return C.GoString(C.luac_version())
+}
+
 func Compile(L *C.lua_State, code string) (LuaCode, error) {
 	cStr := C.CString(code)
 	defer C.free(unsafe.Pointer(cStr))
@@ -47,23 +55,52 @@ func Compile(L *C.lua_State, code string) (LuaCode, error) {
 	if errMsg := C.vm_stringdump(L); errMsg != nil {
 		return nil, errors.New(C.GoString(errMsg))
 	}
-	return dumpToBytes(L), nil
+	return dumpToBytes(L)
+}
+
+// ByteCode compiles the source into bytecode without running it, so it is
+// safe for untrusted sources. Unlike Compile, it does not generate the ABI.
+func ByteCode(code string) ([]byte, error) {
+	L := C.luac_vm_barestate()
+	if L == nil {
+		return nil, errors.New("cannot create a lua state")
+	}
+	defer C.luac_vm_close(L)
+	cStr := C.CString(code)
+	defer C.free(unsafe.Pointer(cStr))
+	if errMsg := C.vm_loadstring(L, cStr); errMsg != nil {
+		return nil, errors.New(C.GoString(errMsg))
+	}
+	if errMsg := C.vm_bytecode(L); errMsg != nil {
+		return nil, errors.New(C.GoString(errMsg))
+	}
+	return luaToBytes(L, -1), nil
 }
 
 func CompileFromFile(srcFileName, outFileName, abiFileName string) error {
 	cSrcFileName := C.CString(srcFileName)
 	cOutFileName := C.CString(outFileName)
-	cAbiFileName := C.CString(abiFileName)
 	L := C.luac_vm_newstate()
 	defer C.free(unsafe.Pointer(cSrcFileName))
 	defer C.free(unsafe.Pointer(cOutFileName))
-	defer C.free(unsafe.Pointer(cAbiFileName))
 	defer C.luac_vm_close(L)
 
-	if errMsg := C.vm_compile(L, cSrcFileName, cOutFileName, cAbiFileName); errMsg != nil {
+	var genAbi C.int
+	if len(abiFileName) > 0 {
+		genAbi = 1
+	}
+	if errMsg := C.vm_compile(L, cSrcFileName, cOutFileName, genAbi); errMsg != nil {
 		return errors.New(C.GoString(errMsg))
 	}
-	return nil
+	if genAbi == 0 {
+		return nil
+	}
+	annotations := abiAnnotations(L)
+	abi, err := mergeABIAnnotations(luaToBytes(L, -1), annotations)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(abiFileName, abi, 0644)
 }
 
 func DumpFromFile(srcFileName string) error {
@@ -79,7 +116,11 @@ func DumpFromFile(srcFileName string) error {
 		return errors.New(C.GoString(errMsg))
 	}
 
-	fmt.Println(encoding.EncodeCode(dumpToBytes(L)))
+	code, err := dumpToBytes(L)
+	if err != nil {
+		return err
+	}
+	fmt.Println(encoding.EncodeCode(code))
 	return nil
 }
 
@@ -116,18 +157,94 @@ func DumpFromStdin() error {
 	if errMsg := C.vm_stringdump(L); errMsg != nil {
 		return errors.New(C.GoString(errMsg))
 	}
-	fmt.Println(encoding.EncodeCode(dumpToBytes(L)))
+	code, err := dumpToBytes(L)
+	if err != nil {
+		return err
+	}
+	fmt.Println(encoding.EncodeCode(code))
 	return nil
 }
 
-func dumpToBytes(L *C.lua_State) LuaCode {
-	var (
-		c, a *C.char
-		lc, la C.size_t
-	)
-	c = C.lua_tolstring(L, -2, &lc)
-	a = C.lua_tolstring(L, -1, &la)
-	return NewLuaCode(C.GoBytes(unsafe.Pointer(c), C.int(lc)), C.GoBytes(unsafe.Pointer(a), C.int(la)))
+func dumpToBytes(L *C.lua_State) (LuaCode, error) {
+	annotations := abiAnnotations(L)
+	abi, err := mergeABIAnnotations(luaToBytes(L, -1), annotations)
+	if err != nil {
+		return nil, err
+	}
+	return NewLuaCode(luaToBytes(L, -2), abi), nil
+}
+
+func luaToBytes(L *C.lua_State, idx C.int) []byte {
+	var l C.size_t
+	s := C.lua_tolstring(L, idx, &l)
+	return C.GoBytes(unsafe.Pointer(s), C.int(l))
+}
+
+// abiAnnotations returns the annotations declared by abi.types and abi.event.
+func abiAnnotations(L *C.lua_State) string {
+	C.luac_abi_annotations(L)
+	annotations := string(luaToBytes(L, -1))
+	C.lua_settop(L, -2)
+	return annotations
+}
+
+// mergeABIAnnotations adds the annotated types and events to the ABI
+// generated by the abi module. The ABI is not changed without annotations.
+func mergeABIAnnotations(abi []byte, annotations string) ([]byte, error) {
+	if len(annotations) == 0 {
+		return abi, nil
+	}
+	var a types.ABI
+	if err := json.Unmarshal(abi, &a); err != nil {
+		return nil, err
+	}
+	for _, line := range strings.Split(strings.TrimSuffix(annotations, "\n"), "\n") {
+		fields := strings.Split(line, "\t")
+		switch fields[0] {
+		case "f":
+			fn := a.GetFunction(fields[1])
+			if fn == nil {
+				return nil, fmt.Errorf("abi.types: function %s is not registered", fields[1])
+			}
+			argTypes := splitAnnotation(fields[2])
+			if len(argTypes) != len(fn.Arguments) {
+				return nil, fmt.Errorf("abi.types: function %s takes %d arguments", fn.Name, len(fn.Arguments))
+			}
+			for i, t := range argTypes {
+				if !types.IsValidABIType(t) {
+					return nil, fmt.Errorf("abi.types: unknown type %s", t)
+				}
+				fn.Arguments[i].Type = t
+			}
+			for _, t := range splitAnnotation(fields[3]) {
+				if !types.IsValidABIType(t) {
+					return nil, fmt.Errorf("abi.types: unknown type %s", t)
+				}
+				fn.Returns = append(fn.Returns, &types.FnArgument{Type: t})
+			}
+		case "e":
+			ev := &types.EventSchema{Name: fields[1]}
+			for _, arg := range splitAnnotation(fields[2]) {
+				name, t := arg, ""
+				if i := strings.IndexByte(arg, ':'); i >= 0 {
+					name, t = arg[:i], arg[i+1:]
+					if !types.IsValidABIType(t) {
+						return nil, fmt.Errorf("abi.event: unknown type %s", t)
+					}
+				}
+				ev.Arguments = append(ev.Arguments, &types.FnArgument{Name: name, Type: t})
+			}
+			a.Events = append(a.Events, ev)
+		}
+	}
+	return json.Marshal(&a)
+}
+
+func splitAnnotation(s string) []string {
+	if len(s) == 0 {
+		return nil
+	}
+	return strings.Split(s, ",")
 }
 
 type LuaCode []byte
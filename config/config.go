@@ -94,6 +94,11 @@ func (ctx *ServerContext) GetDefaultP2PConfig() *P2PConfig {
 		NPUsePolaris:    true,
 		NPExposeSelf:    true,
 		PeerRole:        "",
+
+		// per peer message rates are generous enough for normal sync and gossip
+		NPPeerTxMsgRate:    300,
+		NPPeerBlockMsgRate: 100,
+		NPPeerAddrMsgRate:  5,
 	}
 }
 
@@ -108,6 +113,7 @@ func (ctx *ServerContext) GetDefaultPolarisConfig() *PolarisConfig {
 		GenesisFile:     "",
 		AllowPrivate:    false,
 		EnableBlacklist: true,
+		EnablePersist:   true,
 	}
 }
 
@@ -34,6 +34,13 @@ func isFork(forkBlkNo, currBlkNo types.BlockNo) bool {
 	return forkBlkNo <= currBlkNo
 }
 
+// isFork reports whether the fork of the version in the chain db is passed at the block. The version which is not
+// written in the chain db, because the db was written by an older node, is not forked yet.
+func (c HardforkDbConfig) isFork(version string, h types.BlockNo) bool {
+	bno, exist := c[version]
+	return exist && isFork(bno, h)
+}
+
 func checkOlderNode(maxVer uint64, latest types.BlockNo, dbCfg HardforkDbConfig) error {
 	for k, bno := range dbCfg {
 		ver, err := strconv.ParseUint(k[1:], 10, 64)
@@ -67,7 +67,7 @@ func (c *HardforkConfig) CheckCompatibility(dbCfg HardforkDbConfig, h types.Bloc
 		return err
 	}
 {{- range .Hardforks}}
-	if (isFork(c.V{{.Version}}, h) || isFork(dbCfg["V{{.Version}}"], h)) && c.V{{.Version}} != dbCfg["V{{.Version}}"] {
+	if (isFork(c.V{{.Version}}, h) || dbCfg.isFork("V{{.Version}}", h)) && c.V{{.Version}} != dbCfg["V{{.Version}}"] {
 		return newForkError("V{{.Version}}", h, c.V{{.Version}}, dbCfg["V{{.Version}}"])
 	}
 {{- end}}
@@ -72,7 +72,8 @@ v2 = "9223"
 func TestCompatibility(t *testing.T) {
 	cfg := readConfig(`
 [hardfork]
-v2 = "9223"`,
+v2 = "9223"
+v3 = "11000"`,
 	)
 	dbCfg, _ := readDbConfig(`
 {
@@ -125,7 +126,7 @@ v2 = "9223"`,
 	)
 	err = cfg.CheckCompatibility(dbCfg, 10000)
 	if err == nil {
-		t.Error(`the expected error: the fork "V3" is incompatible: latest block(10000), node(0), and chain(10000)`)
+		t.Error(`the expected error: the fork "V3" is incompatible: latest block(10000), node(11000), and chain(10000)`)
 	}
 
 	dbCfg, _ = readDbConfig(`
@@ -136,7 +137,22 @@ v2 = "9223"`,
 	)
 	err = cfg.CheckCompatibility(dbCfg, 10001)
 	if err == nil {
-		t.Error(`the expected error: the fork "V3" is incompatible: latest block(10000), node(0), and chain(10000)`)
+		t.Error(`the expected error: the fork "V3" is incompatible: latest block(10001), node(11000), and chain(10000)`)
+	}
+
+	// db written by the node which doesn't know V3
+	dbCfg, _ = readDbConfig(`
+{
+	"V2": 9223
+}`,
+	)
+	err = cfg.CheckCompatibility(dbCfg, 10999)
+	if err != nil {
+		t.Error(err)
+	}
+	err = cfg.CheckCompatibility(dbCfg, 11000)
+	if err == nil {
+		t.Error(`the expected error: the fork "V3" is incompatible: latest block(11000), node(11000), and chain(0)`)
 	}
 
 	dbCfg, _ = readDbConfig(`
@@ -185,13 +201,16 @@ v3 = "10000"`,
 			9322,
 			2,
 		},
-		/*
-			{
-				"greater v3",
-				19322,
-				3,
-			},
-		*/
+		{
+			"equal v3",
+			10000,
+			3,
+		},
+		{
+			"greater v3",
+			19322,
+			3,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -76,6 +76,27 @@ type P2PConfig struct {
 	NPUsePolaris   bool     `mapstructure:"npusepolaris" description:"Whether to connect and get node list from polaris"`
 	NPAddPolarises []string `mapstructure:"npaddpolarises" description:"Add addresses of polarises if default polaris is not sufficient"`
 
+	NPPolarisPeerRoles   []string `mapstructure:"nppolarispeerroles" description:"Roles of peers to get from polaris; producer, watcher or agent. All roles if not set"`
+	NPPolarisMinVersion  string   `mapstructure:"nppolarisminversion" description:"Minimum aergo version of peers to get from polaris. No limit if not set"`
+	NPPolarisMaxBlockLag uint64   `mapstructure:"nppolarismaxblocklag" description:"Max blocks that best block of peers to get from polaris can be behind. 0 means no limit"`
+
+	NPUseDHT         bool     `mapstructure:"npusedht" description:"Whether to discover peers of same chain by Kademlia DHT"`
+	NPBootstrapPeers []string `mapstructure:"npbootstrappeers" description:"Addresses of peers to join DHT at startup. Designated peers are used if not set"`
+
+	// rate limits of incoming messages by subprotocol class. zero means unlimited
+	NPPeerTxMsgRate       int `mapstructure:"nppeertxmsgrate" description:"Max number of tx messages per second from a peer. 0 means unlimited"`
+	NPPeerTxByteRate      int `mapstructure:"nppeertxbyterate" description:"Max bytes of tx messages per second from a peer. 0 means unlimited"`
+	NPPeerBlockMsgRate    int `mapstructure:"nppeerblockmsgrate" description:"Max number of block messages per second from a peer. 0 means unlimited"`
+	NPPeerBlockByteRate   int `mapstructure:"nppeerblockbyterate" description:"Max bytes of block messages per second from a peer. 0 means unlimited"`
+	NPPeerAddrMsgRate     int `mapstructure:"nppeeraddrmsgrate" description:"Max number of address exchange messages per second from a peer. 0 means unlimited"`
+	NPPeerAddrByteRate    int `mapstructure:"nppeeraddrbyterate" description:"Max bytes of address exchange messages per second from a peer. 0 means unlimited"`
+	NPGlobalTxMsgRate     int `mapstructure:"npglobaltxmsgrate" description:"Max number of tx messages per second from all peers. 0 means unlimited"`
+	NPGlobalTxByteRate    int `mapstructure:"npglobaltxbyterate" description:"Max bytes of tx messages per second from all peers. 0 means unlimited"`
+	NPGlobalBlockMsgRate  int `mapstructure:"npglobalblockmsgrate" description:"Max number of block messages per second from all peers. 0 means unlimited"`
+	NPGlobalBlockByteRate int `mapstructure:"npglobalblockbyterate" description:"Max bytes of block messages per second from all peers. 0 means unlimited"`
+	NPGlobalAddrMsgRate   int `mapstructure:"npglobaladdrmsgrate" description:"Max number of address exchange messages per second from all peers. 0 means unlimited"`
+	NPGlobalAddrByteRate  int `mapstructure:"npglobaladdrbyterate" description:"Max bytes of address exchange messages per second from all peers. 0 means unlimited"`
+
 	LogFullPeerID bool `mapstructure:"logfullpeerid" description:"Whether to use full legnth peerID or short form"`
 
 	PeerRole      string   `mapstructure:"peerrole" description:"Role of peer. It must be sync with enablebp field in consensus config "`
@@ -91,9 +112,11 @@ type AuthConfig struct {
 
 // PolarisConfig defines configuration for polaris server and client (i.e. polarisConnect)
 type PolarisConfig struct {
-	AllowPrivate    bool   `mapstructure:"allowprivate" description:"allow peer to have private address. for private network and test"`
-	GenesisFile     string `mapstructure:"genesisfile" description:"json file containing informations of genesisblock to which polaris refer "`
-	EnableBlacklist bool   `mapstructure:"enableblacklist" description:"allow peer to have private address. for private network and test"`
+	AllowPrivate    bool     `mapstructure:"allowprivate" description:"allow peer to have private address. for private network and test"`
+	GenesisFile     string   `mapstructure:"genesisfile" description:"json file containing informations of genesisblock to which polaris refer "`
+	EnableBlacklist bool     `mapstructure:"enableblacklist" description:"allow peer to have private address. for private network and test"`
+	EnablePersist   bool     `mapstructure:"enablepersist" description:"save registered peers to local db and load them at startup"`
+	Federation      []string `mapstructure:"federation" description:"addresses of other polaris servers to exchange registered peers with"`
 }
 
 // BlockchainConfig defines configurations for blockchain service
@@ -221,12 +244,38 @@ npusepolaris = {{.P2P.NPUsePolaris}}
 npaddpolarises = [{{range .P2P.NPAddPolarises}}
 "{{.}}", {{end}}
 ]
+nppolarispeerroles = [{{range .P2P.NPPolarisPeerRoles}}
+"{{.}}", {{end}}
+]
+nppolarisminversion = "{{.P2P.NPPolarisMinVersion}}"
+nppolarismaxblocklag = {{.P2P.NPPolarisMaxBlockLag}}
+npusedht = {{.P2P.NPUseDHT}}
+npbootstrappeers = [{{range .P2P.NPBootstrapPeers}}
+"{{.}}", {{end}}
+]
+# rate limits of incoming messages. 0 means unlimited
+nppeertxmsgrate = {{.P2P.NPPeerTxMsgRate}}
+nppeertxbyterate = {{.P2P.NPPeerTxByteRate}}
+nppeerblockmsgrate = {{.P2P.NPPeerBlockMsgRate}}
+nppeerblockbyterate = {{.P2P.NPPeerBlockByteRate}}
+nppeeraddrmsgrate = {{.P2P.NPPeerAddrMsgRate}}
+nppeeraddrbyterate = {{.P2P.NPPeerAddrByteRate}}
+npglobaltxmsgrate = {{.P2P.NPGlobalTxMsgRate}}
+npglobaltxbyterate = {{.P2P.NPGlobalTxByteRate}}
+npglobalblockmsgrate = {{.P2P.NPGlobalBlockMsgRate}}
+npglobalblockbyterate = {{.P2P.NPGlobalBlockByteRate}}
+npglobaladdrmsgrate = {{.P2P.NPGlobalAddrMsgRate}}
+npglobaladdrbyterate = {{.P2P.NPGlobalAddrByteRate}}
 peerrole = "{{.P2P.PeerRole}}"
 
 [polaris]
 allowprivate = {{.Polaris.AllowPrivate}}
 genesisfile = "{{.Polaris.GenesisFile}}"
 enableblacklist = "{{.Polaris.EnableBlacklist}}"
+enablepersist = {{.Polaris.EnablePersist}}
+federation = [{{range .Polaris.Federation}}
+"{{.}}", {{end}}
+]
 
 [blockchain]
 # blockchain configurations
@@ -75,19 +75,21 @@ type BlockGenerator struct {
 	hs               component.ICompSyncRequester
 	bi               *types.BlockHeaderInfo
 	txOp             TxOp
+	execScheduled    chain.ScheduledExecFn
 	fetchTXs         func(component.ICompSyncRequester, uint32) []types.Transaction
 	skipEmpty        bool
 	maxBlockBodySize uint32
 }
 
 func NewBlockGenerator(hs component.ICompSyncRequester, bi *types.BlockHeaderInfo, bState *state.BlockState,
-	txOp TxOp, skipEmpty bool) *BlockGenerator {
+	txOp TxOp, execScheduled chain.ScheduledExecFn, skipEmpty bool) *BlockGenerator {
 	return &BlockGenerator{
 		bState: bState,
 
 		hs:               hs,
 		bi:               bi,
 		txOp:             txOp,
+		execScheduled:    execScheduled,
 		fetchTXs:         FetchTXs,
 		skipEmpty:        skipEmpty,
 		maxBlockBodySize: MaxBlockBodySize(),
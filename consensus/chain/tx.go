@@ -136,6 +136,13 @@ func (g *BlockGenerator) GatherTXs() ([]types.Transaction, error) {
 		contract.CloseDatabase()
 	}()
 
+	// The scheduled contract calls are executed before the transactions as
+	// the chain service does, but their receipts follow the transactions'.
+	scheduled, err := g.execScheduled(bState)
+	if err != nil {
+		return nil, err
+	}
+
 	if nCand > 0 {
 		op := NewCompTxOp(g.txOp)
 
@@ -193,6 +200,12 @@ func (g *BlockGenerator) GatherTXs() ([]types.Transaction, error) {
 		nCollected = len(txRes)
 	}
 
+	for _, r := range scheduled {
+		if err := bState.AddReceipt(r); err != nil {
+			return nil, err
+		}
+	}
+
 	// Warning: This line must be run even with 0 gathered TXs, since the
 	// function below includes voting reward as well as BP reward.
 	if err := chain.SendBlockReward(bState, chain.CoinbaseAccount); err != nil {
@@ -237,7 +237,8 @@ func (bf *BlockFactory) generateBlock(bpi *bpInfo, lpbNo types.BlockNo) (block *
 	bs.Receipts().SetHardFork(bf.bv, bi.No)
 
 	bGen := chain.NewBlockGenerator(
-		bf, bi, bs, chain.NewCompTxOp(bf.txOp, newTxExec(bpi.ChainDB, bi)), false).
+		bf, bi, bs, chain.NewCompTxOp(bf.txOp, newTxExec(bpi.ChainDB, bi)),
+		bc.NewScheduledExecutor(bpi.ChainDB, bi, contract.BlockFactory), false).
 		WithDeco(bf.deco()).
 		SetNoTTE(bf.noTTE)
 
@@ -19,6 +19,7 @@ import (
 	"github.com/aergoio/aergo/contract"
 	"github.com/aergoio/aergo/contract/system"
 	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/internal/loglevel"
 	"github.com/aergoio/aergo/p2p/p2pcommon"
 	"github.com/aergoio/aergo/p2p/p2pkey"
 	"github.com/aergoio/aergo/pkg/component"
@@ -28,7 +29,7 @@ import (
 )
 
 var (
-	logger     *log.Logger
+	logger     *loglevel.Logger
 	httpLogger *log.Logger
 )
 
@@ -40,7 +41,7 @@ var (
 )
 
 func init() {
-	logger = log.NewLogger("raft")
+	logger = loglevel.NewLogger("raft")
 	httpLogger = log.NewLogger("rafthttp")
 }
 
@@ -515,7 +516,9 @@ func (bf *BlockFactory) generateBlock(work *Work) (*types.Block, *state.BlockSta
 	blockState.SetGasPrice(system.GetGasPriceFromState(blockState))
 	blockState.Receipts().SetHardFork(bf.bv, bi.No)
 
-	block, err := chain.NewBlockGenerator(bf, bi, blockState, txOp, RaftSkipEmptyBlock).GenerateBlock()
+	execScheduled := bc.NewScheduledExecutor(bf.ChainWAL, bi, contract.BlockFactory)
+
+	block, err := chain.NewBlockGenerator(bf, bi, blockState, txOp, execScheduled, RaftSkipEmptyBlock).GenerateBlock()
 	if err == chain.ErrBlockEmpty {
 		//need reset previous work
 		return nil, nil, chain.ErrBlockEmpty
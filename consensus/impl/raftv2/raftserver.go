@@ -71,7 +71,7 @@ const (
 )
 
 func init() {
-	raftLogger = NewRaftLogger(logger)
+	raftLogger = NewRaftLogger(logger.Logger)
 }
 
 // A key-value stream backed by raft
@@ -191,7 +191,9 @@ func (s *SimpleBlockFactory) Start() {
 				blockState.Receipts().SetHardFork(s.bv, bi.No)
 				txOp := chain.NewCompTxOp(s.txOp, newTxExec(s.ChainDB, bi))
 
-				block, err := chain.NewBlockGenerator(s, bi, blockState, txOp, false).GenerateBlock()
+				execScheduled := bc.NewScheduledExecutor(contract.ChainAccessor(s.ChainDB), bi, contract.BlockFactory)
+
+				block, err := chain.NewBlockGenerator(s, bi, blockState, txOp, execScheduled, false).GenerateBlock()
 				if err == chain.ErrQuit {
 					return
 				} else if err != nil {
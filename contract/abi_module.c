@@ -0,0 +1,50 @@
+#include <lauxlib.h>
+#include "abi_module.h"
+#include "vm.h"
+
+/* The annotations are added to the ABI when a contract is compiled, so at
+ * run time they only check the hardfork and their arguments. */
+
+static void abi_check_fork(lua_State *L, const char *name)
+{
+    if (!vm_is_hardfork(L, 3)) {
+        luaL_error(L, "abi.%s is not supported", name);
+    }
+}
+
+static int abi_types(lua_State *L)
+{
+    abi_check_fork(L, "types");
+    luaL_checkstring(L, 1);
+    if (!lua_isnoneornil(L, 2)) {
+        luaL_checktype(L, 2, LUA_TTABLE);
+    }
+    if (!lua_isnoneornil(L, 3)) {
+        luaL_checktype(L, 3, LUA_TTABLE);
+    }
+    return 0;
+}
+
+static int abi_event(lua_State *L)
+{
+    int i, n = lua_gettop(L);
+
+    abi_check_fork(L, "event");
+    for (i = 1; i <= n; i++) {
+        luaL_checkstring(L, i);
+    }
+    return 0;
+}
+
+static const luaL_Reg abi_lib[] = {
+	{"types", abi_types},
+	{"event", abi_event},
+	{NULL, NULL}
+};
+
+int luaopen_abi_types(lua_State *L)
+{
+	luaL_register(L, "abi", abi_lib);
+	lua_pop(L, 1);
+	return 1;
+}
@@ -3,14 +3,17 @@ package contract
 import "C"
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"strconv"
 
 	"github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/contract/system"
 	"github.com/aergoio/aergo/fee"
 	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
 	"github.com/minio/sha256-simd"
 )
 
@@ -121,8 +124,9 @@ func Execute(
 	if err != nil {
 		return
 	}
+	var isUpgrade bool
 	if receiver.IsRedeploy() {
-		if err = checkRedeploy(sender, receiver, contractState); err != nil {
+		if isUpgrade, err = checkRedeploy(sender, receiver, contractState, bi.Version); err != nil {
 			return
 		}
 		bs.RemoveCache(receiver.AccountID())
@@ -154,6 +158,7 @@ func Execute(
 		ctx := newVmContext(bs, cdb, sender, receiver, contractState, sender.ID(),
 			tx.GetHash(), bi, "", true, false, receiver.RP(),
 			preLoadService, txBody.GetAmountBigInt(), gasLimit, isFeeDelegation)
+		ctx.curContract.callState.isUpgrade = isUpgrade
 
 		if receiver.IsDeploy() {
 			rv, events, ctrFee, err = Create(contractState, txBody.Payload, receiver.ID(), ctx)
@@ -194,6 +199,71 @@ func Execute(
 	return rv, events, usedFee, nil
 }
 
+// ExecuteScheduledCall executes call scheduled by a contract at the block
+// bi.No. The prepaid fee of call must be withdrawn from the system account
+// beforehand. The unused part of it is refunded to the contract and the fee
+// actually charged is returned.
+func ExecuteScheduledCall(
+	bs *state.BlockState,
+	cdb ChainAccessor,
+	call *system.ScheduledCall,
+	txHash []byte,
+	bi *types.BlockHeaderInfo,
+	preLoadService int,
+) (rv string, events []*types.Event, usedFee *big.Int, err error) {
+	receiver, err := bs.GetAccountStateV(call.Contract)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	usedFee = new(big.Int)
+	if len(receiver.State().CodeHash) == 0 {
+		err = newVmError(fmt.Errorf("not found contract %s", types.EncodeAddress(call.Contract)))
+	} else {
+		var contractState *state.ContractState
+		contractState, err = bs.OpenContractState(receiver.AccountID(), receiver.State())
+		if err != nil {
+			return "", nil, nil, err
+		}
+		name, _ := json.Marshal(call.Function)
+		args := call.Args
+		if len(args) == 0 {
+			args = "[]"
+		}
+		payload := []byte(`{"Name":` + string(name) + `,"Args":` + args + `}`)
+
+		ctx := newVmContext(bs, cdb, nil, receiver, contractState, receiver.ID(),
+			txHash, bi, "", true, false, receiver.RP(),
+			preLoadService, zeroBig, call.GasLimit, false)
+		if ctx.traceFile != nil {
+			defer ctx.traceFile.Close()
+		}
+		rv, events, usedFee, err = Call(contractState, payload, receiver.ID(), ctx)
+		if err != nil {
+			if isSystemError(err) {
+				return "", events, usedFee, err
+			}
+			err = newVmError(err)
+		} else if sErr := bs.StageContractState(contractState); sErr != nil {
+			return "", events, usedFee, sErr
+		}
+	}
+	if usedFee.Cmp(call.Fee) > 0 {
+		usedFee = new(big.Int).Set(call.Fee)
+	}
+
+	// the state of the contract is reverted on error, but the refund is not
+	if err != nil {
+		rv = ""
+		receiver.Reset()
+	}
+	receiver.AddBalance(new(big.Int).Sub(call.Fee, usedFee))
+	if sErr := receiver.PutState(); sErr != nil {
+		return "", events, usedFee, sErr
+	}
+	return rv, events, usedFee, err
+}
+
 func txFee(payloadSize int, GasPrice *big.Int, version int32) *big.Int {
 	if version < 2 {
 		return fee.PayloadTxFee(payloadSize)
@@ -277,20 +347,85 @@ func CreateContractID(account []byte, nonce uint64) []byte {
 	return append([]byte{0x0C}, recipientHash...) // prepend 0x0C to make it same length as account addresses
 }
 
-func checkRedeploy(sender, receiver *state.V, contractState *state.ContractState) error {
+// checkRedeploy checks that the sender can replace the code of the contract.
+// An upgradeable contract can be redeployed by its upgrade owner, and the
+// others only by the creator on a private chain. It reports whether the
+// contract is upgradeable. Contracts are upgradeable since V3.
+func checkRedeploy(sender, receiver *state.V, contractState *state.ContractState, version int32) (bool, error) {
 	if len(receiver.State().CodeHash) == 0 || receiver.IsNew() {
 		receiverAddr := types.EncodeAddress(receiver.ID())
 		ctrLgr.Warn().Str("error", "not found contract").Str("contract", receiverAddr).Msg("redeploy")
-		return newVmError(fmt.Errorf("not found contract %s", receiverAddr))
+		return false, newVmError(fmt.Errorf("not found contract %s", receiverAddr))
+	}
+	senderAddr := []byte(types.EncodeAddress(sender.ID()))
+	if version >= 3 {
+		owner, err := contractState.GetData(upgradeOwnerMetaKey)
+		if err != nil {
+			return false, err
+		}
+		if len(owner) > 0 {
+			if !bytes.Equal(owner, senderAddr) {
+				return false, newVmError(types.ErrUpgradeOwnerNotMatch)
+			}
+			return true, nil
+		}
+	}
+	if PubNet {
+		return false, newVmError(types.ErrNotUpgradeable)
 	}
 	creator, err := contractState.GetData(creatorMetaKey)
+	if err != nil {
+		return false, err
+	}
+	if !bytes.Equal(creator, senderAddr) {
+		return false, newVmError(types.ErrCreatorNotMatch)
+	}
+	return false, nil
+}
+
+// addCodeHistory records the current code of an upgradeable contract.
+func addCodeHistory(ctx *vmContext, contractState *state.ContractState, deployer []byte) error {
+	if ctx.blockInfo.Version < 3 {
+		return nil
+	}
+	history, err := GetCodeHistory(contractState)
+	if err != nil {
+		return err
+	}
+	if len(history.Versions) == 0 {
+		owner, err := contractState.GetData(upgradeOwnerMetaKey)
+		if err != nil || len(owner) == 0 {
+			return err
+		}
+	}
+	history.Versions = append(history.Versions, &types.ContractCodeVersion{
+		Version:  uint64(len(history.Versions) + 1),
+		CodeHash: contractState.State.GetCodeHash(),
+		BlockNo:  ctx.blockInfo.No,
+		TxHash:   ctx.txHash,
+		Deployer: deployer,
+	})
+	data, err := proto.Marshal(history)
 	if err != nil {
 		return err
 	}
-	if !bytes.Equal(creator, []byte(types.EncodeAddress(sender.ID()))) {
-		return newVmError(types.ErrCreatorNotMatch)
+	return contractState.SetData(codeHistoryMetaKey, data)
+}
+
+// GetCodeHistory returns the code versions of an upgradeable contract. It is
+// empty for the other contracts.
+func GetCodeHistory(contractState *state.ContractState) (*types.CodeHistory, error) {
+	data, err := contractState.GetData(codeHistoryMetaKey)
+	if err != nil {
+		return nil, err
+	}
+	history := &types.CodeHistory{}
+	if len(data) > 0 {
+		if err := proto.Unmarshal(data, history); err != nil {
+			return nil, err
+		}
 	}
-	return nil
+	return history, nil
 }
 
 func useGas(version int32) bool {
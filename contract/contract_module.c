@@ -88,6 +88,16 @@ static int call_gas(lua_State *L)
     return set_gas(L, call_str);
 }
 
+/* rethrow the revert reason of a called contract as a table */
+static void throw_revert(lua_State *L, char *payload)
+{
+	if (lua_util_json_to_lua(L, payload, false) != 0) {
+		lua_pushstring(L, payload);
+	}
+	free(payload);
+	luaL_throwerror(L);
+}
+
 static int moduleCall(lua_State *L)
 {
 	char *contract;
@@ -141,6 +151,11 @@ static int moduleCall(lua_State *L)
 		strPushAndRelease(L, ret.r1);
 		luaL_throwerror(L);
 	}
+	if (ret.r2 != NULL) {
+		free(json_args);
+	    reset_amount_info(L);
+		throw_revert(L, ret.r2);
+	}
 	free(json_args);
 	reset_amount_info(L);
 	return ret.r0;
@@ -183,6 +198,11 @@ static int moduleDelegateCall(lua_State *L)
 		strPushAndRelease(L, ret.r1);
 		luaL_throwerror(L);
 	}
+	if (ret.r2 != NULL) {
+		free(json_args);
+	    reset_amount_info(L);
+		throw_revert(L, ret.r2);
+	}
 	free(json_args);
 	reset_amount_info(L);
 
@@ -392,7 +412,7 @@ static int moduleEvent(lua_State *L)
 	if (json_args == NULL) {
 		luaL_throwerror(L);
 	}
-	errStr = luaEvent(L, service, event_name, json_args);
+	errStr = luaEvent(L, service, event_name, json_args, 0);
 	if (errStr != NULL) {
 	    strPushAndRelease(L, errStr);
 	    luaL_throwerror(L);
@@ -401,6 +421,39 @@ static int moduleEvent(lua_State *L)
 	return 0;
 }
 
+/* moduleEventIndexed emits an event of which the first n arguments are
+ * indexed: contract.event_indexed(name, n, ...) */
+static int moduleEventIndexed(lua_State *L)
+{
+	char *event_name;
+	char *json_args;
+	int n;
+	int service = getLuaExecContext(L);
+	char *errStr;
+
+	if (!vm_is_hardfork(L, 3)) {
+		luaL_error(L, "contract.event_indexed is not supported");
+	}
+    lua_gasuse(L, 500);
+
+	event_name = (char *)luaL_checkstring(L, 1);
+	n = luaL_checkint(L, 2);
+	if (n > 0) {
+		lua_gasuse_mul(L, 100, n);
+	}
+	json_args = lua_util_get_json_array_from_stack (L, 3, lua_gettop(L), true);
+	if (json_args == NULL) {
+		luaL_throwerror(L);
+	}
+	errStr = luaEvent(L, service, event_name, json_args, n);
+	free(json_args);
+	if (errStr != NULL) {
+	    strPushAndRelease(L, errStr);
+	    luaL_throwerror(L);
+	}
+	return 0;
+}
+
 static int governance(lua_State *L, char type) {
 	char *ret;
 	int service = getLuaExecContext(L);
@@ -467,6 +520,98 @@ static int moduleVoteDao(lua_State *L) {
     return governance(L, 'D');
 }
 
+/* contract.setUpgradeOwner(owner) makes the contract upgradeable by owner.
+ * It is allowed only in the constructor unless the contract is already
+ * upgradeable, and an empty owner makes the contract immutable. */
+static int moduleSetUpgradeOwner(lua_State *L)
+{
+	char *errStr;
+	int service = getLuaExecContext(L);
+
+	if (!vm_is_hardfork(L, 3)) {
+		luaL_error(L, "contract.setUpgradeOwner is not supported");
+	}
+    lua_gasuse(L, 300);
+
+	errStr = luaSetUpgradeOwner(L, service, (char *)luaL_optstring(L, 1, ""));
+	if (errStr != NULL) {
+	    strPushAndRelease(L, errStr);
+	    luaL_throwerror(L);
+	}
+	return 0;
+}
+
+/* contract.upgrade(contract, code_contract, ...) replaces the code of
+ * contract, whose upgrade owner must be the calling contract, with the code of
+ * the deployed contract code_contract, and calls its migrate function with
+ * the rest of the arguments. It returns the results of migrate. */
+static int moduleUpgrade(lua_State *L)
+{
+	char *contract;
+	char *code_contract;
+	char *json_args;
+	struct luaUpgradeContract_return ret;
+	int service = getLuaExecContext(L);
+
+	if (!vm_is_hardfork(L, 3)) {
+		luaL_error(L, "contract.upgrade is not supported");
+	}
+    lua_gasuse(L, 5000);
+
+	contract = (char *)luaL_checkstring(L, 1);
+	code_contract = (char *)luaL_checkstring(L, 2);
+	json_args = lua_util_get_json_from_stack (L, 3, lua_gettop(L), false);
+	if (json_args == NULL) {
+		luaL_throwerror(L);
+	}
+	ret = luaUpgradeContract(L, service, contract, code_contract, json_args);
+	free(json_args);
+	if (ret.r0 < 0) {
+		strPushAndRelease(L, ret.r1);
+		luaL_throwerror(L);
+	}
+	return ret.r0;
+}
+
+/* contract.schedule(block_no, gas, fname, ...) registers a call to the
+ * contract itself, which is executed at the beginning of the block block_no.
+ * The fee for gas is prepaid from the balance of the contract and the
+ * unused part of it is refunded after the call. It returns the tx hash to
+ * which the receipt of the call is attributed. */
+static int moduleSchedule(lua_State *L)
+{
+	lua_Integer block_no;
+	lua_Integer gas;
+	char *fname;
+	char *json_args;
+	struct luaSchedule_return ret;
+	int service = getLuaExecContext(L);
+
+	if (!vm_is_hardfork(L, 3)) {
+		luaL_error(L, "contract.schedule is not supported");
+	}
+    lua_gasuse(L, 2000);
+
+	block_no = luaL_checkinteger(L, 1);
+	gas = luaL_checkinteger(L, 2);
+	fname = (char *)luaL_checkstring(L, 3);
+	if (block_no <= 0 || gas <= 0) {
+		luaL_error(L, "invalid number");
+	}
+	json_args = lua_util_get_json_from_stack (L, 4, lua_gettop(L), false);
+	if (json_args == NULL) {
+		luaL_throwerror(L);
+	}
+	ret = luaSchedule(L, service, block_no, gas, fname, json_args);
+	free(json_args);
+	if (ret.r1 != NULL) {
+	    strPushAndRelease(L, ret.r1);
+	    luaL_throwerror(L);
+	}
+	strPushAndRelease(L, ret.r0);
+	return 1;
+}
+
 static const luaL_Reg call_methods[] = {
 	{"value", call_value},
 	{"amount", call_value},
@@ -505,10 +650,14 @@ static const luaL_Reg contract_lib[] = {
 	{"send", moduleSend},
 	{"pcall", modulePcall},
 	{"event", moduleEvent},
+	{"event_indexed", moduleEventIndexed},
 	{"stake", moduleStake},
 	{"unstake", moduleUnstake},
 	{"vote", moduleVote},
 	{"voteDao", moduleVoteDao},
+	{"setUpgradeOwner", moduleSetUpgradeOwner},
+	{"upgrade", moduleUpgrade},
+	{"schedule", moduleSchedule},
 	{NULL, NULL}
 };
 
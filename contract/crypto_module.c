@@ -1,5 +1,6 @@
 #include "_cgo_export.h"
 #include "util.h"
+#include "vm.h"
 
 extern int getLuaExecContext(lua_State *L);
 
@@ -150,11 +151,187 @@ static int crypto_keccak256(lua_State *L)
 	return 1;
 }
 
+/* the functions below are added at the V3 hardfork */
+static void crypto_check_fork(lua_State *L, const char *name)
+{
+    if (!vm_is_hardfork(L, 3)) {
+        luaL_error(L, "crypto.%s is not supported", name);
+    }
+}
+
+static int crypto_ripemd160(lua_State *L)
+{
+    size_t len;
+    char *arg;
+    struct luaCryptoRipemd160_return ret;
+
+    crypto_check_fork(L, "ripemd160");
+    lua_gasuse(L, 500);
+    luaL_checktype(L, 1, LUA_TSTRING);
+    arg = (char *)lua_tolstring(L, 1, &len);
+
+    ret = luaCryptoRipemd160(arg, len);
+    lua_pushlstring(L, ret.r0, ret.r1);
+    free(ret.r0);
+	return 1;
+}
+
+static int crypto_blake2b(lua_State *L)
+{
+    size_t len;
+    char *arg;
+    struct luaCryptoBlake2b_return ret;
+
+    crypto_check_fork(L, "blake2b");
+    lua_gasuse(L, 500);
+    luaL_checktype(L, 1, LUA_TSTRING);
+    arg = (char *)lua_tolstring(L, 1, &len);
+
+    ret = luaCryptoBlake2b(arg, len);
+    lua_pushlstring(L, ret.r0, ret.r1);
+    free(ret.r0);
+	return 1;
+}
+
+static int crypto_ecrecover(lua_State *L)
+{
+    char *msg, *sig;
+    struct luaCryptoEcRecover_return ret;
+	int service = getLuaExecContext(L);
+
+    crypto_check_fork(L, "ecrecover");
+    lua_gasuse(L, 5000);
+    luaL_checktype(L, 1, LUA_TSTRING);
+    luaL_checktype(L, 2, LUA_TSTRING);
+    msg = (char *)lua_tostring(L, 1);
+    sig = (char *)lua_tostring(L, 2);
+
+    ret = luaCryptoEcRecover(L, service, msg, sig);
+    if (ret.r1 != NULL) {
+        strPushAndRelease(L, ret.r1);
+        lua_error(L);
+    }
+    strPushAndRelease(L, ret.r0);
+	return 1;
+}
+
+static int crypto_ed25519verify(lua_State *L)
+{
+    size_t len;
+    char *msg, *sig, *pubkey;
+    struct luaCryptoEd25519Verify_return ret;
+	int service = getLuaExecContext(L);
+
+    crypto_check_fork(L, "ed25519verify");
+    lua_gasuse(L, 5000);
+    luaL_checktype(L, 1, LUA_TSTRING);
+    luaL_checktype(L, 2, LUA_TSTRING);
+    luaL_checktype(L, 3, LUA_TSTRING);
+    msg = (char *)lua_tolstring(L, 1, &len);
+    sig = (char *)lua_tostring(L, 2);
+    pubkey = (char *)lua_tostring(L, 3);
+
+    ret = luaCryptoEd25519Verify(L, service, msg, len, sig, pubkey);
+    if (ret.r1 != NULL) {
+        strPushAndRelease(L, ret.r1);
+        lua_error(L);
+    }
+    lua_pushboolean(L, ret.r0);
+	return 1;
+}
+
+#define BLS_MAX_KEYS 256
+
+static int check_bytes_table(lua_State *L, int n)
+{
+    int i, len;
+
+    len = (int)lua_objlen(L, n);
+    if (len == 0 || len > BLS_MAX_KEYS) {
+        luaL_argerror(L, n, "invalid number of elements");
+    }
+    for (i = 1; i <= len; i++) {
+        lua_rawgeti(L, n, i);
+        if (lua_type(L, -1) != LUA_TSTRING) {
+            luaL_argerror(L, n, "string expected");
+        }
+        lua_pop(L, 1);
+    }
+    return len;
+}
+
+static struct bytes_arg *make_bytes_args(lua_State *L, int n, int len)
+{
+    struct bytes_arg *args;
+    int i;
+
+    args = (struct bytes_arg *)malloc(sizeof(struct bytes_arg) * len);
+    for (i = 0; i < len; i++) {
+        lua_rawgeti(L, n, i+1);
+        args[i].data = (char *)lua_tolstring(L, -1, &args[i].len);
+        lua_pop(L, 1);
+    }
+    return args;
+}
+
+/* crypto.blsAggregateVerify(sig, pubkeys, msgs)
+ * pubkeys is a table of compressed G1 public keys and msgs is either a table
+ * of the messages signed by each key or one message signed by all of them. */
+static int crypto_blsAggregateVerify(lua_State *L)
+{
+    size_t sigLen;
+    char *sig;
+    struct bytes_arg *pubkeys, *msgs, single;
+    int nPubkey, nMsg, sameMsg;
+    struct luaCryptoBlsAggregateVerify_return ret;
+	int service = getLuaExecContext(L);
+
+    crypto_check_fork(L, "blsAggregateVerify");
+    lua_gasuse(L, 50000);
+    luaL_checktype(L, 1, LUA_TSTRING);
+    luaL_checktype(L, 2, LUA_TTABLE);
+    sig = (char *)lua_tolstring(L, 1, &sigLen);
+    nPubkey = check_bytes_table(L, 2);
+    sameMsg = lua_type(L, 3) == LUA_TSTRING;
+    if (sameMsg) {
+        lua_gasuse_mul(L, 500, nPubkey);
+        nMsg = 1;
+    } else {
+        luaL_checktype(L, 3, LUA_TTABLE);
+        lua_gasuse_mul(L, 25000, nPubkey);
+        nMsg = check_bytes_table(L, 3);
+    }
+
+    pubkeys = make_bytes_args(L, 2, nPubkey);
+    if (sameMsg) {
+        single.data = (char *)lua_tolstring(L, 3, &single.len);
+        msgs = &single;
+    } else {
+        msgs = make_bytes_args(L, 3, nMsg);
+    }
+    ret = luaCryptoBlsAggregateVerify(L, service, sig, sigLen, pubkeys, nPubkey, msgs, nMsg, sameMsg);
+    free(pubkeys);
+    if (!sameMsg) {
+        free(msgs);
+    }
+    if (ret.r1 != NULL) {
+        strPushAndRelease(L, ret.r1);
+        lua_error(L);
+    }
+    lua_pushboolean(L, ret.r0);
+	return 1;
+}
+
 static const luaL_Reg crypto_lib[] = {
 	{"sha256", crypto_sha256},
 	{"ecverify", crypto_ecverify},
 	{"verifyProof", crypto_verifyProof},
 	{"keccak256", crypto_keccak256},
+	{"ripemd160", crypto_ripemd160},
+	{"blake2b", crypto_blake2b},
+	{"ecrecover", crypto_ecrecover},
+	{"ed25519verify", crypto_ed25519verify},
+	{"blsAggregateVerify", crypto_blsAggregateVerify},
 	{NULL, NULL}
 };
 
@@ -12,16 +12,95 @@
 #define LAST_ERROR(L,db,rc)                         \
     do {                                            \
         if ((rc) != SQLITE_OK) {                    \
-            luaL_error((L), sqlite3_errmsg((db)));  \
+            luaL_error((L), db_errmsg((db), (rc))); \
         }                                           \
     } while(0)
 
 #define RESOURCE_PSTMT_KEY "_RESOURCE_PSTMT_KEY_"
 #define RESOURCE_RS_KEY "_RESOURCE_RS_KEY_"
 
+/* gas metering of sql statements */
+#define SQL_PROGRESS_STEPS  100     /* sqlite VM steps between progress handler calls */
+#define SQL_STEP_GAS        1       /* gas per sqlite VM step */
+#define SQL_PAGE_GAS        5000    /* gas per page added to the database */
+
 extern int getLuaExecContext(lua_State *L);
 static void get_column_meta(lua_State *L, sqlite3_stmt* stmt);
 
+typedef struct {
+    lua_State *L;
+    int service;
+    int exhausted;
+} sql_meter_t;
+
+static const char *db_errmsg(sqlite3 *db, int rc)
+{
+    if (rc == SQLITE_INTERRUPT) {
+        return "not enough gas for the sql statement";
+    }
+    return sqlite3_errmsg(db);
+}
+
+/* sql_progress is called by sqlite every SQL_PROGRESS_STEPS VM steps and
+ * charges them as gas. It interrupts the statement when the gas runs out. */
+static int sql_progress(void *arg)
+{
+    sql_meter_t *m = (sql_meter_t *)arg;
+
+    if (luaChargeSqlGas(m->L, m->service, SQL_PROGRESS_STEPS * SQL_STEP_GAS) != 0) {
+        m->exhausted = 1;
+        return 1;
+    }
+    return 0;
+}
+
+static sqlite3_int64 db_page_count(sqlite3 *db)
+{
+    sqlite3_stmt *s;
+    sqlite3_int64 n = 0;
+
+    if (sqlite3_prepare_v2(db, "pragma page_count", -1, &s, NULL) != SQLITE_OK) {
+        return 0;
+    }
+    if (sqlite3_step(s) == SQLITE_ROW) {
+        n = sqlite3_column_int64(s, 0);
+    }
+    sqlite3_finalize(s);
+    return n;
+}
+
+/* db_step steps s charging the sqlite VM steps and, if the statement
+ * writes, the pages it adds to the database as gas. It returns
+ * SQLITE_INTERRUPT when the gas runs out. */
+static int db_step(lua_State *L, sqlite3 *db, sqlite3_stmt *s, int write)
+{
+    sql_meter_t m;
+    sqlite3_int64 pages = 0;
+    int rc;
+
+    if (!vm_is_hardfork(L, 3)) {
+        return sqlite3_step(s);
+    }
+    if (write) {
+        pages = db_page_count(db);
+    }
+    m.L = L;
+    m.service = getLuaExecContext(L);
+    m.exhausted = 0;
+    sqlite3_progress_handler(db, SQL_PROGRESS_STEPS, sql_progress, &m);
+    rc = sqlite3_step(s);
+    sqlite3_progress_handler(db, 0, NULL, NULL);
+    if (m.exhausted) {
+        return SQLITE_INTERRUPT;
+    }
+    if (write && (pages = db_page_count(db) - pages) > 0) {
+        if (luaChargeSqlGas(L, m.service, (unsigned long long)pages * SQL_PAGE_GAS) != 0) {
+            return SQLITE_INTERRUPT;
+        }
+    }
+    return rc;
+}
+
 static int append_resource(lua_State *L, const char *key, void *data)
 {
     int refno;
@@ -190,7 +269,7 @@ static int db_rs_next(lua_State *L)
     db_rs_t *rs = get_db_rs(L, 1);
     int rc;
 
-    rc = sqlite3_step(rs->s);
+    rc = db_step(L, rs->db, rs->s, 0);
     if (rc == SQLITE_DONE) {
         db_rs_close(L, rs, 1);
         lua_pushboolean(L, 0);
@@ -334,11 +413,11 @@ static int db_pstmt_exec(lua_State *L)
         sqlite3_clear_bindings(pstmt->s);
         luaL_error(L, lua_tostring(L, -1));
     }
-    rc = sqlite3_step(pstmt->s);
+    rc = db_step(L, pstmt->db, pstmt->s, 1);
     if (rc != SQLITE_ROW && rc != SQLITE_OK && rc != SQLITE_DONE) {
         sqlite3_reset(pstmt->s);
         sqlite3_clear_bindings(pstmt->s);
-        luaL_error(L, sqlite3_errmsg(pstmt->db));
+        luaL_error(L, db_errmsg(pstmt->db, rc));
     }
     n = sqlite3_changes(pstmt->db);
     lua_pushinteger(L, n);
@@ -480,10 +559,10 @@ static int db_exec(lua_State *L)
         luaL_error(L, lua_tostring(L, -1));
     }
 
-    rc = sqlite3_step(s);
+    rc = db_step(L, db, s, 1);
     if (rc != SQLITE_ROW && rc != SQLITE_OK && rc != SQLITE_DONE) {
         sqlite3_finalize(s);
-        luaL_error(L, sqlite3_errmsg(db));
+        luaL_error(L, db_errmsg(db, rc));
     }
     sqlite3_finalize(s);
 
@@ -0,0 +1,340 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package contract
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// the operations of the decimal module, which must be the same as the ones
+// in decimal_module.h
+const (
+	decimalOpNew = iota
+	decimalOpAdd
+	decimalOpSub
+	decimalOpMul
+	decimalOpDiv
+	decimalOpRound
+	decimalOpCmp
+	decimalOpNeg
+	decimalOpAbs
+	decimalOpToInt
+)
+
+const (
+	decimalMaxScale = 38
+	decimalDivScale = 18
+)
+
+// decimalOpGas is the base gas of each operation. The length of the operands
+// is charged in addition.
+var decimalOpGas = [...]uint64{
+	decimalOpNew:   50,
+	decimalOpAdd:   100,
+	decimalOpSub:   100,
+	decimalOpMul:   300,
+	decimalOpDiv:   500,
+	decimalOpRound: 100,
+	decimalOpCmp:   50,
+	decimalOpNeg:   50,
+	decimalOpAbs:   50,
+	decimalOpToInt: 100,
+}
+
+var (
+	decimalMaxCoef = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	bigTen         = big.NewInt(10)
+
+	errDecimalInvalid   = errors.New("decimal invalid number string")
+	errDecimalOverflow  = errors.New("decimal over max limit")
+	errDecimalDivZero   = errors.New("decimal divide by zero")
+	errDecimalScale     = fmt.Errorf("decimal scale must be between 0 and %d", decimalMaxScale)
+	errDecimalRounding  = errors.New("decimal invalid rounding mode")
+	errDecimalOperation = errors.New("decimal invalid operation")
+)
+
+type roundingMode int
+
+const (
+	roundDown     roundingMode = iota // toward zero
+	roundUp                           // away from zero
+	roundFloor                        // toward negative infinity
+	roundCeil                         // toward positive infinity
+	roundHalfUp                       // to nearest, ties away from zero
+	roundHalfDown                     // to nearest, ties toward zero
+	roundHalfEven                     // to nearest, ties to even
+)
+
+var roundingModes = map[string]roundingMode{
+	"down":      roundDown,
+	"up":        roundUp,
+	"floor":     roundFloor,
+	"ceil":      roundCeil,
+	"half_up":   roundHalfUp,
+	"half_down": roundHalfDown,
+	"half_even": roundHalfEven,
+}
+
+// decimal is a fixed-point decimal number of which the value is
+// coef * 10^-scale.
+type decimal struct {
+	coef  *big.Int
+	scale int
+}
+
+func newDecimal(coef *big.Int, scale int) (*decimal, error) {
+	if scale < 0 || scale > decimalMaxScale {
+		return nil, errDecimalScale
+	}
+	if new(big.Int).Abs(coef).Cmp(decimalMaxCoef) > 0 {
+		return nil, errDecimalOverflow
+	}
+	return &decimal{coef: coef, scale: scale}, nil
+}
+
+func parseDecimal(s string) (*decimal, error) {
+	neg := false
+	if len(s) > 0 && (s[0] == '-' || s[0] == '+') {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+	intPart, fracPart := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, fracPart = s[:i], s[i+1:]
+	}
+	if len(intPart)+len(fracPart) == 0 || !isDigits(intPart) || !isDigits(fracPart) {
+		return nil, errDecimalInvalid
+	}
+	coef, ok := new(big.Int).SetString(intPart+fracPart, 10)
+	if !ok {
+		return nil, errDecimalInvalid
+	}
+	if neg {
+		coef.Neg(coef)
+	}
+	return newDecimal(coef, len(fracPart))
+}
+
+func parseRoundingMode(s string) (roundingMode, error) {
+	if len(s) == 0 {
+		return roundHalfEven, nil
+	}
+	mode, ok := roundingModes[s]
+	if !ok {
+		return 0, errDecimalRounding
+	}
+	return mode, nil
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+func (d *decimal) String() string {
+	s := new(big.Int).Abs(d.coef).String()
+	if d.scale > 0 {
+		if len(s) <= d.scale {
+			s = strings.Repeat("0", d.scale-len(s)+1) + s
+		}
+		s = s[:len(s)-d.scale] + "." + s[len(s)-d.scale:]
+	}
+	if d.coef.Sign() < 0 {
+		s = "-" + s
+	}
+	return s
+}
+
+// round returns d rescaled to scale. The digits dropped are rounded with
+// mode.
+func (d *decimal) round(scale int, mode roundingMode) (*decimal, error) {
+	if scale < 0 || scale > decimalMaxScale {
+		return nil, errDecimalScale
+	}
+	if scale >= d.scale {
+		return newDecimal(new(big.Int).Mul(d.coef, pow10(scale-d.scale)), scale)
+	}
+	return newDecimal(divRound(d.coef, pow10(d.scale-scale), mode), scale)
+}
+
+// align returns the coefficients of a and b at the greater scale of them.
+func align(a, b *decimal) (*big.Int, *big.Int, int) {
+	switch {
+	case a.scale > b.scale:
+		return a.coef, new(big.Int).Mul(b.coef, pow10(a.scale-b.scale)), a.scale
+	case a.scale < b.scale:
+		return new(big.Int).Mul(a.coef, pow10(b.scale-a.scale)), b.coef, b.scale
+	}
+	return a.coef, b.coef, a.scale
+}
+
+func (d *decimal) add(o *decimal) (*decimal, error) {
+	x, y, scale := align(d, o)
+	return newDecimal(new(big.Int).Add(x, y), scale)
+}
+
+func (d *decimal) sub(o *decimal) (*decimal, error) {
+	x, y, scale := align(d, o)
+	return newDecimal(new(big.Int).Sub(x, y), scale)
+}
+
+// mul returns d * o at scale. If scale is negative, the sum of the scales of
+// the operands up to decimalMaxScale is used.
+func (d *decimal) mul(o *decimal, scale int, mode roundingMode) (*decimal, error) {
+	if scale < 0 {
+		scale = d.scale + o.scale
+		if scale > decimalMaxScale {
+			scale = decimalMaxScale
+		}
+	}
+	if scale > decimalMaxScale {
+		return nil, errDecimalScale
+	}
+	r := &decimal{coef: new(big.Int).Mul(d.coef, o.coef), scale: d.scale + o.scale}
+	return r.round(scale, mode)
+}
+
+// div returns d / o at scale. If scale is negative, the greater one of the
+// scales of the operands and decimalDivScale is used.
+func (d *decimal) div(o *decimal, scale int, mode roundingMode) (*decimal, error) {
+	if o.coef.Sign() == 0 {
+		return nil, errDecimalDivZero
+	}
+	if scale < 0 {
+		scale = decimalDivScale
+		if d.scale > scale {
+			scale = d.scale
+		}
+		if o.scale > scale {
+			scale = o.scale
+		}
+	}
+	if scale > decimalMaxScale {
+		return nil, errDecimalScale
+	}
+	// d / o = (d.coef / o.coef) * 10^(o.scale - d.scale)
+	num, den := new(big.Int).Set(d.coef), new(big.Int).Set(o.coef)
+	if exp := scale + o.scale - d.scale; exp >= 0 {
+		num.Mul(num, pow10(exp))
+	} else {
+		den.Mul(den, pow10(-exp))
+	}
+	return newDecimal(divRound(num, den, mode), scale)
+}
+
+func (d *decimal) cmp(o *decimal) int {
+	x, y, _ := align(d, o)
+	return x.Cmp(y)
+}
+
+// divRound returns x / y rounded with mode.
+func divRound(x, y *big.Int, mode roundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(x, y, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+	neg := (x.Sign() < 0) != (y.Sign() < 0)
+	var inc bool
+	switch mode {
+	case roundDown:
+		inc = false
+	case roundUp:
+		inc = true
+	case roundFloor:
+		inc = neg
+	case roundCeil:
+		inc = !neg
+	default:
+		half := new(big.Int).Lsh(new(big.Int).Abs(r), 1).Cmp(new(big.Int).Abs(y))
+		switch mode {
+		case roundHalfUp:
+			inc = half >= 0
+		case roundHalfDown:
+			inc = half > 0
+		default:
+			inc = half > 0 || (half == 0 && q.Bit(0) == 1)
+		}
+	}
+	if inc {
+		if neg {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return q
+}
+
+// decimalOp runs the operation op of the decimal module and returns the
+// result as a string with the gas used. A negative scale means the default
+// scale of op.
+func decimalOp(op int, a, b string, scale int, mode string) (string, uint64, error) {
+	if op < 0 || op >= len(decimalOpGas) {
+		return "", 0, errDecimalOperation
+	}
+	gas := decimalOpGas[op] + uint64(len(a)+len(b))
+	if op == decimalOpToInt && len(mode) == 0 {
+		mode = "down"
+	}
+	rm, err := parseRoundingMode(mode)
+	if err != nil {
+		return "", gas, err
+	}
+	x, err := parseDecimal(a)
+	if err != nil {
+		return "", gas, err
+	}
+	var y *decimal
+	switch op {
+	case decimalOpAdd, decimalOpSub, decimalOpMul, decimalOpDiv, decimalOpCmp:
+		if y, err = parseDecimal(b); err != nil {
+			return "", gas, err
+		}
+	}
+
+	var r *decimal
+	switch op {
+	case decimalOpNew:
+		if scale < 0 {
+			r = x
+		} else {
+			r, err = x.round(scale, rm)
+		}
+	case decimalOpAdd:
+		r, err = x.add(y)
+	case decimalOpSub:
+		r, err = x.sub(y)
+	case decimalOpMul:
+		r, err = x.mul(y, scale, rm)
+	case decimalOpDiv:
+		r, err = x.div(y, scale, rm)
+	case decimalOpRound:
+		r, err = x.round(scale, rm)
+	case decimalOpCmp:
+		return fmt.Sprint(x.cmp(y)), gas, nil
+	case decimalOpNeg:
+		r = &decimal{coef: new(big.Int).Neg(x.coef), scale: x.scale}
+	case decimalOpAbs:
+		r = &decimal{coef: new(big.Int).Abs(x.coef), scale: x.scale}
+	case decimalOpToInt:
+		r, err = x.round(0, rm)
+	}
+	if err != nil {
+		return "", gas, err
+	}
+	return r.String(), gas, nil
+}
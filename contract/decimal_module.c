@@ -0,0 +1,259 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+#include <stdlib.h>
+#include <string.h>
+#include "vm.h"
+#include "util.h"
+#include "lgmp.h"
+#include "decimal_module.h"
+#include "_cgo_export.h"
+
+#define DECIMAL_NAME    "decimal"
+#define DECIMAL_TYPE    DECIMAL_NAME " number"
+
+/* dec_push pushes a decimal userdata holding the canonical string s */
+static void dec_push(lua_State *L, const char *s)
+{
+    size_t len = strlen(s);
+    char *d = (char *)lua_newuserdata(L, len + 1);
+
+    memcpy(d, s, len + 1);
+    luaL_getmetatable(L, DECIMAL_TYPE);
+    lua_setmetatable(L, -2);
+}
+
+static int dec_isdecimal(lua_State *L, int i)
+{
+    return luaL_testudata(L, i, DECIMAL_TYPE) != NULL;
+}
+
+/* dec_get returns the string of the decimal, number, string or bignum at the
+ * index i */
+static const char *dec_get(lua_State *L, int i)
+{
+    char *s;
+
+    switch (lua_type(L, i)) {
+    case LUA_TNUMBER:
+    case LUA_TSTRING:
+        return lua_tostring(L, i);
+    case LUA_TUSERDATA:
+        if (dec_isdecimal(L, i)) {
+            return (const char *)lua_touserdata(L, i);
+        }
+        if (lua_isbignumber(L, i)) {
+            s = lua_get_bignum_str(L, i);
+            if (s == NULL) {
+                luaL_error(L, "decimal not enough memory");
+            }
+            strPushAndRelease(L, s);
+            lua_replace(L, i);
+            return lua_tostring(L, i);
+        }
+    }
+    luaL_typerror(L, i, DECIMAL_NAME);
+    return NULL;
+}
+
+static int dec_optscale(lua_State *L, int i)
+{
+    lua_Integer scale;
+
+    if (lua_isnoneornil(L, i)) {
+        return -1;
+    }
+    scale = luaL_checkinteger(L, i);
+    if (scale < 0) {
+        luaL_error(L, "decimal scale must not be negative");
+    }
+    return (int)scale;
+}
+
+static void dec_check_fork(lua_State *L)
+{
+    if (!vm_is_hardfork(L, 3)) {
+        luaL_error(L, "decimal is not supported");
+    }
+}
+
+/* dec_op runs op in the vm callback, which also returns the gas of op, and
+ * pushes the result */
+static int dec_op(lua_State *L, int op, const char *a, const char *b, int scale, const char *mode)
+{
+    struct luaDecimalOp_return ret;
+    const char *errStr;
+    const char *r;
+
+    dec_check_fork(L);
+    ret = luaDecimalOp(op, (char *)a, (char *)b, scale, (char *)mode);
+    if (ret.r2 != NULL) {
+        strPushAndRelease(L, ret.r2);
+        lua_gasuse(L, ret.r1);
+        lua_error(L);
+    }
+    strPushAndRelease(L, ret.r0);
+    lua_gasuse(L, ret.r1);
+
+    r = lua_tostring(L, -1);
+    switch (op) {
+    case DEC_CMP:
+        lua_pushinteger(L, atoi(r));
+        break;
+    case DEC_TOINT:
+        if ((errStr = lua_set_bignum(L, (char *)r)) != NULL) {
+            luaL_error(L, errStr);
+        }
+        break;
+    default:
+        dec_push(L, r);
+    }
+    lua_remove(L, -2);
+    return 1;
+}
+
+static int dec_new(lua_State *L)
+{
+    return dec_op(L, DEC_NEW, dec_get(L, 1), NULL, dec_optscale(L, 2), luaL_optstring(L, 3, NULL));
+}
+
+static int dec_add(lua_State *L)
+{
+    return dec_op(L, DEC_ADD, dec_get(L, 1), dec_get(L, 2), -1, NULL);
+}
+
+static int dec_sub(lua_State *L)
+{
+    return dec_op(L, DEC_SUB, dec_get(L, 1), dec_get(L, 2), -1, NULL);
+}
+
+static int dec_mul(lua_State *L)
+{
+    return dec_op(L, DEC_MUL, dec_get(L, 1), dec_get(L, 2), dec_optscale(L, 3), luaL_optstring(L, 4, NULL));
+}
+
+static int dec_div(lua_State *L)
+{
+    return dec_op(L, DEC_DIV, dec_get(L, 1), dec_get(L, 2), dec_optscale(L, 3), luaL_optstring(L, 4, NULL));
+}
+
+static int dec_round(lua_State *L)
+{
+    const char *a = dec_get(L, 1);
+    int scale;
+
+    luaL_checkinteger(L, 2);
+    scale = dec_optscale(L, 2);
+    return dec_op(L, DEC_ROUND, a, NULL, scale, luaL_optstring(L, 3, NULL));
+}
+
+static int dec_compare(lua_State *L)
+{
+    return dec_op(L, DEC_CMP, dec_get(L, 1), dec_get(L, 2), -1, NULL);
+}
+
+static int dec_eq(lua_State *L)
+{
+    dec_compare(L);
+    lua_pushboolean(L, lua_tointeger(L, -1) == 0);
+    return 1;
+}
+
+static int dec_lt(lua_State *L)
+{
+    dec_compare(L);
+    lua_pushboolean(L, lua_tointeger(L, -1) < 0);
+    return 1;
+}
+
+static int dec_le(lua_State *L)
+{
+    dec_compare(L);
+    lua_pushboolean(L, lua_tointeger(L, -1) <= 0);
+    return 1;
+}
+
+static int dec_neg(lua_State *L)
+{
+    return dec_op(L, DEC_NEG, dec_get(L, 1), NULL, -1, NULL);
+}
+
+static int dec_abs(lua_State *L)
+{
+    return dec_op(L, DEC_ABS, dec_get(L, 1), NULL, -1, NULL);
+}
+
+static int dec_tobignum(lua_State *L)
+{
+    return dec_op(L, DEC_TOINT, dec_get(L, 1), NULL, -1, luaL_optstring(L, 2, NULL));
+}
+
+static int dec_tostring(lua_State *L)
+{
+    dec_check_fork(L);
+    lua_gasuse(L, 50);
+    lua_pushstring(L, dec_get(L, 1));
+    return 1;
+}
+
+static int dec_scale(lua_State *L)
+{
+    const char *s = (const char *)luaL_checkudata(L, 1, DECIMAL_TYPE);
+    const char *p = strchr(s, '.');
+
+    dec_check_fork(L);
+    lua_gasuse(L, 10);
+    lua_pushinteger(L, p == NULL ? 0 : (lua_Integer)strlen(p + 1));
+    return 1;
+}
+
+static int dec_is(lua_State *L)
+{
+    dec_check_fork(L);
+    lua_gasuse(L, 10);
+    lua_pushboolean(L, dec_isdecimal(L, 1));
+    return 1;
+}
+
+static const luaL_Reg decimal_lib[] = {
+    {"new", dec_new},
+    {"add", dec_add},
+    {"sub", dec_sub},
+    {"mul", dec_mul},
+    {"div", dec_div},
+    {"round", dec_round},
+    {"compare", dec_compare},
+    {"neg", dec_neg},
+    {"abs", dec_abs},
+    {"tobignum", dec_tobignum},
+    {"tostring", dec_tostring},
+    {"scale", dec_scale},
+    {"isdecimal", dec_is},
+    {NULL, NULL}
+};
+
+static const luaL_Reg decimal_meta[] = {
+    {"__add", dec_add},
+    {"__sub", dec_sub},
+    {"__mul", dec_mul},
+    {"__div", dec_div},
+    {"__unm", dec_neg},
+    {"__eq", dec_eq},
+    {"__lt", dec_lt},
+    {"__le", dec_le},
+    {"__tostring", dec_tostring},
+    {NULL, NULL}
+};
+
+int luaopen_decimal(lua_State *L)
+{
+    luaL_register(L, DECIMAL_NAME, decimal_lib);
+    luaL_newmetatable(L, DECIMAL_TYPE);
+    luaL_register(L, NULL, decimal_meta);
+    lua_pushvalue(L, -2);
+    lua_setfield(L, -2, "__index");
+    lua_pop(L, 1);
+    return 1;
+}
@@ -0,0 +1,26 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+#ifndef _DECIMAL_MODULE_H
+#define _DECIMAL_MODULE_H
+
+#include <lua.h>
+
+/* operations of the decimal module, which must be the same as the ones in
+ * decimal.go */
+#define DEC_NEW     0
+#define DEC_ADD     1
+#define DEC_SUB     2
+#define DEC_MUL     3
+#define DEC_DIV     4
+#define DEC_ROUND   5
+#define DEC_CMP     6
+#define DEC_NEG     7
+#define DEC_ABS     8
+#define DEC_TOINT   9
+
+extern int luaopen_decimal(lua_State *L);
+
+#endif /* _DECIMAL_MODULE_H */
@@ -0,0 +1,89 @@
+package contract
+
+import (
+	"testing"
+)
+
+func TestDecimalOp(t *testing.T) {
+	tests := []struct {
+		op     int
+		a, b   string
+		scale  int
+		mode   string
+		want   string
+		errStr string
+	}{
+		{decimalOpNew, "1.50", "", -1, "", "1.50", ""},
+		{decimalOpNew, "-.5", "", -1, "", "-0.5", ""},
+		{decimalOpNew, "+007", "", 2, "", "7.00", ""},
+		{decimalOpNew, "1.2.3", "", -1, "", "", errDecimalInvalid.Error()},
+		{decimalOpNew, "1e5", "", -1, "", "", errDecimalInvalid.Error()},
+		{decimalOpNew, "-", "", -1, "", "", errDecimalInvalid.Error()},
+		{decimalOpNew, "1", "", 39, "", "", errDecimalScale.Error()},
+		{decimalOpNew, "115792089237316195423570985008687907853269984665640564039457584007913129639936", "", -1, "", "", errDecimalOverflow.Error()},
+		{decimalOpNew, "1.25", "", 1, "unknown", "", errDecimalRounding.Error()},
+
+		{decimalOpAdd, "1.5", "2.25", -1, "", "3.75", ""},
+		{decimalOpSub, "1.5", "2.25", -1, "", "-0.75", ""},
+		{decimalOpMul, "1.5", "2.25", -1, "", "3.375", ""},
+		{decimalOpMul, "1.5", "2.25", 2, "", "3.38", ""},
+		{decimalOpMul, "1.5", "2.25", 2, "down", "3.37", ""},
+		{decimalOpDiv, "1", "3", -1, "", "0.333333333333333333", ""},
+		{decimalOpDiv, "2", "3", 2, "", "0.67", ""},
+		{decimalOpDiv, "2", "3", 2, "down", "0.66", ""},
+		{decimalOpDiv, "-2", "3", 2, "floor", "-0.67", ""},
+		{decimalOpDiv, "10.000", "0.5", 0, "", "20", ""},
+		{decimalOpDiv, "1", "0.0", -1, "", "", errDecimalDivZero.Error()},
+
+		{decimalOpRound, "2.5", "", 0, "half_even", "2", ""},
+		{decimalOpRound, "3.5", "", 0, "half_even", "4", ""},
+		{decimalOpRound, "-2.5", "", 0, "half_even", "-2", ""},
+		{decimalOpRound, "2.5", "", 0, "half_up", "3", ""},
+		{decimalOpRound, "-2.5", "", 0, "half_up", "-3", ""},
+		{decimalOpRound, "2.5", "", 0, "half_down", "2", ""},
+		{decimalOpRound, "2.51", "", 0, "half_down", "3", ""},
+		{decimalOpRound, "2.1", "", 0, "up", "3", ""},
+		{decimalOpRound, "-2.1", "", 0, "up", "-3", ""},
+		{decimalOpRound, "-2.9", "", 0, "down", "-2", ""},
+		{decimalOpRound, "-2.1", "", 0, "floor", "-3", ""},
+		{decimalOpRound, "-2.9", "", 0, "ceil", "-2", ""},
+		{decimalOpRound, "1.5", "", 3, "", "1.500", ""},
+		{decimalOpRound, "0.004", "", 2, "", "0.00", ""},
+
+		{decimalOpCmp, "1.50", "1.5", -1, "", "0", ""},
+		{decimalOpCmp, "1.49", "1.5", -1, "", "-1", ""},
+		{decimalOpCmp, "-1", "-1.5", -1, "", "1", ""},
+		{decimalOpNeg, "1.50", "", -1, "", "-1.50", ""},
+		{decimalOpAbs, "-1.50", "", -1, "", "1.50", ""},
+		{decimalOpToInt, "-1.99", "", -1, "", "-1", ""},
+		{decimalOpToInt, "1.5", "", -1, "half_up", "2", ""},
+		{10, "1", "", -1, "", "", errDecimalOperation.Error()},
+	}
+	for _, tt := range tests {
+		got, _, err := decimalOp(tt.op, tt.a, tt.b, tt.scale, tt.mode)
+		if len(tt.errStr) > 0 {
+			if err == nil || err.Error() != tt.errStr {
+				t.Errorf("op(%d, %s, %s): expected error %s, got %v", tt.op, tt.a, tt.b, tt.errStr, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("op(%d, %s, %s): unexpected error %v", tt.op, tt.a, tt.b, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("op(%d, %s, %s): expected %s, got %s", tt.op, tt.a, tt.b, tt.want, got)
+		}
+	}
+}
+
+func TestDecimalOpGas(t *testing.T) {
+	_, gas, _ := decimalOp(decimalOpAdd, "1.5", "2", -1, "")
+	if gas != decimalOpGas[decimalOpAdd]+4 {
+		t.Errorf("invalid gas: %d", gas)
+	}
+	_, gas, err := decimalOp(decimalOpDiv, "1", "0", -1, "")
+	if err == nil || gas != decimalOpGas[decimalOpDiv]+2 {
+		t.Errorf("gas must be charged on error: %d, %v", gas, err)
+	}
+}
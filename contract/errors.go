@@ -5,6 +5,8 @@
 
 package contract
 
+import "encoding/json"
+
 type ErrSystem interface {
 	System() bool
 }
@@ -81,6 +83,61 @@ func (e *vmError) Runtime() bool {
 	return e != nil
 }
 
+// RevertError is raised by a contract calling error with a table like
+// error({code = "E01", message = "insufficient funds", data = {...}}).
+type RevertError struct {
+	Code    string
+	Message string
+	Data    string
+	payload string
+}
+
+func newRevertError(payload string) *RevertError {
+	e := &RevertError{payload: payload}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(payload), &fields); err != nil {
+		e.Message = payload
+		return e
+	}
+	e.Code = rawString(fields["code"])
+	e.Message = rawString(fields["message"])
+	if data, ok := fields["data"]; ok {
+		e.Data = string(data)
+	}
+	return e
+}
+
+// rawString returns a JSON string unquoted and other JSON values as they are.
+func rawString(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	return string(raw)
+}
+
+func (e *RevertError) Error() string {
+	switch {
+	case len(e.Code) != 0:
+		return e.Code + ": " + e.Message
+	case len(e.Message) != 0:
+		return e.Message
+	}
+	return e.payload
+}
+
+// GetRevertError returns the revert reason of a failed contract execution.
+func GetRevertError(err error) *RevertError {
+	if vmErr, ok := err.(*vmError); ok {
+		err = vmErr.error
+	}
+	rErr, _ := err.(*RevertError)
+	return rErr
+}
+
 //Governance Errors
 
 type ErrGovEnt interface {
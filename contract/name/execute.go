@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/aergoio/aergo/contract/system"
 	"github.com/aergoio/aergo/state"
@@ -16,14 +17,14 @@ func ExecuteNameTx(bs *state.BlockState, scs *state.ContractState, txBody *types
 
 	systemContractState, err := bs.StateDB.OpenContractStateAccount(types.ToAccountID([]byte(types.AergoSystem)))
 
-	ci, err := ValidateNameTx(txBody, sender, scs, systemContractState)
+	ci, err := ValidateNameTx(txBody, sender, scs, systemContractState, blockInfo)
 	if err != nil {
 		return nil, err
 	}
 	var events []*types.Event
 
 	var nameState *state.V
-	owner := getOwner(scs, []byte(types.AergoName), false)
+	owner := getOwner(scs, []byte(types.AergoName), false, blockInfo.No)
 	if owner != nil {
 		if bytes.Equal(sender.ID(), owner) {
 			nameState = sender
@@ -38,8 +39,13 @@ func ExecuteNameTx(bs *state.BlockState, scs *state.ContractState, txBody *types
 	}
 	switch ci.Name {
 	case types.NameCreate:
+		var registered, expire uint64
+		if blockInfo.Version >= 3 {
+			registered = blockInfo.No
+			expire = blockInfo.No + system.GetNamePeriodFromState(systemContractState)
+		}
 		if err = CreateName(scs, txBody, sender, nameState,
-			ci.Args[0].(string)); err != nil {
+			ci.Args[0].(string), registered, expire); err != nil {
 			return nil, err
 		}
 		jsonArgs := ""
@@ -56,7 +62,7 @@ func ExecuteNameTx(bs *state.BlockState, scs *state.ContractState, txBody *types
 		})
 	case types.NameUpdate:
 		if err = UpdateName(bs, scs, txBody, sender, nameState,
-			ci.Args[0].(string), ci.Args[1].(string)); err != nil {
+			ci.Args[0].(string), ci.Args[1].(string), blockInfo.No); err != nil {
 			return nil, err
 		}
 		jsonArgs := ""
@@ -72,6 +78,51 @@ func ExecuteNameTx(bs *state.BlockState, scs *state.ContractState, txBody *types
 			EventName:       "update name",
 			JsonArgs:        jsonArgs,
 		})
+	case types.NameRenew:
+		expire, err := RenewName(scs, txBody, sender, nameState, ci.Args[0].(string),
+			blockInfo.No, system.GetNamePeriodFromState(systemContractState))
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, &types.Event{
+			ContractAddress: receiver.ID(),
+			EventIdx:        0,
+			EventName:       "renew name",
+			JsonArgs:        `["` + ci.Args[0].(string) + `",` + strconv.FormatUint(expire, 10) + `]`,
+		})
+	case types.NameSetReverse:
+		if err = SetReverse(scs, sender.ID(), ci.Args[0].(string)); err != nil {
+			return nil, err
+		}
+		events = append(events, &types.Event{
+			ContractAddress: receiver.ID(),
+			EventIdx:        0,
+			EventName:       "set reverse",
+			JsonArgs:        `["` + ci.Args[0].(string) + `","` + types.EncodeAddress(sender.ID()) + `"]`,
+		})
+	case types.NameSetSub:
+		subname := ci.Args[0].(string)
+		destination, err := types.DecodeAddress(ci.Args[1].(string))
+		if err != nil {
+			return nil, err
+		}
+		owner := sender.ID()
+		if len(ci.Args) == 3 {
+			if owner, err = types.DecodeAddress(ci.Args[2].(string)); err != nil {
+				return nil, err
+			}
+		}
+		parent := getRegistered(scs, []byte(types.ParentName(subname)), false, blockInfo.No)
+		if err = SetSubName(scs, subname, owner, destination, parent); err != nil {
+			return nil, err
+		}
+		events = append(events, &types.Event{
+			ContractAddress: receiver.ID(),
+			EventIdx:        0,
+			EventName:       "set subname",
+			JsonArgs: `["` + subname + `","` + types.EncodeAddress(destination) +
+				`","` + types.EncodeAddress(owner) + `"]`,
+		})
 	case types.SetContractOwner:
 		ownerState, err := SetContractOwner(bs, scs, ci.Args[0].(string), nameState)
 		if err != nil {
@@ -84,7 +135,7 @@ func ExecuteNameTx(bs *state.BlockState, scs *state.ContractState, txBody *types
 }
 
 func ValidateNameTx(tx *types.TxBody, sender *state.V,
-	scs, systemcs *state.ContractState) (*types.CallInfo, error) {
+	scs, systemcs *state.ContractState, blockInfo *types.BlockHeaderInfo) (*types.CallInfo, error) {
 	if sender != nil && sender.Balance().Cmp(tx.GetAmountBigInt()) < 0 {
 		return nil, types.ErrInsufficientBalance
 	}
@@ -99,8 +150,8 @@ func ValidateNameTx(tx *types.TxBody, sender *state.V,
 		if namePrice.Cmp(tx.GetAmountBigInt()) > 0 {
 			return nil, types.ErrTooSmallAmount
 		}
-		owner := getOwner(scs, []byte(name), false)
-		if owner != nil {
+		nameMap := getNameMap(scs, []byte(name), false)
+		if nameMap != nil && !nameMap.IsExpired(blockInfo.No) {
 			return nil, fmt.Errorf("aleady occupied %s", string(name))
 		}
 	case types.NameUpdate:
@@ -109,11 +160,52 @@ func ValidateNameTx(tx *types.TxBody, sender *state.V,
 			return nil, types.ErrTooSmallAmount
 		}
 		if (!bytes.Equal(tx.Account, []byte(name))) &&
-			(!bytes.Equal(tx.Account, getOwner(scs, []byte(name), false))) {
+			(!bytes.Equal(tx.Account, getOwner(scs, []byte(name), false, blockInfo.No))) {
+			return nil, fmt.Errorf("owner not matched : %s", name)
+		}
+	case types.NameRenew:
+		if blockInfo.Version < 3 {
+			return nil, fmt.Errorf("not supported operation")
+		}
+		namePrice := system.GetNamePriceFromState(systemcs)
+		if namePrice.Cmp(tx.GetAmountBigInt()) > 0 {
+			return nil, types.ErrTooSmallAmount
+		}
+		nameMap := getRegistered(scs, []byte(name), false, blockInfo.No)
+		if nameMap == nil || !bytes.Equal(tx.Account, nameMap.Owner) {
+			return nil, fmt.Errorf("owner not matched : %s", name)
+		}
+		if nameMap.Expire == 0 {
+			return nil, fmt.Errorf("name does not expire : %s", name)
+		}
+	case types.NameSetReverse:
+		if blockInfo.Version < 3 {
+			return nil, fmt.Errorf("not supported operation")
+		}
+		if tx.GetAmountBigInt().Sign() != 0 {
+			return nil, types.ErrTxInvalidAmount
+		}
+		nameMap := getRegistered(scs, []byte(name), false, blockInfo.No)
+		if nameMap == nil || !bytes.Equal(tx.Account, nameMap.Destination) {
+			return nil, fmt.Errorf("name not resolved to sender : %s", name)
+		}
+	case types.NameSetSub:
+		if blockInfo.Version < 3 {
+			return nil, fmt.Errorf("not supported operation")
+		}
+		if tx.GetAmountBigInt().Sign() != 0 {
+			return nil, types.ErrTxInvalidAmount
+		}
+		parent := getRegistered(scs, []byte(types.ParentName(name)), false, blockInfo.No)
+		if parent == nil {
+			return nil, fmt.Errorf("parent name not found : %s", name)
+		}
+		if !bytes.Equal(tx.Account, parent.Owner) &&
+			!bytes.Equal(tx.Account, getOwner(scs, []byte(name), false, blockInfo.No)) {
 			return nil, fmt.Errorf("owner not matched : %s", name)
 		}
 	case types.SetContractOwner:
-		owner := getOwner(scs, []byte(types.AergoName), false)
+		owner := getOwner(scs, []byte(types.AergoName), false, blockInfo.No)
 		if owner != nil {
 			return nil, fmt.Errorf("owner aleady set to %s", types.EncodeAddress(owner))
 		}
@@ -136,7 +228,7 @@ func SetContractOwner(bs *state.BlockState, scs *state.ContractState,
 	}
 	ownerState.AddBalance(nameState.Balance())
 	nameState.SubBalance(nameState.Balance())
-	if err = registerOwner(scs, name, rawaddr, name); err != nil {
+	if err = registerOwner(scs, name, &NameMap{Owner: rawaddr, Destination: name}); err != nil {
 		return nil, err
 	}
 	return ownerState, nil
@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/aergoio/aergo/contract/system"
 	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
 	"github.com/stretchr/testify/assert"
@@ -41,9 +42,9 @@ func TestExcuteNameTx(t *testing.T) {
 	commitContractState(t, bs, scs)
 	scs = openContractState(t, bs)
 
-	ret := GetAddress(scs, []byte(name))
+	ret := GetAddress(scs, []byte(name), blockInfo.No)
 	assert.Equal(t, txBody.Account, ret, "pubkey address")
-	ret = GetOwner(scs, []byte(name))
+	ret = GetOwner(scs, []byte(name), blockInfo.No)
 	assert.Equal(t, txBody.Account, ret, "pubkey owner")
 
 	_, err = ExecuteNameTx(bs, scs, txBody, sender, receiver, blockInfo)
@@ -60,9 +61,9 @@ func TestExcuteNameTx(t *testing.T) {
 	commitContractState(t, bs, scs)
 	scs = openContractState(t, bs)
 
-	ret = GetAddress(scs, []byte(name))
+	ret = GetAddress(scs, []byte(name), blockInfo.No)
 	assert.Equal(t, buyer, types.EncodeAddress(ret), "pubkey address")
-	ret = GetOwner(scs, []byte(name))
+	ret = GetOwner(scs, []byte(name), blockInfo.No)
 	assert.Equal(t, buyer, types.EncodeAddress(ret), "pubkey owner")
 
 	//invalid case
@@ -142,3 +143,158 @@ func nextBlockContractState(t *testing.T, bs *state.BlockState, scs *state.Contr
 	commitContractState(t, bs, scs)
 	return openContractState(t, bs)
 }
+
+func TestExcuteNameRenewReverse(t *testing.T) {
+	initTest(t)
+	defer deinitTest()
+	txBody := &types.TxBody{}
+	txBody.Account = types.ToAddress("AmMXVdJ8DnEFysN58cox9RADC74dF1CLrQimKCMdB4XXMkJeuQgL")
+	txBody.Recipient = []byte(types.AergoName)
+	txBody.Amount = big.NewInt(1000000000000000000).Bytes()
+
+	name := "AB1234567890"
+	sender, _ := sdb.GetStateDB().GetAccountStateV(txBody.Account)
+	sender.AddBalance(types.MaxAER)
+	receiver, _ := sdb.GetStateDB().GetAccountStateV(txBody.Recipient)
+	bs := sdb.NewBlockState(sdb.GetRoot())
+	scs := openContractState(t, bs)
+	blockInfo := &types.BlockHeaderInfo{No: uint64(10), Version: 3}
+
+	txBody.Payload = buildNamePayload(name, types.NameRenew, "")
+	_, err := ExecuteNameTx(bs, scs, txBody, sender, receiver, blockInfo)
+	assert.Error(t, err, "renew unregistered name")
+
+	systemcs := openSystemContractState(t, bs)
+	err = systemcs.SetData([]byte("param\\NAMEPERIOD"), big.NewInt(100).Bytes())
+	assert.NoError(t, err, "set name period")
+	bs.StageContractState(systemcs)
+	commitContractState(t, bs, scs)
+	scs = openContractState(t, bs)
+
+	txBody.Payload = buildNamePayload(name, types.NameCreate, "")
+	_, err = ExecuteNameTx(bs, scs, txBody, sender, receiver, blockInfo)
+	assert.NoError(t, err, "create name")
+	scs = nextBlockContractState(t, bs, scs)
+	assert.Equal(t, uint64(110), getNameMap(scs, []byte(name), true).Expire, "expire")
+
+	blockInfo.No = 20
+	txBody.Payload = buildNamePayload(name, types.NameRenew, "")
+	event, err := ExecuteNameTx(bs, scs, txBody, sender, receiver, blockInfo)
+	assert.NoError(t, err, "renew name")
+	assert.Equal(t, "renew name", event[0].EventName, "event name")
+	assert.Equal(t, "[\"AB1234567890\",210]", event[0].JsonArgs, "event args")
+
+	txBody.Amount = nil
+	txBody.Payload = buildNamePayload(name, types.NameSetReverse, "")
+	_, err = ExecuteNameTx(bs, scs, txBody, sender, receiver, &types.BlockHeaderInfo{No: 20, Version: 2})
+	assert.Error(t, err, "not supported before v3")
+	_, err = ExecuteNameTx(bs, scs, txBody, sender, receiver, blockInfo)
+	assert.NoError(t, err, "set reverse")
+	scs = nextBlockContractState(t, bs, scs)
+
+	info, err := GetNameInfo(sdb.OpenNewStateDB(sdb.GetRoot()), types.EncodeAddress(txBody.Account), blockInfo.No)
+	assert.NoError(t, err, "reverse lookup")
+	assert.Equal(t, "ab1234567890", info.Name.Name, "reverse name")
+	assert.Equal(t, uint64(210), info.Expire, "expire")
+
+	// an expired name is registrable by others
+	other := types.ToAddress("AmNHAxiGbZJjKjdGGNj2NBoAXGwdzX9Bg59eqbek9n49JpiaZ3As")
+	otherState, _ := sdb.GetStateDB().GetAccountStateV(other)
+	otherState.AddBalance(types.MaxAER)
+	txBody.Account = other
+	txBody.Amount = big.NewInt(1000000000000000000).Bytes()
+	txBody.Payload = buildNamePayload(name, types.NameCreate, "")
+	_, err = ExecuteNameTx(bs, scs, txBody, otherState, receiver, blockInfo)
+	assert.Error(t, err, "create occupied name")
+
+	blockInfo.No = 211
+	assert.Nil(t, GetAddress(scs, []byte(name), blockInfo.No), "expired name resolved")
+	assert.Nil(t, GetOwner(scs, []byte(name), blockInfo.No), "expired name owned")
+	txBody.Account = types.ToAddress("AmMXVdJ8DnEFysN58cox9RADC74dF1CLrQimKCMdB4XXMkJeuQgL")
+	txBody.Payload = buildNamePayload(name, types.NameRenew, "")
+	_, err = ExecuteNameTx(bs, scs, txBody, sender, receiver, blockInfo)
+	assert.Error(t, err, "renew expired name")
+	_, err = GetNameInfo(sdb.OpenNewStateDB(sdb.GetRoot()), types.EncodeAddress(txBody.Account), blockInfo.No)
+	assert.Equal(t, types.ErrNameNotFound, err, "expired reverse")
+	info, err = GetNameInfo(sdb.OpenNewStateDB(sdb.GetRoot()), name, blockInfo.No)
+	assert.NoError(t, err, "expired name info")
+	assert.Nil(t, info.Owner, "expired name info owned")
+
+	txBody.Account = other
+	txBody.Payload = buildNamePayload(name, types.NameCreate, "")
+	_, err = ExecuteNameTx(bs, scs, txBody, otherState, receiver, blockInfo)
+	assert.NoError(t, err, "create expired name")
+	scs = nextBlockContractState(t, bs, scs)
+	assert.Equal(t, other, GetOwner(scs, []byte(name), blockInfo.No), "new owner")
+
+	_, err = GetNameInfo(sdb.OpenNewStateDB(sdb.GetRoot()), types.EncodeAddress(types.ToAddress("AmMXVdJ8DnEFysN58cox9RADC74dF1CLrQimKCMdB4XXMkJeuQgL")), blockInfo.No)
+	assert.Equal(t, types.ErrNameNotFound, err, "stale reverse")
+}
+
+func TestExcuteSubName(t *testing.T) {
+	initTest(t)
+	defer deinitTest()
+	txBody := &types.TxBody{}
+	txBody.Account = types.ToAddress("AmMXVdJ8DnEFysN58cox9RADC74dF1CLrQimKCMdB4XXMkJeuQgL")
+	txBody.Recipient = []byte(types.AergoName)
+	txBody.Amount = big.NewInt(1000000000000000000).Bytes()
+
+	name := "AB1234567890"
+	subname := "shop.ab1234567890"
+	department := "AmMSMkVHQ6qRVA7G7rqwjvv2NBwB48tTekJ2jFMrjfZrsofePgay"
+	other := types.ToAddress("AmNHAxiGbZJjKjdGGNj2NBoAXGwdzX9Bg59eqbek9n49JpiaZ3As")
+
+	sender, _ := sdb.GetStateDB().GetAccountStateV(txBody.Account)
+	sender.AddBalance(types.MaxAER)
+	receiver, _ := sdb.GetStateDB().GetAccountStateV(txBody.Recipient)
+	bs := sdb.NewBlockState(sdb.GetRoot())
+	scs := openContractState(t, bs)
+	blockInfo := &types.BlockHeaderInfo{No: uint64(1), Version: 3}
+
+	txBody.Payload = buildNamePayload(name, types.NameCreate, "")
+	_, err := ExecuteNameTx(bs, scs, txBody, sender, receiver, blockInfo)
+	assert.NoError(t, err, "create name")
+	scs = nextBlockContractState(t, bs, scs)
+	assert.Equal(t, 1+uint64(system.DefaultNamePeriod), getNameMap(scs, []byte(name), true).Expire, "default expire")
+
+	txBody.Amount = nil
+	txBody.Payload = buildNamePayload(subname, types.NameSetSub, department)
+	event, err := ExecuteNameTx(bs, scs, txBody, sender, receiver, blockInfo)
+	assert.NoError(t, err, "set subname")
+	assert.Equal(t, "set subname", event[0].EventName, "event name")
+	scs = nextBlockContractState(t, bs, scs)
+
+	assert.Equal(t, department, types.EncodeAddress(GetAddress(scs, []byte(subname), blockInfo.No)), "subname address")
+	resolved, err := Resolve(bs, []byte("SHOP.AB1234567890"), false, blockInfo.No)
+	assert.NoError(t, err, "resolve subname")
+	assert.Equal(t, department, types.EncodeAddress(resolved), "resolve subname")
+
+	otherState, _ := sdb.GetStateDB().GetAccountStateV(other)
+	txBody.Account = other
+	txBody.Payload = buildNamePayload("desk."+subname, types.NameSetSub, department)
+	_, err = ExecuteNameTx(bs, scs, txBody, otherState, receiver, blockInfo)
+	assert.Error(t, err, "not the owner of the parent")
+
+	txBody.Payload = buildNamePayload("x.ab0000000000", types.NameSetSub, department)
+	_, err = ExecuteNameTx(bs, scs, txBody, otherState, receiver, blockInfo)
+	assert.Error(t, err, "parent not found")
+
+	blockInfo.Version = 2
+	txBody.Account = sender.ID()
+	txBody.Payload = buildNamePayload(subname, types.NameSetSub, department)
+	_, err = ExecuteNameTx(bs, scs, txBody, sender, receiver, blockInfo)
+	assert.Error(t, err, "not supported before v3")
+
+	// the subname is dropped with the registration of its parent
+	blockInfo.Version = 3
+	blockInfo.No = 2 + system.DefaultNamePeriod
+	otherState.AddBalance(types.MaxAER)
+	txBody.Account = other
+	txBody.Amount = big.NewInt(1000000000000000000).Bytes()
+	txBody.Payload = buildNamePayload(name, types.NameCreate, "")
+	_, err = ExecuteNameTx(bs, scs, txBody, otherState, receiver, blockInfo)
+	assert.NoError(t, err, "create expired name")
+	scs = nextBlockContractState(t, bs, scs)
+	assert.Equal(t, other, GetAddress(scs, []byte(name), blockInfo.No), "name address")
+	assert.Nil(t, GetAddress(scs, []byte(subname), blockInfo.No), "subname of the previous registration")
+}
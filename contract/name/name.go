@@ -1,6 +1,7 @@
 package name
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 	"strings"
@@ -10,11 +11,22 @@ import (
 )
 
 var prefix = []byte("name")
+var reversePrefix = []byte("reverse")
 
 type NameMap struct {
 	Version     byte
 	Owner       []byte
 	Destination []byte
+	Expire      uint64 // the last block number of the registration, 0 if it never expires
+	Registered  uint64 // the block number of the registration, 0 if before V3
+	Parent      uint64 // Registered of the parent name when the subname was set
+}
+
+// IsExpired reports whether the registration of the name is over at blockNo.
+// An expired name is treated as unregistered: it neither resolves nor has an
+// owner.
+func (n *NameMap) IsExpired(blockNo types.BlockNo) bool {
+	return n.Expire != 0 && n.Expire < blockNo
 }
 
 // AccountStateReader is an interface for getting a name account state.
@@ -22,27 +34,58 @@ type AccountStateReader interface {
 	GetNameAccountState() (*state.ContractState, error)
 }
 
-func CreateName(scs *state.ContractState, tx *types.TxBody, sender, receiver *state.V, name string) error {
+// CreateName registers name to the sender at the block registered. The
+// registration never expires if expire is 0.
+func CreateName(scs *state.ContractState, tx *types.TxBody, sender, receiver *state.V, name string,
+	registered, expire uint64) error {
 	amount := tx.GetAmountBigInt()
 	sender.SubBalance(amount)
 	receiver.AddBalance(amount)
-	return createName(scs, []byte(name), sender.ID())
+	return createName(scs, []byte(name), sender.ID(), registered, expire)
 }
 
-func createName(scs *state.ContractState, name []byte, owner []byte) error {
+func createName(scs *state.ContractState, name []byte, owner []byte, registered, expire uint64) error {
 	//	return setAddress(scs, name, owner)
-	return registerOwner(scs, name, owner, owner)
+	return registerOwner(scs, name, &NameMap{Owner: owner, Destination: owner, Expire: expire, Registered: registered})
+}
+
+// RenewName extends the registration of name by period blocks from its
+// expiration.
+func RenewName(scs *state.ContractState, tx *types.TxBody, sender, receiver *state.V,
+	name string, blockNo types.BlockNo, period uint64) (uint64, error) {
+	nameMap := getRegistered(scs, []byte(name), false, blockNo)
+	if nameMap == nil {
+		return 0, fmt.Errorf("%s is not created yet", name)
+	}
+	amount := tx.GetAmountBigInt()
+	sender.SubBalance(amount)
+	receiver.AddBalance(amount)
+	nameMap.Expire += period
+	return nameMap.Expire, registerOwner(scs, []byte(name), nameMap)
+}
+
+// SetReverse sets name as the name shown for the address which it resolves to.
+func SetReverse(scs *state.ContractState, address []byte, name string) error {
+	return scs.SetData(append(reversePrefix, address...), []byte(strings.ToLower(name)))
+}
+
+func getReverse(scs *state.ContractState, address []byte) []byte {
+	name, err := scs.GetInitialData(append(reversePrefix, address...))
+	if err != nil {
+		return nil
+	}
+	return name
 }
 
 //UpdateName is avaliable after bid implement
 func UpdateName(bs *state.BlockState, scs *state.ContractState, tx *types.TxBody,
-	sender, receiver *state.V, name, to string) error {
+	sender, receiver *state.V, name, to string, blockNo types.BlockNo) error {
 	amount := tx.GetAmountBigInt()
-	if len(getAddress(scs, []byte(name))) <= types.NameLength {
+	if len(getAddress(scs, []byte(name), blockNo)) <= types.NameLength {
 		return fmt.Errorf("%s is not created yet", string(name))
 	}
 	destination, _ := types.DecodeAddress(to)
-	destination = GetAddress(scs, destination)
+	destination = GetAddress(scs, destination, blockNo)
 	sender.SubBalance(amount)
 	receiver.AddBalance(amount)
 	contract, err := bs.StateDB.OpenContractStateAccount(types.ToAccountID(destination))
@@ -60,12 +103,29 @@ func UpdateName(bs *state.BlockState, scs *state.ContractState, tx *types.TxBody
 			return types.ErrTxInvalidRecipient
 		}
 	}
-	return updateName(scs, []byte(name), ownerAddr, destination)
+	nameMap := &NameMap{}
+	if prev := getNameMap(scs, []byte(name), false); prev != nil {
+		nameMap = prev
+	}
+	return updateName(scs, []byte(name), ownerAddr, destination, nameMap)
 }
 
-func updateName(scs *state.ContractState, name []byte, owner []byte, to []byte) error {
+// updateName changes the owner and the destination of the registration
+// nameMap, keeping its term.
+func updateName(scs *state.ContractState, name []byte, owner []byte, to []byte, nameMap *NameMap) error {
 	//return setAddress(scs, name, to)
-	return registerOwner(scs, name, owner, to)
+	nameMap.Owner = owner
+	nameMap.Destination = to
+	return registerOwner(scs, name, nameMap)
+}
+
+// SetSubName points the subname to destination and delegates it to owner.
+// The owner of the parent name keeps the control of the subname. The subname
+// is bound to the current registration of its parent name, so it stops
+// resolving once the parent name expires, even if the name is registered
+// again.
+func SetSubName(scs *state.ContractState, subname string, owner, destination []byte, parent *NameMap) error {
+	return registerOwner(scs, []byte(subname), &NameMap{Owner: owner, Destination: destination, Parent: parent.Registered})
 }
 
 func isPredefined(name []byte, legacy bool) bool {
@@ -75,8 +135,8 @@ func isPredefined(name []byte, legacy bool) bool {
 	return len(name) == types.AddressLength || types.IsSpecialAccount(name)
 }
 
-//Resolve is resolve name for chain
-func Resolve(bs *state.BlockState, name []byte, legacy bool) ([]byte, error) {
+//Resolve is resolve name for chain at the block blockNo
+func Resolve(bs *state.BlockState, name []byte, legacy bool, blockNo types.BlockNo) ([]byte, error) {
 	if isPredefined(name, legacy) {
 		return name, nil
 	}
@@ -84,7 +144,7 @@ func Resolve(bs *state.BlockState, name []byte, legacy bool) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	return getAddress(scs, name), nil
+	return getAddress(scs, name, blockNo), nil
 }
 
 func openContract(bs *state.BlockState) (*state.ContractState, error) {
@@ -99,44 +159,62 @@ func openContract(bs *state.BlockState) (*state.ContractState, error) {
 	return scs, nil
 }
 
-//GetAddress is resolve name for mempool
-func GetAddress(scs *state.ContractState, name []byte) []byte {
+//GetAddress is resolve name for mempool at the block blockNo
+func GetAddress(scs *state.ContractState, name []byte, blockNo types.BlockNo) []byte {
 	if len(name) == types.AddressLength ||
 		types.IsSpecialAccount(name) {
 		return name
 	}
-	return getAddress(scs, name)
+	return getAddress(scs, name, blockNo)
 }
 
 //GetAddressLegacy is resolve name for mempool by buggy logic, leaved for backward compatibility
-func GetAddressLegacy(scs *state.ContractState, name []byte) []byte {
+func GetAddressLegacy(scs *state.ContractState, name []byte, blockNo types.BlockNo) []byte {
 	if len(name) == types.AddressLength ||
 		strings.Contains(string(name), ".") {
 		return name
 	}
-	return getAddress(scs, name)
+	return getAddress(scs, name, blockNo)
 }
 
-func getAddress(scs *state.ContractState, name []byte) []byte {
-	nameMap := getNameMap(scs, name, true)
+func getAddress(scs *state.ContractState, name []byte, blockNo types.BlockNo) []byte {
+	nameMap := getRegistered(scs, name, true, blockNo)
 	if nameMap != nil {
 		return nameMap.Destination
 	}
 	return nil
 }
 
-func GetOwner(scs *state.ContractState, name []byte) []byte {
-	return getOwner(scs, name, true)
+// GetOwner returns the owner of name at the block blockNo.
+func GetOwner(scs *state.ContractState, name []byte, blockNo types.BlockNo) []byte {
+	return getOwner(scs, name, true, blockNo)
 }
 
-func getOwner(scs *state.ContractState, name []byte, useInitial bool) []byte {
-	nameMap := getNameMap(scs, name, useInitial)
+func getOwner(scs *state.ContractState, name []byte, useInitial bool, blockNo types.BlockNo) []byte {
+	nameMap := getRegistered(scs, name, useInitial, blockNo)
 	if nameMap != nil {
 		return nameMap.Owner
 	}
 	return nil
 }
 
+// getRegistered returns the registration of name, or nil if name is not
+// registered or its registration is over at blockNo. A subname is registered
+// only while the registration of its parent, which it was set under, is.
+func getRegistered(scs *state.ContractState, name []byte, useInitial bool, blockNo types.BlockNo) *NameMap {
+	nameMap := getNameMap(scs, name, useInitial)
+	if nameMap == nil || nameMap.IsExpired(blockNo) {
+		return nil
+	}
+	if types.IsSubName(name) {
+		parent := getRegistered(scs, []byte(types.ParentName(string(name))), useInitial, blockNo)
+		if parent == nil || parent.Registered != nameMap.Parent {
+			return nil
+		}
+	}
+	return nameMap
+}
+
 func getNameMap(scs *state.ContractState, name []byte, useInitial bool) *NameMap {
 	lowerCaseName := strings.ToLower(string(name))
 	key := append(prefix, lowerCaseName...)
@@ -153,17 +231,55 @@ func getNameMap(scs *state.ContractState, name []byte, useInitial bool) *NameMap
 	return deserializeNameMap(ownerdata)
 }
 
-func GetNameInfo(r AccountStateReader, name string) (*types.NameInfo, error) {
+// GetNameInfo returns the registration of name at the block blockNo. If an
+// address is given instead, it returns the registration of the reverse name
+// of the address.
+func GetNameInfo(r AccountStateReader, name string, blockNo types.BlockNo) (*types.NameInfo, error) {
 	scs, err := r.GetNameAccountState()
 	if err != nil {
 		return nil, err
 	}
-	owner := getOwner(scs, []byte(name), true)
-	return &types.NameInfo{Name: &types.Name{Name: string(name)}, Owner: owner, Destination: GetAddress(scs, []byte(name))}, err
+	if len(name) > types.NameLength {
+		return getReverseInfo(scs, name, blockNo)
+	}
+	info := &types.NameInfo{Name: &types.Name{Name: string(name)}}
+	if len(name) == types.AddressLength || types.IsSpecialAccount([]byte(name)) {
+		info.Destination = []byte(name)
+	}
+	if nameMap := getRegistered(scs, []byte(name), true, blockNo); nameMap != nil {
+		info.Owner = nameMap.Owner
+		info.Destination = nameMap.Destination
+		info.Expire = nameMap.Expire
+	}
+	return info, err
 }
 
-func registerOwner(scs *state.ContractState, name, owner, destination []byte) error {
-	nameMap := &NameMap{Version: 1, Owner: owner, Destination: destination}
+func getReverseInfo(scs *state.ContractState, address string, blockNo types.BlockNo) (*types.NameInfo, error) {
+	addr, err := types.DecodeAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	name := getReverse(scs, addr)
+	if name == nil {
+		return nil, types.ErrNameNotFound
+	}
+	nameMap := getRegistered(scs, name, true, blockNo)
+	// the name may have been moved to another address after the reverse was set
+	if nameMap == nil || !bytes.Equal(nameMap.Destination, addr) {
+		return nil, types.ErrNameNotFound
+	}
+	return &types.NameInfo{Name: &types.Name{Name: string(name)}, Owner: nameMap.Owner, Destination: addr, Expire: nameMap.Expire}, nil
+}
+
+func registerOwner(scs *state.ContractState, name []byte, nameMap *NameMap) error {
+	switch {
+	case nameMap.Registered != 0 || nameMap.Parent != 0:
+		nameMap.Version = 3
+	case nameMap.Expire != 0:
+		nameMap.Version = 2
+	default:
+		nameMap.Version = 1
+	}
 	return setNameMap(scs, name, nameMap)
 }
 
@@ -184,6 +300,16 @@ func serializeNameMap(n *NameMap) []byte {
 		binary.LittleEndian.PutUint64(buf, uint64(len(n.Destination)))
 		ret = append(ret, buf...)
 		ret = append(ret, n.Destination...)
+		if n.Version >= 2 {
+			binary.LittleEndian.PutUint64(buf, n.Expire)
+			ret = append(ret, buf...)
+		}
+		if n.Version >= 3 {
+			binary.LittleEndian.PutUint64(buf, n.Registered)
+			ret = append(ret, buf...)
+			binary.LittleEndian.PutUint64(buf, n.Parent)
+			ret = append(ret, buf...)
+		}
 	}
 	return ret
 }
@@ -191,7 +317,7 @@ func serializeNameMap(n *NameMap) []byte {
 func deserializeNameMap(data []byte) *NameMap {
 	if data != nil {
 		version := data[0]
-		if version != 1 {
+		if version < 1 || version > 3 {
 			panic("could not deserializeOwner, not supported version")
 		}
 		offset := 1
@@ -209,10 +335,29 @@ func deserializeNameMap(data []byte) *NameMap {
 		offset = next
 		next = offset + int(sizeOfDest)
 		destination := data[offset:next]
+
+		var expire, registered, parent uint64
+		if version >= 2 {
+			offset = next
+			next = offset + 8
+			expire = binary.LittleEndian.Uint64(data[offset:next])
+		}
+		if version >= 3 {
+			offset = next
+			next = offset + 8
+			registered = binary.LittleEndian.Uint64(data[offset:next])
+
+			offset = next
+			next = offset + 8
+			parent = binary.LittleEndian.Uint64(data[offset:next])
+		}
 		return &NameMap{
 			Version:     version,
 			Owner:       owner,
 			Destination: destination,
+			Expire:      expire,
+			Registered:  registered,
+			Parent:      parent,
 		}
 	}
 	return nil
@@ -12,7 +12,9 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/aergoio/aergo/internal/enc"
 
@@ -38,6 +40,10 @@ var (
 const (
 	statesqlDriver = "statesql"
 	queryDriver    = "query"
+
+	sqlQueryDefaultLimit = 100
+	sqlQueryMaxLimit     = 1000
+	sqlQueryTimeout      = 3 * time.Second
 )
 
 type sqlDatabase struct {
@@ -271,6 +277,140 @@ func openDB(dbName string) (*litetree, error) {
 	return database.DBs[dbName], nil
 }
 
+// GetDBStats returns the size of the sql database of a contract and the
+// number of rows in each of its tables as of contractState.
+func GetDBStats(contractState *state.ContractState) (*types.ContractDBStats, error) {
+	aid := contractState.GetAccountID()
+	stats := &types.ContractDBStats{}
+	rp := contractState.State.GetSqlRecoveryPoint()
+	if rp == 0 {
+		return stats, nil
+	}
+	if _, err := os.Stat(filepath.Join(database.DataDir, aid.String()+".db")); os.IsNotExist(err) {
+		return stats, nil
+	}
+	tx, err := beginReadOnly(aid.String(), rp)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.close()
+
+	db := tx.(*readOnlySqlTx).litetree
+	ctx := context.Background()
+	if err = db.QueryRowContext(ctx, "pragma page_count").Scan(&stats.PageCount); err != nil {
+		return nil, err
+	}
+	if err = db.QueryRowContext(ctx, "pragma page_size").Scan(&stats.PageSize); err != nil {
+		return nil, err
+	}
+	stats.Size = stats.PageCount * stats.PageSize
+
+	rows, err := db.QueryContext(ctx, "select name from sqlite_master where type = 'table' order by name")
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for rows.Next() {
+		var name string
+		if err = rows.Scan(&name); err != nil {
+			_ = rows.Close()
+			return nil, err
+		}
+		// _dummy is created by the node to initialize the database
+		if name != "_dummy" {
+			names = append(names, name)
+		}
+	}
+	if err = rows.Close(); err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		t := &types.TableStats{Name: name}
+		q := `select count(*) from "` + strings.Replace(name, `"`, `""`, -1) + `"`
+		if err = db.QueryRowContext(ctx, q).Scan(&t.Rows); err != nil {
+			return nil, err
+		}
+		stats.Tables = append(stats.Tables, t)
+	}
+	return stats, nil
+}
+
+// QuerySQL runs the read-only query against the sql database of a contract
+// as of contractState. It returns at most limit rows of the result, each
+// encoded as a JSON array of the column values.
+func QuerySQL(contractState *state.ContractState, query string, limit uint32) (*types.SQLQueryResult, error) {
+	if !cReadOnlySql(query) {
+		return nil, errors.New("only select queries allowed")
+	}
+	if limit == 0 {
+		limit = sqlQueryDefaultLimit
+	} else if limit > sqlQueryMaxLimit {
+		limit = sqlQueryMaxLimit
+	}
+	aid := contractState.GetAccountID()
+	rp := contractState.State.GetSqlRecoveryPoint()
+	if rp == 0 {
+		return nil, errors.New("the contract has no sql database")
+	}
+	if _, err := os.Stat(filepath.Join(database.DataDir, aid.String()+".db")); os.IsNotExist(err) {
+		return nil, errors.New("the contract has no sql database")
+	}
+	tx, err := beginReadOnly(aid.String(), rp)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), sqlQueryTimeout)
+	defer cancel()
+	rows, err := tx.(*readOnlySqlTx).litetree.QueryContext(ctx, query)
+	if err != nil {
+		return nil, sqlQueryError(ctx, err)
+	}
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	result := &types.SQLQueryResult{Columns: cols}
+	values := make([]interface{}, len(cols))
+	dest := make([]interface{}, len(cols))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	for rows.Next() {
+		if len(result.Rows) == int(limit) {
+			result.Truncated = true
+			break
+		}
+		if err = rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		for i, v := range values {
+			if b, ok := v.([]byte); ok {
+				values[i] = string(b)
+			}
+		}
+		row, err := json.Marshal(values)
+		if err != nil {
+			return nil, err
+		}
+		result.Rows = append(result.Rows, string(row))
+	}
+	if err = rows.Err(); err != nil {
+		return nil, sqlQueryError(ctx, err)
+	}
+	return result, nil
+}
+
+func sqlQueryError(ctx context.Context, err error) error {
+	if ctx.Err() == context.DeadlineExceeded {
+		return fmt.Errorf("the query exceeded the timeout(%s)", sqlQueryTimeout)
+	}
+	return err
+}
+
 type litetree struct {
 	*sql.Conn
 	db        *sql.DB
@@ -21,8 +21,9 @@ type SystemContext struct {
 	Call      *types.CallInfo
 	Args      []string
 	Staked    *types.Staking
+	Unbonding []*types.Unbonding
 	Vote      *types.Vote // voting
-	Proposal  *Proposal   // voting
+	Proposal  *Proposal   // voting, creating or executing a proposal
 	Sender    *state.V
 	Receiver  *state.V
 
@@ -62,10 +63,14 @@ func newSysCmd(account []byte, txBody *types.TxBody, sender, receiver *state.V,
 	scs *state.ContractState, blockInfo *types.BlockHeaderInfo) (sysCmd, error) {
 
 	cmds := map[types.OpSysTx]sysCmdCtor{
-		types.OpvoteBP:    newVoteCmd,
-		types.OpvoteDAO: newVoteCmd,
-		types.Opstake:     newStakeCmd,
-		types.Opunstake:   newUnstakeCmd,
+		types.OpvoteBP:          newVoteCmd,
+		types.OpvoteDAO:         newVoteCmd,
+		types.Opstake:           newStakeCmd,
+		types.Opunstake:         newUnstakeCmd,
+		types.Opunbond:          newUnbondCmd,
+		types.Opwithdraw:        newWithdrawCmd,
+		types.OpcreateProposal:  newCreateProposalCmd,
+		types.OpexecuteProposal: newExecuteProposalCmd,
 	}
 
 	context, err := newSystemContext(account, txBody, sender, receiver, scs, blockInfo)
@@ -100,7 +105,11 @@ func ExecuteSystemTx(scs *state.ContractState, txBody *types.TxBody,
 func GetVotes(scs *state.ContractState, address []byte) ([]*types.VoteInfo, error) {
 	var results []*types.VoteInfo
 
-	for _, i := range GetVotingCatalog() {
+	issues, err := getVotingIssues(scs, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range issues {
 		id := i.ID()
 		key := i.Key()
 
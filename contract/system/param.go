@@ -25,6 +25,14 @@ const (
 	sysParamMax
 )
 
+// namePeriodID is the parameter of the name registration period. It is not
+// in the voting catalog and is only changed by the proposals of the stakers.
+const namePeriodID = "NAMEPERIOD"
+
+// DefaultNamePeriod is the name registration period, about a year of blocks,
+// used until a proposal sets NAMEPERIOD.
+const DefaultNamePeriod = 365 * 24 * 60 * 60
+
 var (
 	systemParams parameters
 
@@ -59,6 +67,17 @@ func loadParam(g dataGetter) parameters {
 		}
 		ret[id] = new(big.Int).SetBytes(data)
 	}
+	ids, err := getStringList(g, paramListKey)
+	if err != nil {
+		panic("could not load blockchain parameter list")
+	}
+	for _, id := range ids {
+		data, err := g.GetData(genParamKey(id))
+		if err != nil {
+			panic("could not load blockchain parameter")
+		}
+		ret[id] = new(big.Int).SetBytes(data)
+	}
 	return ret
 }
 
@@ -98,6 +117,19 @@ func GetNamePriceFromState(scs *state.ContractState) *big.Int {
 	return getParamFromState(scs, namePrice)
 }
 
+// GetNamePeriodFromState returns the number of blocks for which a name is
+// registered. It is DefaultNamePeriod unless a proposal set NAMEPERIOD.
+func GetNamePeriodFromState(scs *state.ContractState) uint64 {
+	data, err := scs.GetInitialData(genParamKey(namePeriodID))
+	if err != nil {
+		panic("could not get blockchain parameter")
+	}
+	if len(data) == 0 {
+		return DefaultNamePeriod
+	}
+	return new(big.Int).SetBytes(data).Uint64()
+}
+
 func GetStakingMinimumFromState(scs *state.ContractState) *big.Int {
 	return getParamFromState(scs, stakingMin)
 }
@@ -4,13 +4,40 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"regexp"
+	"strconv"
 	"strings"
 
+	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
 )
 
 const proposalPrefixKey = "proposal" //aergo proposal format
 
+const (
+	// ProposalIDPrefix is the prefix of the IDs of the proposals created by the stakers.
+	ProposalIDPrefix = "PROPOSAL_"
+
+	ProposalYes = "YES"
+	ProposalNo  = "NO"
+
+	MaxProposalDescription = 1024
+	MinProposalPeriod      = VotingDelay
+	MaxProposalPeriod      = 30 * VotingDelay
+	MinProposalQuorum      = 10
+	MinProposalTimelock    = VotingDelay
+	MaxProposalTimelock    = 30 * VotingDelay
+	MaxActiveProposals     = 32
+)
+
+var (
+	proposalSeqKey    = []byte(proposalPrefixKey + "seq")
+	proposalActiveKey = []byte(proposalPrefixKey + "active")
+	paramListKey      = []byte("paramlist")
+
+	paramIDPattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]{0,31}$`)
+)
+
 func (i sysParamIndex) ID() string {
 	return strings.ToUpper(i.String())
 }
@@ -37,6 +64,15 @@ type Proposal struct {
 	MultipleChoice uint32
 	Candidates     []string
 	Default        *big.Int
+
+	// The fields below are only used by the proposals created by the stakers.
+	Proposer string `json:",omitempty"`
+	Target   string `json:",omitempty"`
+	Value    string `json:",omitempty"`
+	Quorum   uint32 `json:",omitempty"` // percentage of the total staking
+	Timelock uint64   `json:",omitempty"` // blocks between the end of voting and the execution
+	Executed bool     `json:",omitempty"`
+	Total    *big.Int `json:",omitempty"` // the staking total when the voting was closed
 }
 
 var SystemProposal = map[string]*Proposal{
@@ -78,6 +114,47 @@ func (a *Proposal) GetKey() []byte {
 	return []byte(strings.ToUpper(a.ID))
 }
 
+// IsGeneral reports whether a is created by a staker rather than predefined
+// for a system parameter.
+func (a *Proposal) IsGeneral() bool {
+	return len(a.Target) != 0
+}
+
+// ExecutableFrom returns the first block number at which the approved
+// proposal can be applied.
+func (a *Proposal) ExecutableFrom() uint64 {
+	return a.Blockto + a.Timelock + 1
+}
+
+func (a *Proposal) toProposalInfo() *types.ProposalInfo {
+	proposer, _ := types.DecodeAddress(a.Proposer)
+	return &types.ProposalInfo{
+		Id:          a.ID,
+		Description: a.Description,
+		Proposer:    proposer,
+		Target:      a.Target,
+		Value:       a.Value,
+		Blockfrom:   a.Blockfrom,
+		Blockto:     a.Blockto,
+		Quorum:      a.Quorum,
+		Timelock:    a.Timelock,
+		Executed:    a.Executed,
+		Candidates:  a.Candidates,
+	}
+}
+
+// proposalIssue is a voting issue corresponding to a proposal created by a
+// staker.
+type proposalIssue string
+
+func (i proposalIssue) ID() string {
+	return string(i)
+}
+
+func (i proposalIssue) Key() []byte {
+	return GenProposalKey(string(i))
+}
+
 func GenProposalKey(id string) []byte {
 	return []byte(strings.ToUpper(id))
 }
@@ -100,6 +177,160 @@ func setProposal(proposal *Proposal) {
 	SystemProposal[proposal.ID] = proposal
 }
 
+func isProposalID(id string) bool {
+	return strings.HasPrefix(strings.ToUpper(id), ProposalIDPrefix)
+}
+
+func genProposalStateKey(id string) []byte {
+	return []byte(proposalPrefixKey + "\\" + strings.ToUpper(id))
+}
+
+// loadProposal finds a proposal using id, either from the predefined system
+// proposals or from the ones created by the stakers.
+func loadProposal(scs *state.ContractState, id string) (*Proposal, error) {
+	if !isProposalID(id) {
+		return getProposal(id)
+	}
+	data, err := scs.GetData(genProposalStateKey(id))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("proposal %s is not found", id)
+	}
+	proposal := deserializeProposal(data)
+	if proposal == nil {
+		return nil, fmt.Errorf("proposal %s is corrupted", id)
+	}
+	return proposal, nil
+}
+
+func storeProposal(scs *state.ContractState, proposal *Proposal) error {
+	return scs.SetData(genProposalStateKey(proposal.ID), serializeProposal(proposal))
+}
+
+func nextProposalID(scs *state.ContractState) (string, error) {
+	data, err := scs.GetData(proposalSeqKey)
+	if err != nil {
+		return "", err
+	}
+	seq := new(big.Int).Add(new(big.Int).SetBytes(data), big.NewInt(1))
+	if err := scs.SetData(proposalSeqKey, seq.Bytes()); err != nil {
+		return "", err
+	}
+	return ProposalIDPrefix + seq.String(), nil
+}
+
+func getStringList(g dataGetter, key []byte) ([]string, error) {
+	data, err := g.GetData(key)
+	if err != nil {
+		return nil, err
+	}
+	var list []string
+	if len(data) == 0 {
+		return list, nil
+	}
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func setStringList(s dataSetter, key []byte, list []string) error {
+	data, err := json.Marshal(list)
+	if err != nil {
+		return err
+	}
+	return s.SetData(key, data)
+}
+
+// getActiveProposals returns the proposals which are still being voted at
+// blockNo.
+func getActiveProposals(scs *state.ContractState, blockNo uint64) ([]*Proposal, error) {
+	ids, err := getStringList(scs, proposalActiveKey)
+	if err != nil {
+		return nil, err
+	}
+	var active []*Proposal
+	for _, id := range ids {
+		proposal, err := loadProposal(scs, id)
+		if err != nil {
+			return nil, err
+		}
+		if proposal.Blockto >= blockNo {
+			active = append(active, proposal)
+		}
+	}
+	return active, nil
+}
+
+// addActiveProposal appends the proposal to the active list, while dropping
+// the ones whose voting has already been closed.
+func addActiveProposal(scs *state.ContractState, proposal *Proposal, blockNo uint64) error {
+	if err := closeProposals(scs, blockNo); err != nil {
+		return err
+	}
+	ids, err := getStringList(scs, proposalActiveKey)
+	if err != nil {
+		return err
+	}
+	return setStringList(scs, proposalActiveKey, append(ids, proposal.ID))
+}
+
+// closeProposals records the staking total on the proposals whose voting has
+// been closed before blockNo, and drops them from the active list. It is
+// called before any change of the staking total, so the recorded total is the
+// one when the voting was closed.
+func closeProposals(scs *state.ContractState, blockNo uint64) error {
+	ids, err := getStringList(scs, proposalActiveKey)
+	if err != nil || len(ids) == 0 {
+		return err
+	}
+	var total *big.Int
+	active := make([]string, 0, len(ids))
+	for _, id := range ids {
+		proposal, err := loadProposal(scs, id)
+		if err != nil {
+			return err
+		}
+		if proposal.Blockto >= blockNo {
+			active = append(active, id)
+			continue
+		}
+		if total == nil {
+			if total, err = getStakingTotal(scs); err != nil {
+				return err
+			}
+		}
+		proposal.Total = total
+		if err := storeProposal(scs, proposal); err != nil {
+			return err
+		}
+	}
+	if len(active) == len(ids) {
+		return nil
+	}
+	return setStringList(scs, proposalActiveKey, active)
+}
+
+// GetProposals returns all the proposals created by the stakers.
+func GetProposals(scs *state.ContractState) (*types.ProposalList, error) {
+	data, err := scs.GetData(proposalSeqKey)
+	if err != nil {
+		return nil, err
+	}
+	seq := new(big.Int).SetBytes(data).Uint64()
+	list := &types.ProposalList{}
+	for i := uint64(1); i <= seq; i++ {
+		proposal, err := loadProposal(scs, ProposalIDPrefix+strconv.FormatUint(i, 10))
+		if err != nil {
+			return nil, err
+		}
+		list.Proposals = append(list.Proposals, proposal.toProposalInfo())
+	}
+	return list, nil
+}
+
 func serializeProposal(proposal *Proposal) []byte {
 	data, err := json.Marshal(proposal)
 	if err != nil {
@@ -127,6 +358,10 @@ func serializeProposalHistory(wtv whereToVotes) []byte {
 	return data
 }
 
+func isValidParamID(id string) bool {
+	return paramIDPattern.MatchString(id) && !isProposalID(id)
+}
+
 func isValidID(id string) bool {
 	for i := sysParamIndex(0); i < sysParamMax; i++ {
 		if strings.ToUpper(id) == i.ID() {
@@ -135,3 +370,119 @@ func isValidID(id string) bool {
 	}
 	return false
 }
+
+type createProposalCmd struct {
+	*SystemContext
+}
+
+func newCreateProposalCmd(ctx *SystemContext) (sysCmd, error) {
+	return &createProposalCmd{SystemContext: ctx}, nil
+}
+
+func (c *createProposalCmd) run() (*types.Event, error) {
+	var (
+		scs      = c.scs
+		proposal = c.Proposal
+		err      error
+	)
+
+	if proposal.ID, err = nextProposalID(scs); err != nil {
+		return nil, err
+	}
+	if err := storeProposal(scs, proposal); err != nil {
+		return nil, err
+	}
+	if err := addActiveProposal(scs, proposal, c.BlockInfo.No); err != nil {
+		return nil, err
+	}
+	return &types.Event{
+		ContractAddress: c.Receiver.ID(),
+		EventIdx:        0,
+		EventName:       c.op.ID(),
+		JsonArgs: `["` +
+			types.EncodeAddress(c.Sender.ID()) +
+			`", "` + proposal.ID + `", "` + proposal.Target +
+			`", {"_bignum":"` + proposal.Value + `"}]`,
+	}, nil
+}
+
+type executeProposalCmd struct {
+	*SystemContext
+}
+
+func newExecuteProposalCmd(ctx *SystemContext) (sysCmd, error) {
+	return &executeProposalCmd{SystemContext: ctx}, nil
+}
+
+func (c *executeProposalCmd) run() (*types.Event, error) {
+	var (
+		scs      = c.scs
+		proposal = c.Proposal
+	)
+
+	value, _ := new(big.Int).SetString(proposal.Value, 10) // already checked
+	if _, err := updateParam(scs, proposal.Target, value); err != nil {
+		return nil, err
+	}
+	if err := addParamID(scs, proposal.Target); err != nil {
+		return nil, err
+	}
+	proposal.Executed = true
+	if err := storeProposal(scs, proposal); err != nil {
+		return nil, err
+	}
+	return &types.Event{
+		ContractAddress: c.Receiver.ID(),
+		EventIdx:        0,
+		EventName:       c.op.ID(),
+		JsonArgs: `["` + proposal.ID + `", "` + proposal.Target +
+			`", {"_bignum":"` + proposal.Value + `"}]`,
+	}, nil
+}
+
+// addParamID records the id of a parameter which isn't predefined, so that it
+// can be loaded on start-up.
+func addParamID(scs *state.ContractState, id string) error {
+	if isValidID(id) {
+		return nil
+	}
+	ids, err := getStringList(scs, paramListKey)
+	if err != nil {
+		return err
+	}
+	for _, i := range ids {
+		if i == id {
+			return nil
+		}
+	}
+	return setStringList(scs, paramListKey, append(ids, id))
+}
+
+// isApproved reports whether the voting result of the proposal satisfies the
+// quorum and the majority of the voters agree. The quorum is against the
+// staking total when the voting was closed.
+func isApproved(scs *state.ContractState, proposal *Proposal) (bool, error) {
+	voteResult, err := loadVoteResult(scs, proposal.GetKey())
+	if err != nil {
+		return false, err
+	}
+	total := proposal.Total
+	if total == nil {
+		// the staking total hasn't changed since the voting was closed
+		if total, err = getStakingTotal(scs); err != nil {
+			return false, err
+		}
+	}
+	if total.Sign() == 0 {
+		return false, nil
+	}
+	quorum := new(big.Int).Mul(total, big.NewInt(int64(proposal.Quorum)))
+	if new(big.Int).Mul(voteResult.GetTotal(), big.NewInt(100)).Cmp(quorum) < 0 {
+		return false, nil
+	}
+	yes, no := voteResult.rmap[ProposalYes], voteResult.rmap[ProposalNo]
+	if yes == nil {
+		return false, nil
+	}
+	return no == nil || yes.Cmp(no) > 0, nil
+}
@@ -223,3 +223,136 @@ func TestFailProposals(t *testing.T) {
 	assert.NoError(t, err, "valid")
 	assert.Equal(t, big.NewInt(101), GetGasPrice(), "check gas price")
 }
+
+func TestGeneralProposal(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+
+	balance1 := types.StakingMinimum
+	balance3 := new(big.Int).Mul(balance1, big.NewInt(3))
+
+	sender2 := getSender(t, "AmNqJN2P1MA2Uc6X5byA4mDg2iuo95ANAyWCmd3LkZe4GhJkSyr4")
+	sender3 := getSender(t, "AmLt7Z3y2XTu7YS8KHNuyKM2QAszpFHSX77FLKEt7FAuRW7GEhj7")
+	sender.AddBalance(balance3)
+	sender2.AddBalance(balance3)
+	sender3.AddBalance(balance3)
+
+	blockInfo := &types.BlockHeaderInfo{No: uint64(1), Version: 3}
+	stakingTx := &types.Tx{
+		Body: &types.TxBody{
+			Account: sender.ID(),
+			Amount:  balance1.Bytes(),
+			Payload: buildStakingPayload(true),
+			Type:    types.TxType_GOVERNANCE,
+		},
+	}
+	for _, s := range []*state.V{sender, sender2, sender3} {
+		stakingTx.Body.Account = s.ID()
+		_, err := ExecuteSystemTx(scs, stakingTx.GetBody(), s, receiver, blockInfo)
+		assert.NoError(t, err, "could not execute system tx")
+	}
+
+	createPayload := func(target, value string, period, quorum, timelock int) []byte {
+		return []byte(fmt.Sprintf(`{"Name":"v1createProposal", "Args":["%s", "%s", "", "%d", "%d", "%d"]}`,
+			target, value, period, quorum, timelock))
+	}
+	tx := &types.TxBody{
+		Account: sender.ID(),
+		Payload: createPayload("maxcallsize", "100", MinProposalPeriod, 50, MinProposalTimelock),
+		Type:    types.TxType_GOVERNANCE,
+	}
+	_, err := ExecuteSystemTx(scs, tx, sender, receiver, &types.BlockHeaderInfo{No: 1, Version: 2})
+	assert.Error(t, err, "before v3")
+
+	invalid := &types.TxBody{
+		Account: sender.ID(),
+		Payload: createPayload("bpcount", "10", MinProposalPeriod, 50, MinProposalTimelock),
+		Type:    types.TxType_GOVERNANCE,
+	}
+	_, err = ExecuteSystemTx(scs, invalid, sender, receiver, blockInfo)
+	assert.Error(t, err, "predefined system parameter")
+	for _, payload := range [][]byte{
+		createPayload("maxcallsize", "100", MinProposalPeriod, 101, MinProposalTimelock),
+		createPayload("maxcallsize", "100", MinProposalPeriod, MinProposalQuorum-1, MinProposalTimelock),
+		createPayload("maxcallsize", "100", MinProposalPeriod-1, 50, MinProposalTimelock),
+		createPayload("maxcallsize", "100", MinProposalPeriod, 50, MinProposalTimelock-1),
+	} {
+		invalid.Payload = payload
+		_, err = ExecuteSystemTx(scs, invalid, sender, receiver, blockInfo)
+		assert.Error(t, err, "invalid quorum, period or timelock")
+	}
+
+	events, err := ExecuteSystemTx(scs, tx, sender, receiver, blockInfo)
+	assert.NoError(t, err, "could not create proposal")
+	assert.Equal(t, types.OpcreateProposal.ID(), events[0].EventName, "event name")
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, blockInfo)
+	assert.NoError(t, err, "could not create proposal")
+
+	proposals, err := GetProposals(scs)
+	assert.NoError(t, err, "could not get proposals")
+	assert.Equal(t, 2, len(proposals.GetProposals()), "proposal count")
+	assert.Equal(t, "PROPOSAL_1", proposals.GetProposals()[0].GetId(), "proposal id")
+	assert.Equal(t, "MAXCALLSIZE", proposals.GetProposals()[0].GetTarget(), "proposal target")
+	blockto := 1 + uint64(MinProposalPeriod)
+	assert.Equal(t, blockto, proposals.GetProposals()[0].GetBlockto(), "proposal blockto")
+
+	blockInfo.No++
+	vote := func(s *state.V, id, candidate string) error {
+		tx := &types.TxBody{
+			Account: s.ID(),
+			Payload: []byte(`{"Name":"v1voteDAO", "Args":["` + id + `", "` + candidate + `"]}`),
+			Type:    types.TxType_GOVERNANCE,
+		}
+		_, err := ExecuteSystemTx(scs, tx, s, receiver, blockInfo)
+		return err
+	}
+	assert.Error(t, vote(sender, "proposal_1", "13"), "invalid candidate")
+	assert.NoError(t, vote(sender, "proposal_1", ProposalYes), "vote yes")
+	assert.NoError(t, vote(sender2, "proposal_1", ProposalYes), "vote yes")
+	assert.NoError(t, vote(sender3, "proposal_1", ProposalNo), "vote no")
+	assert.NoError(t, vote(sender, "proposal_2", ProposalYes), "vote yes")
+	assert.Nil(t, GetParam("MAXCALLSIZE"), "not applied by voting")
+
+	votes, err := GetVoteResult(&TestAccountStateReader{Scs: scs}, []byte("proposal_1"), 2)
+	assert.NoError(t, err, "could not get vote result")
+	assert.Equal(t, ProposalYes, string(votes.Votes[0].Candidate), "winner")
+
+	execute := &types.TxBody{
+		Account: sender3.ID(),
+		Payload: []byte(`{"Name":"v1executeProposal", "Args":["PROPOSAL_1"]}`),
+		Type:    types.TxType_GOVERNANCE,
+	}
+	blockInfo.No = blockto + 1
+	assert.Error(t, vote(sender2, "proposal_1", ProposalNo), "voting was closed")
+	_, err = ExecuteSystemTx(scs, execute, sender3, receiver, blockInfo)
+	assert.Error(t, err, "timelock")
+
+	blockInfo.No = blockto + MinProposalTimelock + 1
+	events, err = ExecuteSystemTx(scs, execute, sender3, receiver, blockInfo)
+	assert.NoError(t, err, "could not execute proposal")
+	assert.Equal(t, types.OpexecuteProposal.ID(), events[0].EventName, "event name")
+	assert.Equal(t, big.NewInt(100), GetParam("MAXCALLSIZE"), "applied")
+	_, err = ExecuteSystemTx(scs, execute, sender3, receiver, blockInfo)
+	assert.Error(t, err, "already executed")
+
+	// the quorum is against the staking total when the voting was closed
+	for _, s := range []*state.V{sender2, sender3} {
+		unbond := &types.TxBody{
+			Account: s.ID(),
+			Amount:  balance1.Bytes(),
+			Payload: []byte(`{"Name":"v1unbond"}`),
+			Type:    types.TxType_GOVERNANCE,
+		}
+		_, err = ExecuteSystemTx(scs, unbond, s, receiver, blockInfo)
+		assert.NoError(t, err, "could not unbond")
+	}
+	proposal, err := loadProposal(scs, "PROPOSAL_2")
+	assert.NoError(t, err, "could not load proposal")
+	assert.Equal(t, balance3, proposal.Total, "staking total at the close of voting")
+
+	execute.Payload = []byte(`{"Name":"v1executeProposal", "Args":["PROPOSAL_2"]}`)
+	_, err = ExecuteSystemTx(scs, execute, sender3, receiver, blockInfo)
+	assert.Error(t, err, "quorum is not satisfied")
+
+	assert.Equal(t, big.NewInt(100), loadParam(scs)["MAXCALLSIZE"], "reload parameters")
+}
@@ -0,0 +1,172 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package system
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+	"github.com/minio/sha256-simd"
+)
+
+var scheduleKey = []byte("schedule")
+
+const (
+	// MaxScheduledCalls is the maximum number of the calls scheduled at a
+	// block.
+	MaxScheduledCalls = 32
+	// MaxScheduledCallGas is the maximum gas limit of a scheduled call.
+	MaxScheduledCallGas = 1000000
+	// MaxScheduledBlockGas is the maximum sum of the gas limits of the calls
+	// scheduled at a block, which are executed in addition to the
+	// transactions of the block.
+	MaxScheduledBlockGas = 10 * MaxScheduledCallGas
+	// MaxScheduleDelay is the maximum number of blocks between the block
+	// where a call is scheduled and the block where it is executed, which
+	// bounds how long the prepaid fee is held.
+	MaxScheduleDelay = 7 * 24 * 60 * 60
+)
+
+var errInvalidScheduledCall = errors.New("invalid scheduled call data")
+
+// ScheduledCall is a call registered by a contract to itself, which is
+// executed by the chain at the beginning of the scheduled block. Fee is the
+// prepaid fee held by the system account until the call is executed.
+type ScheduledCall struct {
+	Contract []byte
+	Function string
+	Args     string
+	GasLimit uint64
+	Fee      *big.Int
+}
+
+// AddScheduledCall appends call to the queue of the block blockNo and returns
+// the index of call in the queue.
+func AddScheduledCall(scs *state.ContractState, blockNo types.BlockNo, call *ScheduledCall) (int, error) {
+	calls, err := GetScheduledCalls(scs, blockNo)
+	if err != nil {
+		return -1, err
+	}
+	if len(calls) >= MaxScheduledCalls {
+		return -1, fmt.Errorf("exceeded the maximum number of calls scheduled at block %d(%d)", blockNo, MaxScheduledCalls)
+	}
+	if call.GasLimit > MaxScheduledCallGas {
+		return -1, fmt.Errorf("exceeded the maximum gas of a scheduled call(%d)", MaxScheduledCallGas)
+	}
+	blockGas := call.GasLimit
+	for _, c := range calls {
+		blockGas += c.GasLimit
+	}
+	if blockGas > MaxScheduledBlockGas {
+		return -1, fmt.Errorf("exceeded the maximum gas of calls scheduled at block %d(%d)", blockNo, MaxScheduledBlockGas)
+	}
+	calls = append(calls, call)
+	if err = scs.SetData(scheduleBlockKey(blockNo), serializeScheduledCalls(calls)); err != nil {
+		return -1, err
+	}
+	return len(calls) - 1, nil
+}
+
+// GetScheduledCalls returns the calls scheduled at the block blockNo.
+func GetScheduledCalls(scs *state.ContractState, blockNo types.BlockNo) ([]*ScheduledCall, error) {
+	data, err := scs.GetData(scheduleBlockKey(blockNo))
+	if err != nil {
+		return nil, err
+	}
+	return deserializeScheduledCalls(data)
+}
+
+// RemoveScheduledCalls removes the queue of the block blockNo.
+func RemoveScheduledCalls(scs *state.ContractState, blockNo types.BlockNo) error {
+	return scs.DeleteData(scheduleBlockKey(blockNo))
+}
+
+// ScheduledTxHash returns the synthetic tx hash to which the receipt of the
+// idx-th call scheduled at the block blockNo is attributed.
+func ScheduledTxHash(blockNo types.BlockNo, idx int, contract []byte) []byte {
+	buf := make([]byte, 12)
+	binary.BigEndian.PutUint64(buf, blockNo)
+	binary.BigEndian.PutUint32(buf[8:], uint32(idx))
+	h := sha256.New()
+	h.Write(scheduleKey)
+	h.Write(buf)
+	h.Write(contract)
+	return h.Sum(nil)
+}
+
+func scheduleBlockKey(blockNo types.BlockNo) []byte {
+	key := make([]byte, len(scheduleKey)+8)
+	copy(key, scheduleKey)
+	binary.BigEndian.PutUint64(key[len(scheduleKey):], blockNo)
+	return key
+}
+
+func serializeScheduledCalls(calls []*ScheduledCall) []byte {
+	var ret []byte
+	putBytes := func(b []byte) {
+		l := make([]byte, binary.MaxVarintLen64)
+		ret = append(ret, l[:binary.PutUvarint(l, uint64(len(b)))]...)
+		ret = append(ret, b...)
+	}
+	for _, c := range calls {
+		putBytes(c.Contract)
+		putBytes([]byte(c.Function))
+		putBytes([]byte(c.Args))
+		gas := make([]byte, 8)
+		binary.LittleEndian.PutUint64(gas, c.GasLimit)
+		ret = append(ret, gas...)
+		putBytes(c.Fee.Bytes())
+	}
+	return ret
+}
+
+func deserializeScheduledCalls(data []byte) ([]*ScheduledCall, error) {
+	var ret []*ScheduledCall
+	offset := 0
+	getBytes := func() ([]byte, error) {
+		l, n := binary.Uvarint(data[offset:])
+		if n <= 0 || uint64(len(data)-offset-n) < l {
+			return nil, errInvalidScheduledCall
+		}
+		offset += n
+		b := data[offset : offset+int(l)]
+		offset += int(l)
+		return b, nil
+	}
+	for offset < len(data) {
+		var (
+			c            = &ScheduledCall{}
+			fn, args, fe []byte
+			err          error
+		)
+		if c.Contract, err = getBytes(); err != nil {
+			return nil, err
+		}
+		if fn, err = getBytes(); err != nil {
+			return nil, err
+		}
+		if args, err = getBytes(); err != nil {
+			return nil, err
+		}
+		if len(data)-offset < 8 {
+			return nil, errInvalidScheduledCall
+		}
+		c.GasLimit = binary.LittleEndian.Uint64(data[offset : offset+8])
+		offset += 8
+		if fe, err = getBytes(); err != nil {
+			return nil, err
+		}
+		c.Function = string(fn)
+		c.Args = string(args)
+		c.Fee = new(big.Int).SetBytes(fe)
+		ret = append(ret, c)
+	}
+	return ret, nil
+}
@@ -0,0 +1,74 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package system
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduledCalls(t *testing.T) {
+	scs, sender, _ := initTest(t)
+	defer deinitTest()
+
+	calls, err := GetScheduledCalls(scs, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, calls, "no calls scheduled")
+
+	first := &ScheduledCall{
+		Contract: sender.ID(),
+		Function: "release",
+		Args:     `[1,"a"]`,
+		GasLimit: 100000,
+		Fee:      big.NewInt(5000),
+	}
+	idx, err := AddScheduledCall(scs, 10, first)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, idx)
+	idx, err = AddScheduledCall(scs, 10, &ScheduledCall{Contract: sender.ID(), Function: "settle", Fee: big.NewInt(0)})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, idx)
+
+	calls, err = GetScheduledCalls(scs, 10)
+	assert.NoError(t, err)
+	assert.Len(t, calls, 2)
+	assert.Equal(t, first, calls[0])
+	assert.Equal(t, "settle", calls[1].Function)
+	assert.Equal(t, "", calls[1].Args)
+	assert.Equal(t, uint64(0), calls[1].GasLimit)
+
+	calls, err = GetScheduledCalls(scs, 11)
+	assert.NoError(t, err)
+	assert.Empty(t, calls, "calls are scheduled at another block")
+
+	for i := 2; i < MaxScheduledCalls; i++ {
+		_, err = AddScheduledCall(scs, 10, first)
+		assert.NoError(t, err)
+	}
+	_, err = AddScheduledCall(scs, 10, first)
+	assert.Error(t, err, "queue is full")
+
+	heavy := &ScheduledCall{Contract: sender.ID(), Function: "settle", GasLimit: MaxScheduledCallGas + 1, Fee: big.NewInt(0)}
+	_, err = AddScheduledCall(scs, 11, heavy)
+	assert.Error(t, err, "exceeded the maximum gas of a call")
+	heavy.GasLimit = MaxScheduledCallGas
+	for i := 0; i < MaxScheduledBlockGas/MaxScheduledCallGas; i++ {
+		_, err = AddScheduledCall(scs, 11, heavy)
+		assert.NoError(t, err)
+	}
+	_, err = AddScheduledCall(scs, 11, &ScheduledCall{Contract: sender.ID(), Function: "settle", GasLimit: 1, Fee: big.NewInt(0)})
+	assert.Error(t, err, "exceeded the maximum gas of a block")
+
+	assert.NoError(t, RemoveScheduledCalls(scs, 10))
+	calls, err = GetScheduledCalls(scs, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, calls)
+
+	assert.NotEqual(t, ScheduledTxHash(10, 0, sender.ID()), ScheduledTxHash(10, 1, sender.ID()))
+	assert.Equal(t, ScheduledTxHash(10, 0, sender.ID()), ScheduledTxHash(10, 0, sender.ID()))
+}
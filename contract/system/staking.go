@@ -7,6 +7,8 @@ package system
 import (
 	"encoding/binary"
 	"errors"
+	"fmt"
+	"math"
 	"math/big"
 
 	"github.com/aergoio/aergo/state"
@@ -18,6 +20,8 @@ var consensusType string
 var (
 	stakingKey      = []byte("staking")
 	stakingTotalKey = []byte("stakingtotal")
+	unbondingKey    = []byte("unbonding")
+	lockedKey       = []byte("locked")
 
 	ErrInvalidCandidate = errors.New("invalid candidate")
 )
@@ -25,6 +29,9 @@ var (
 const StakingDelay = 60 * 60 * 24 //block interval
 //const StakingDelay = 5
 
+// MaxUnbondingEntries is the maximum number of the pending unbonding requests per account.
+const MaxUnbondingEntries = 32
+
 func InitGovernance(consensus string) {
 	consensusType = consensus
 }
@@ -32,6 +39,7 @@ func InitGovernance(consensus string) {
 type stakeCmd struct {
 	*SystemContext
 	amount *big.Int
+	topUp  bool
 }
 
 func newStakeCmd(ctx *SystemContext) (sysCmd, error) {
@@ -40,8 +48,13 @@ func newStakeCmd(ctx *SystemContext) (sysCmd, error) {
 		staked = cmd.Staked
 	)
 
+	// since V3, topping up a staking doesn't lock the staked amount again.
+	// only the topped-up amount is locked by its own deposit.
+	cmd.topUp = cmd.BlockInfo.Version >= 3 && staked.GetAmountBigInt().Sign() != 0
+	if !cmd.topUp {
+		staked.SetWhen(cmd.BlockInfo.No)
+	}
 	staked.Add(cmd.amount)
-	staked.SetWhen(cmd.BlockInfo.No)
 
 	return cmd, nil
 }
@@ -56,7 +69,12 @@ func (c *stakeCmd) run() (*types.Event, error) {
 	if err := c.updateStaking(); err != nil {
 		return nil, err
 	}
-	if err := addTotal(c.scs, amount); err != nil {
+	if c.topUp {
+		if err := lockDeposit(c.scs, sender.ID(), amount, c.BlockInfo.No); err != nil {
+			return nil, err
+		}
+	}
+	if err := addTotal(c.scs, amount, c.BlockInfo.No); err != nil {
 		return nil, err
 	}
 	sender.SubBalance(amount)
@@ -109,7 +127,7 @@ func (c *unstakeCmd) run() (*types.Event, error) {
 	if err := refreshAllVote(c.SystemContext); err != nil {
 		return nil, err
 	}
-	if err := subTotal(scs, balanceAdjustment); err != nil {
+	if err := subTotal(scs, balanceAdjustment, c.BlockInfo.No); err != nil {
 		return nil, err
 	}
 	sender.AddBalance(balanceAdjustment)
@@ -134,6 +152,98 @@ func (c *unstakeCmd) run() (*types.Event, error) {
 	}, nil
 }
 
+type unbondCmd struct {
+	*SystemContext
+	amount *big.Int
+}
+
+func newUnbondCmd(ctx *SystemContext) (sysCmd, error) {
+	return &unbondCmd{
+		SystemContext: ctx,
+		amount:        ctx.txBody.GetAmountBigInt(),
+	}, nil
+}
+
+// run moves the amount from the staking to the unbonding queue. Unlike the
+// unstaking, it doesn't check the staking time. Instead, the amount is locked
+// in the queue until its own release block.
+func (c *unbondCmd) run() (*types.Event, error) {
+	var (
+		scs     = c.scs
+		staked  = c.Staked
+		sender  = c.Sender
+		release = c.BlockInfo.No + StakingDelay
+	)
+
+	staked.Sub(c.amount)
+	if err := c.updateStaking(); err != nil {
+		return nil, err
+	}
+	if err := refreshAllVote(c.SystemContext); err != nil {
+		return nil, err
+	}
+	if err := subTotal(scs, c.amount, c.BlockInfo.No); err != nil {
+		return nil, err
+	}
+	c.Unbonding = append(c.Unbonding, &types.Unbonding{
+		Amount:  c.amount.Bytes(),
+		Release: release,
+	})
+	if err := setUnbonding(scs, sender.ID(), c.Unbonding); err != nil {
+		return nil, err
+	}
+	return &types.Event{
+		ContractAddress: c.Receiver.ID(),
+		EventIdx:        0,
+		EventName:       "unbond",
+		JsonArgs: `["` +
+			types.EncodeAddress(sender.ID()) +
+			`", {"_bignum":"` + c.amount.String() + `"}, ` +
+			fmt.Sprintf("%d", release) + `]`,
+	}, nil
+}
+
+type withdrawCmd struct {
+	*SystemContext
+}
+
+func newWithdrawCmd(ctx *SystemContext) (sysCmd, error) {
+	return &withdrawCmd{
+		SystemContext: ctx,
+	}, nil
+}
+
+// run returns all the released unbonding requests to the sender.
+func (c *withdrawCmd) run() (*types.Event, error) {
+	var (
+		sender   = c.Sender
+		receiver = c.Receiver
+		amount   = new(big.Int)
+		pending  []*types.Unbonding
+	)
+
+	for _, u := range c.Unbonding {
+		if u.GetRelease() > c.BlockInfo.No {
+			pending = append(pending, u)
+			continue
+		}
+		amount.Add(amount, u.GetAmountBigInt())
+	}
+	if err := setUnbonding(c.scs, sender.ID(), pending); err != nil {
+		return nil, err
+	}
+	sender.AddBalance(amount)
+	receiver.SubBalance(amount)
+	return &types.Event{
+		ContractAddress: receiver.ID(),
+		EventIdx:        0,
+		EventName:       "withdraw",
+		JsonArgs: `["` +
+			types.EncodeAddress(sender.ID()) +
+			`", {"_bignum":"` + amount.String() + `"}]`,
+	}, nil
+}
+
 func setStaking(scs *state.ContractState, who []byte, staking *types.Staking) error {
 	key := append(stakingKey, who...)
 	return scs.SetData(key, serializeStaking(staking))
@@ -153,10 +263,98 @@ func getStaking(scs *state.ContractState, who []byte) (*types.Staking, error) {
 }
 
 func GetStaking(scs *state.ContractState, address []byte) (*types.Staking, error) {
-	if address != nil {
-		return getStaking(scs, address)
+	if address == nil {
+		return nil, errors.New("invalid argument: address should not be nil")
+	}
+	staking, err := getStaking(scs, address)
+	if err != nil {
+		return nil, err
+	}
+	staking.Unbonding, err = getUnbonding(scs, address)
+	if err != nil {
+		return nil, err
+	}
+	return staking, nil
+}
+
+func setUnbonding(scs *state.ContractState, who []byte, unbonding []*types.Unbonding) error {
+	key := append(unbondingKey, who...)
+	data, err := serializeUnbonding(unbonding)
+	if err != nil {
+		return err
+	}
+	return scs.SetData(key, data)
+}
+
+func getUnbonding(scs *state.ContractState, who []byte) ([]*types.Unbonding, error) {
+	key := append(unbondingKey, who...)
+	data, err := scs.GetData(key)
+	if err != nil {
+		return nil, err
+	}
+	return deserializeUnbonding(data)
+}
+
+// The locked deposits are kept in the same form as the unbonding queue, with
+// the block where each topped-up amount is released.
+func setLocked(scs *state.ContractState, who []byte, locked []*types.Unbonding) error {
+	key := append(lockedKey, who...)
+	data, err := serializeUnbonding(locked)
+	if err != nil {
+		return err
+	}
+	return scs.SetData(key, data)
+}
+
+// getLocked returns the deposits which are not released at blockNo.
+func getLocked(scs *state.ContractState, who []byte, blockNo uint64) ([]*types.Unbonding, error) {
+	key := append(lockedKey, who...)
+	data, err := scs.GetData(key)
+	if err != nil {
+		return nil, err
+	}
+	locked, err := deserializeUnbonding(data)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*types.Unbonding
+	for _, l := range locked {
+		if l.GetRelease() > blockNo {
+			ret = append(ret, l)
+		}
+	}
+	return ret, nil
+}
+
+// lockDeposit locks the topped-up amount for StakingDelay. If there are too
+// many locked deposits, the amount is merged into the latest one, whose
+// release is put off together.
+func lockDeposit(scs *state.ContractState, who []byte, amount *big.Int, blockNo uint64) error {
+	locked, err := getLocked(scs, who, blockNo)
+	if err != nil {
+		return err
+	}
+	release := blockNo + StakingDelay
+	if len(locked) >= MaxUnbondingEntries {
+		last := locked[len(locked)-1]
+		last.Amount = new(big.Int).Add(last.GetAmountBigInt(), amount).Bytes()
+		last.Release = release
+	} else {
+		locked = append(locked, &types.Unbonding{Amount: amount.Bytes(), Release: release})
+	}
+	return setLocked(scs, who, locked)
+}
+
+func getLockedAmount(scs *state.ContractState, who []byte, blockNo uint64) (*big.Int, error) {
+	locked, err := getLocked(scs, who, blockNo)
+	if err != nil {
+		return nil, err
 	}
-	return nil, errors.New("invalid argument: address should not be nil")
+	amount := new(big.Int)
+	for _, l := range locked {
+		amount.Add(amount, l.GetAmountBigInt())
+	}
+	return amount, nil
 }
 
 func GetStakingTotal(ar AccountStateReader) (*big.Int, error) {
@@ -175,7 +373,10 @@ func getStakingTotal(scs *state.ContractState) (*big.Int, error) {
 	return new(big.Int).SetBytes(data), nil
 }
 
-func addTotal(scs *state.ContractState, amount *big.Int) error {
+func addTotal(scs *state.ContractState, amount *big.Int, blockNo uint64) error {
+	if err := closeProposals(scs, blockNo); err != nil {
+		return err
+	}
 	data, err := scs.GetData(stakingTotalKey)
 	if err != nil {
 		return err
@@ -184,7 +385,10 @@ func addTotal(scs *state.ContractState, amount *big.Int) error {
 	return scs.SetData(stakingTotalKey, new(big.Int).Add(total, amount).Bytes())
 }
 
-func subTotal(scs *state.ContractState, amount *big.Int) error {
+func subTotal(scs *state.ContractState, amount *big.Int, blockNo uint64) error {
+	if err := closeProposals(scs, blockNo); err != nil {
+		return err
+	}
 	data, err := scs.GetData(stakingTotalKey)
 	if err != nil {
 		return err
@@ -209,3 +413,42 @@ func deserializeStaking(data []byte) *types.Staking {
 	amount := data[8:]
 	return &types.Staking{Amount: amount, When: when}
 }
+
+// unbondingHeaderLen is the length of the release block number and the
+// amount length which precede each amount in the serialized unbonding queue.
+const unbondingHeaderLen = 8 + 1
+
+var errInvalidUnbonding = errors.New("invalid unbonding data")
+
+func serializeUnbonding(v []*types.Unbonding) ([]byte, error) {
+	var ret []byte
+	for _, u := range v {
+		if len(u.GetAmount()) > math.MaxUint8 {
+			return nil, fmt.Errorf("too large unbonding amount: %s", u.GetAmountBigInt())
+		}
+		release := make([]byte, 8)
+		binary.LittleEndian.PutUint64(release, u.GetRelease())
+		ret = append(ret, release...)
+		ret = append(ret, byte(len(u.GetAmount())))
+		ret = append(ret, u.GetAmount()...)
+	}
+	return ret, nil
+}
+
+func deserializeUnbonding(data []byte) ([]*types.Unbonding, error) {
+	var ret []*types.Unbonding
+	for offset := 0; offset < len(data); {
+		if len(data)-offset < unbondingHeaderLen {
+			return nil, errInvalidUnbonding
+		}
+		release := binary.LittleEndian.Uint64(data[offset : offset+8])
+		size := int(data[offset+8])
+		offset += unbondingHeaderLen
+		if len(data)-offset < size {
+			return nil, errInvalidUnbonding
+		}
+		ret = append(ret, &types.Unbonding{Amount: data[offset : offset+size], Release: release})
+		offset += size
+	}
+	return ret, nil
+}
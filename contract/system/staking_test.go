@@ -161,3 +161,152 @@ func TestUnstakingError(t *testing.T) {
 	_, err = ExecuteSystemTx(scs, tx.Body, sender, receiver, blockInfo)
 	assert.EqualError(t, types.ErrMustStakeBeforeUnstake, err.Error(), "should be success")
 }
+
+func TestUnbondingWithdraw(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+
+	tx := &types.Tx{
+		Body: &types.TxBody{
+			Account: sender.ID(),
+			Amount:  new(big.Int).Add(types.StakingMinimum, types.StakingMinimum).Bytes(),
+			Payload: []byte(`{"Name":"v1stake"}`),
+		},
+	}
+	sender.AddBalance(types.MaxAER)
+	blockInfo := &types.BlockHeaderInfo{No: uint64(0), Version: 3}
+	stake, err := newSysCmd(sender.ID(), tx.GetBody(), sender, receiver, scs, blockInfo)
+	assert.NoError(t, err, "staking failed")
+	_, err = stake.run()
+	assert.NoError(t, err, "staking failed")
+
+	tx.Body.Payload = []byte(`{"Name":"v1unbond"}`)
+	tx.Body.Amount = types.StakingMinimum.Bytes()
+	_, err = ValidateSystemTx(sender.ID(), tx.GetBody(), sender, scs, &types.BlockHeaderInfo{No: 1, Version: 2})
+	assert.Error(t, err, "unbonding is not supported before v3")
+
+	// unbonding doesn't wait for the staking delay
+	blockInfo.No++
+	unbond, err := newSysCmd(sender.ID(), tx.GetBody(), sender, receiver, scs, blockInfo)
+	assert.NoError(t, err, "unbonding failed")
+	event, err := unbond.run()
+	assert.NoError(t, err, "unbonding failed")
+	assert.Equal(t, "unbond", event.EventName, "event name")
+
+	blockInfo.No += 10
+	unbond, err = newSysCmd(sender.ID(), tx.GetBody(), sender, receiver, scs, blockInfo)
+	assert.NoError(t, err, "unbonding failed")
+	_, err = unbond.run()
+	assert.NoError(t, err, "unbonding failed")
+
+	staking, err := GetStaking(scs, sender.ID())
+	assert.NoError(t, err, "get staking failed")
+	assert.Equal(t, new(big.Int).Bytes(), staking.GetAmount(), "staking amount")
+	assert.Equal(t, 2, len(staking.GetUnbonding()), "unbonding queue")
+	assert.Equal(t, uint64(1+StakingDelay), staking.GetUnbonding()[0].GetRelease(), "release of the 1st request")
+	assert.Equal(t, uint64(11+StakingDelay), staking.GetUnbonding()[1].GetRelease(), "release of the 2nd request")
+	total, err := getStakingTotal(scs)
+	assert.NoError(t, err, "get staking total failed")
+	assert.Equal(t, new(big.Int), total, "total value")
+
+	tx.Body.Payload = []byte(`{"Name":"v1withdraw"}`)
+	tx.Body.Amount = nil
+	_, err = ValidateSystemTx(sender.ID(), tx.GetBody(), sender, scs, blockInfo)
+	assert.Equal(t, types.ErrLessTimeHasPassed, err, "nothing is released yet")
+
+	balance := new(big.Int).Set(sender.Balance())
+	blockInfo.No = 1 + StakingDelay
+	withdraw, err := newSysCmd(sender.ID(), tx.GetBody(), sender, receiver, scs, blockInfo)
+	assert.NoError(t, err, "withdraw failed")
+	event, err = withdraw.run()
+	assert.NoError(t, err, "withdraw failed")
+	assert.Equal(t, "withdraw", event.EventName, "event name")
+	assert.Equal(t, new(big.Int).Add(balance, types.StakingMinimum), sender.Balance(), "partial withdrawal")
+
+	staking, err = GetStaking(scs, sender.ID())
+	assert.NoError(t, err, "get staking failed")
+	assert.Equal(t, 1, len(staking.GetUnbonding()), "unbonding queue")
+
+	blockInfo.No = 11 + StakingDelay
+	withdraw, err = newSysCmd(sender.ID(), tx.GetBody(), sender, receiver, scs, blockInfo)
+	assert.NoError(t, err, "withdraw failed")
+	_, err = withdraw.run()
+	assert.NoError(t, err, "withdraw failed")
+	assert.Equal(t, types.MaxAER, sender.Balance(), "all the staking should be returned")
+
+	_, err = ValidateSystemTx(sender.ID(), tx.GetBody(), sender, scs, blockInfo)
+	assert.Error(t, err, "no unbonding request")
+}
+
+func TestStakingTopUp(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+
+	tx := &types.Tx{
+		Body: &types.TxBody{
+			Account: sender.ID(),
+			Amount:  types.StakingMinimum.Bytes(),
+			Payload: []byte(`{"Name":"v1stake"}`),
+		},
+	}
+	sender.AddBalance(types.MaxAER)
+	blockInfo := &types.BlockHeaderInfo{No: uint64(1), Version: 3}
+	_, err := ExecuteSystemTx(scs, tx.GetBody(), sender, receiver, blockInfo)
+	assert.NoError(t, err, "staking failed")
+
+	blockInfo.No += StakingDelay
+	_, err = ExecuteSystemTx(scs, tx.GetBody(), sender, receiver, blockInfo)
+	assert.NoError(t, err, "top-up failed")
+	staking, err := getStaking(scs, sender.ID())
+	assert.NoError(t, err, "get staking failed")
+	assert.Equal(t, uint64(1), staking.GetWhen(), "top-up keeps the staking time")
+
+	// the topped-up amount can't be unstaked until the deposit is released
+	unstake := &types.Tx{
+		Body: &types.TxBody{
+			Account: sender.ID(),
+			Amount:  new(big.Int).Mul(types.StakingMinimum, big.NewInt(2)).Bytes(),
+			Payload: []byte(`{"Name":"v1unstake"}`),
+		},
+	}
+	blockInfo.No++
+	_, err = ExecuteSystemTx(scs, unstake.GetBody(), sender, receiver, blockInfo)
+	assert.EqualError(t, err, types.ErrLessTimeHasPassed.Error(), "unstake topped-up amount")
+	blockInfo.No += StakingDelay - 1
+	_, err = ExecuteSystemTx(scs, unstake.GetBody(), sender, receiver, blockInfo)
+	assert.NoError(t, err, "unstake after the deposit is released")
+
+	blockInfo.No += StakingDelay
+	blockInfo.Version = 2
+	_, err = ExecuteSystemTx(scs, tx.GetBody(), sender, receiver, blockInfo)
+	assert.NoError(t, err, "staking failed")
+	blockInfo.No += StakingDelay
+	_, err = ExecuteSystemTx(scs, tx.GetBody(), sender, receiver, blockInfo)
+	assert.NoError(t, err, "top-up failed")
+	staking, err = getStaking(scs, sender.ID())
+	assert.NoError(t, err, "get staking failed")
+	assert.Equal(t, blockInfo.No, staking.GetWhen(), "top-up resets the staking time before v3")
+}
+
+func TestUnbondingSerialization(t *testing.T) {
+	unbonding := []*types.Unbonding{
+		{Amount: types.StakingMinimum.Bytes(), Release: 10},
+		{Amount: types.MaxAER.Bytes(), Release: 20},
+	}
+	data, err := serializeUnbonding(unbonding)
+	assert.NoError(t, err, "serialize")
+	ret, err := deserializeUnbonding(data)
+	assert.NoError(t, err, "deserialize")
+	assert.Equal(t, unbonding, ret, "round trip")
+
+	for i := 1; i < len(data); i++ {
+		if i == unbondingHeaderLen+len(unbonding[0].Amount) {
+			continue
+		}
+		_, err = deserializeUnbonding(data[:i])
+		assert.Error(t, err, "truncated data")
+	}
+
+	_, err = serializeUnbonding([]*types.Unbonding{{Amount: make([]byte, 256), Release: 10}})
+	assert.Error(t, err, "too large amount")
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math/big"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/aergoio/aergo/state"
@@ -47,6 +48,25 @@ func ValidateSystemTx(account []byte, txBody *types.TxBody, sender *state.V,
 			return nil, err
 		}
 		context.Staked = staked
+	case types.Opunbond:
+		if blockInfo.Version < 3 {
+			return nil, fmt.Errorf("not supported operation")
+		}
+		staked, unbonding, err := validateForUnbonding(account, txBody, scs, blockNo)
+		if err != nil {
+			return nil, err
+		}
+		context.Staked = staked
+		context.Unbonding = unbonding
+	case types.Opwithdraw:
+		if blockInfo.Version < 3 {
+			return nil, fmt.Errorf("not supported operation")
+		}
+		unbonding, err := validateForWithdraw(account, txBody, scs, blockNo)
+		if err != nil {
+			return nil, err
+		}
+		context.Unbonding = unbonding
 	case types.OpvoteDAO:
 		if blockInfo.Version < 2 {
 			return nil, fmt.Errorf("not supported operation")
@@ -55,7 +75,7 @@ func ValidateSystemTx(account []byte, txBody *types.TxBody, sender *state.V,
 		if err != nil {
 			return nil, err
 		}
-		proposal, err := getProposal(id)
+		proposal, err := loadProposal(scs, id)
 		if proposal == nil {
 			return nil, err
 		}
@@ -74,6 +94,10 @@ func ValidateSystemTx(account []byte, txBody *types.TxBody, sender *state.V,
 			if !ok {
 				return nil, fmt.Errorf("include invalid character")
 			}
+			if proposal.IsGeneral() {
+				// checked against the proposal candidates below
+				continue
+			}
 			candidateNumber, ok := new(big.Int).SetString(candidate, 10)
 			if !ok {
 				return nil, fmt.Errorf("include invalid number")
@@ -104,6 +128,24 @@ func ValidateSystemTx(account []byte, txBody *types.TxBody, sender *state.V,
 		context.Proposal = proposal
 		context.Staked = staked
 		context.Vote = oldvote
+	case types.OpcreateProposal:
+		if blockInfo.Version < 3 {
+			return nil, fmt.Errorf("not supported operation")
+		}
+		proposal, err := validateForCreateProposal(account, &ci, scs, blockNo)
+		if err != nil {
+			return nil, err
+		}
+		context.Proposal = proposal
+	case types.OpexecuteProposal:
+		if blockInfo.Version < 3 {
+			return nil, fmt.Errorf("not supported operation")
+		}
+		proposal, err := validateForExecuteProposal(&ci, scs, blockNo)
+		if err != nil {
+			return nil, err
+		}
+		context.Proposal = proposal
 	default:
 		return nil, types.ErrTxInvalidPayload
 	}
@@ -163,6 +205,14 @@ func validateForUnstaking(account []byte, txBody *types.TxBody, scs *state.Contr
 	if staked.GetWhen()+StakingDelay > blockNo {
 		return nil, types.ErrLessTimeHasPassed
 	}
+	// topped-up amounts are locked by their own deposits
+	locked, err := getLockedAmount(scs, account, blockNo)
+	if err != nil {
+		return nil, err
+	}
+	if new(big.Int).Sub(staked.GetAmountBigInt(), locked).Cmp(txBody.GetAmountBigInt()) < 0 {
+		return nil, types.ErrLessTimeHasPassed
+	}
 	toBe := new(big.Int).Sub(staked.GetAmountBigInt(), txBody.GetAmountBigInt())
 	stakingMin := GetStakingMinimumFromState(scs)
 	if toBe.Cmp(big.NewInt(0)) != 0 && stakingMin.Cmp(toBe) > 0 {
@@ -171,10 +221,154 @@ func validateForUnstaking(account []byte, txBody *types.TxBody, scs *state.Contr
 	return staked, nil
 }
 
+func validateForUnbonding(account []byte, txBody *types.TxBody, scs *state.ContractState, blockNo uint64) (*types.Staking, []*types.Unbonding, error) {
+	staked, err := checkStakingBefore(account, scs)
+	if err != nil {
+		return nil, nil, types.ErrMustStakeBeforeUnstake
+	}
+	amount := txBody.GetAmountBigInt()
+	if amount.Sign() == 0 {
+		return nil, nil, types.ErrTooSmallAmount
+	}
+	if staked.GetAmountBigInt().Cmp(amount) < 0 {
+		return nil, nil, types.ErrExceedAmount
+	}
+	toBe := new(big.Int).Sub(staked.GetAmountBigInt(), amount)
+	stakingMin := GetStakingMinimumFromState(scs)
+	if toBe.Cmp(big.NewInt(0)) != 0 && stakingMin.Cmp(toBe) > 0 {
+		return nil, nil, types.ErrTooSmallAmount
+	}
+	unbonding, err := getUnbonding(scs, account)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(unbonding) >= MaxUnbondingEntries {
+		return nil, nil, fmt.Errorf("too many unbonding requests (max : %d)", MaxUnbondingEntries)
+	}
+	return staked, unbonding, nil
+}
+
+func validateForWithdraw(account []byte, txBody *types.TxBody, scs *state.ContractState, blockNo uint64) ([]*types.Unbonding, error) {
+	if txBody.GetAmountBigInt().Sign() != 0 {
+		return nil, types.ErrTxInvalidAmount
+	}
+	unbonding, err := getUnbonding(scs, account)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range unbonding {
+		if u.GetRelease() <= blockNo {
+			return unbonding, nil
+		}
+	}
+	if len(unbonding) == 0 {
+		return nil, fmt.Errorf("no unbonding request")
+	}
+	return nil, types.ErrLessTimeHasPassed
+}
+
+// validateForCreateProposal returns a proposal built from the arguments:
+// target, value, description, voting period, quorum and timelock. Its ID is
+// assigned at the execution.
+func validateForCreateProposal(account []byte, ci *types.CallInfo, scs *state.ContractState, blockNo uint64) (*Proposal, error) {
+	staked, err := checkStakingBefore(account, scs)
+	if err != nil {
+		return nil, types.ErrMustStakeBeforeVote
+	}
+	if GetStakingMinimumFromState(scs).Cmp(staked.GetAmountBigInt()) > 0 {
+		return nil, types.ErrTooSmallAmount
+	}
+	if len(ci.Args) != 6 {
+		return nil, fmt.Errorf("the number of args should be 6")
+	}
+	var args [6]string
+	for i, v := range ci.Args {
+		arg, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("args[%d] should be string", i)
+		}
+		args[i] = arg
+	}
+	target := strings.ToUpper(args[0])
+	if !isValidParamID(target) {
+		return nil, fmt.Errorf("args[0] invalid target parameter")
+	}
+	// the predefined system parameters are decided only by their own voting
+	if isValidID(target) {
+		return nil, fmt.Errorf("args[0] %s can't be changed by a proposal", target)
+	}
+	value, ok := new(big.Int).SetString(args[1], 10)
+	if !ok || value.Sign() < 0 || types.MaxAER.Cmp(value) < 0 {
+		return nil, fmt.Errorf("args[1] invalid value")
+	}
+	if len(args[2]) > MaxProposalDescription {
+		return nil, fmt.Errorf("too long description (max : %d)", MaxProposalDescription)
+	}
+	period, err := strconv.ParseUint(args[3], 10, 64)
+	if err != nil || period < MinProposalPeriod || period > MaxProposalPeriod {
+		return nil, fmt.Errorf("args[3] invalid voting period (min : %d, max : %d)", MinProposalPeriod, MaxProposalPeriod)
+	}
+	quorum, err := strconv.ParseUint(args[4], 10, 32)
+	if err != nil || quorum < MinProposalQuorum || quorum > 100 {
+		return nil, fmt.Errorf("args[4] invalid quorum percentage (min : %d)", MinProposalQuorum)
+	}
+	timelock, err := strconv.ParseUint(args[5], 10, 64)
+	if err != nil || timelock < MinProposalTimelock || timelock > MaxProposalTimelock {
+		return nil, fmt.Errorf("args[5] invalid timelock (min : %d, max : %d)", MinProposalTimelock, MaxProposalTimelock)
+	}
+	active, err := getActiveProposals(scs, blockNo)
+	if err != nil {
+		return nil, err
+	}
+	if len(active) >= MaxActiveProposals {
+		return nil, fmt.Errorf("too many active proposals (max : %d)", MaxActiveProposals)
+	}
+	return &Proposal{
+		Description:    args[2],
+		Blockfrom:      blockNo,
+		Blockto:        blockNo + period,
+		MultipleChoice: 1,
+		Candidates:     []string{ProposalYes, ProposalNo},
+		Proposer:       types.EncodeAddress(account),
+		Target:         target,
+		Value:          value.String(),
+		Quorum:         uint32(quorum),
+		Timelock:       timelock,
+	}, nil
+}
+
+func validateForExecuteProposal(ci *types.CallInfo, scs *state.ContractState, blockNo uint64) (*Proposal, error) {
+	if len(ci.Args) != 1 {
+		return nil, fmt.Errorf("the number of args should be 1")
+	}
+	id, ok := ci.Args[0].(string)
+	if !ok || !isProposalID(id) {
+		return nil, fmt.Errorf("args[%d] invalid id", 0)
+	}
+	proposal, err := loadProposal(scs, id)
+	if err != nil {
+		return nil, err
+	}
+	if proposal.Executed {
+		return nil, fmt.Errorf("proposal %s was already executed", proposal.ID)
+	}
+	if blockNo < proposal.ExecutableFrom() {
+		return nil, fmt.Errorf("proposal %s can be executed from %d", proposal.ID, proposal.ExecutableFrom())
+	}
+	approved, err := isApproved(scs, proposal)
+	if err != nil {
+		return nil, err
+	}
+	if !approved {
+		return nil, fmt.Errorf("proposal %s is not approved", proposal.ID)
+	}
+	return proposal, nil
+}
+
 func parseIDForProposal(ci *types.CallInfo) (string, error) {
 	//length should be checked before this function
 	id, ok := ci.Args[0].(string)
-	if !ok || len(id) < 1 || !isValidID(id) {
+	if !ok || len(id) < 1 || !(isValidID(id) || isProposalID(id)) {
 		return "", fmt.Errorf("args[%d] invalid id", 0)
 	}
 	return strings.ToUpper(id), nil
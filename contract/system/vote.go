@@ -54,6 +54,21 @@ func GetVotingCatalog() []types.VotingIssue {
 	return votingCatalog
 }
 
+// getVotingIssues returns the voting catalog followed by the proposals
+// created by the stakers, which are active at blockNo.
+func getVotingIssues(scs *state.ContractState, blockNo uint64) ([]types.VotingIssue, error) {
+	proposals, err := getActiveProposals(scs, blockNo)
+	if err != nil {
+		return nil, err
+	}
+	issues := make([]types.VotingIssue, 0, len(votingCatalog)+len(proposals))
+	issues = append(issues, votingCatalog...)
+	for _, p := range proposals {
+		issues = append(issues, proposalIssue(p.ID))
+	}
+	return issues, nil
+}
+
 type vprCmd struct {
 	*SystemContext
 	voteResult *VoteResult
@@ -129,7 +144,11 @@ func newVoteCmd(ctx *SystemContext) (sysCmd, error) {
 		}
 		//for event. voteDAO allow only one candidate. it should be validate before.
 		voteID := ctx.Call.Args[0].(string)
-		cmd.args = []byte(`"` + strings.ToUpper(voteID) + `", {"_bignum":"` + ctx.Call.Args[1].(string) + `"}`)
+		if ctx.Proposal.IsGeneral() {
+			cmd.args = []byte(`"` + strings.ToUpper(voteID) + `", "` + ctx.Call.Args[1].(string) + `"`)
+		} else {
+			cmd.args = []byte(`"` + strings.ToUpper(voteID) + `", {"_bignum":"` + ctx.Call.Args[1].(string) + `"}`)
+		}
 	} else {
 		cmd.issue = []byte(ctx.op.ID())
 		cmd.args, err = json.Marshal(ctx.Call.Args)
@@ -238,7 +257,11 @@ func refreshAllVote(context *SystemContext) error {
 		stakedAmount = new(big.Int).SetBytes(staked.Amount)
 	)
 
-	for _, i := range GetVotingCatalog() {
+	issues, err := getVotingIssues(scs, context.BlockInfo.No)
+	if err != nil {
+		return err
+	}
+	for _, i := range issues {
 		key := i.Key()
 
 		oldvote, err := getVote(scs, key, account)
@@ -250,7 +273,7 @@ func refreshAllVote(context *SystemContext) error {
 			continue
 		}
 		if types.OpvoteBP.ID() != i.ID() {
-			proposal, err := getProposal(i.ID())
+			proposal, err := loadProposal(scs, i.ID())
 			if err != nil {
 				return err
 			}
@@ -112,7 +112,9 @@ func (vr *VoteResult) Sync() error {
 	votingPowerRank.apply(vr.scs)
 	resultList := vr.buildVoteList()
 	if vr.ex {
-		if vr.threshold(resultList.Votes[0].GetAmountBigInt()) {
+		// The proposals created by the stakers are applied by an explicit
+		// execution after their timelock.
+		if !isProposalID(string(vr.key)) && vr.threshold(resultList.Votes[0].GetAmountBigInt()) {
 			value, ok := new(big.Int).SetString(string(resultList.Votes[0].GetCandidate()), 10)
 			if !ok {
 				return fmt.Errorf("abnormal winner is in vote %s", string(vr.key))
@@ -7,6 +7,8 @@
 #include "db_module.h"
 #include "state_module.h"
 #include "crypto_module.h"
+#include "abi_module.h"
+#include "decimal_module.h"
 #include "util.h"
 #include "lgmp.h"
 #include "_cgo_export.h"
@@ -31,7 +33,9 @@ static void preloadModules(lua_State *L)
 	luaopen_state(L);
 	luaopen_json(L);
 	luaopen_crypto(L);
+	luaopen_abi_types(L);
 	luaopen_gmp(L);
+	luaopen_decimal(L);
     luaopen_utf8(L);
 
 	if (!isPublic()) {
@@ -230,7 +234,25 @@ void vm_set_timeout_count_hook(lua_State *L, int limit)
     lua_sethook(L, timeout_count_hook, LUA_MASKCOUNT, VM_TIMEOUT_INST_COUNT);
 }
 
-const char *vm_pcall(lua_State *L, int argc, int *nresult)
+/* replace a non-string error object with a string; a table is a revert reason */
+static void error_object_to_string(lua_State *L, int *revert)
+{
+	char *json;
+
+	if (lua_istable(L, -1)) {
+		json = lua_util_get_json(L, -1, false);
+		if (json != NULL) {
+			lua_pop(L, 1);
+			lua_pushstring(L, json);
+			free(json);
+			*revert = 1;
+			return;
+		}
+	}
+	lua_pushfstring(L, "(error object is a %s value)", luaL_typename(L, -1));
+}
+
+const char *vm_pcall(lua_State *L, int argc, int *nresult, int *revert)
 {
 	int err;
 	int nr = lua_gettop(L) - argc - 1;
@@ -251,6 +273,9 @@ const char *vm_pcall(lua_State *L, int argc, int *nresult)
 
 	if (err != 0) {
         lua_cpcall(L, lua_db_release_resource, NULL);
+        if (!lua_isstring(L, -1) && vm_is_hardfork(L, 3)) {
+            error_object_to_string(L, revert);
+        }
 		return lua_tostring(L, -1);
 	}
     err = lua_cpcall(L, lua_db_release_resource, NULL);
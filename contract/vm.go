@@ -34,10 +34,10 @@ import (
 	"time"
 	"unsafe"
 
-	"github.com/aergoio/aergo-lib/log"
 	luacUtil "github.com/aergoio/aergo/cmd/aergoluac/util"
 	"github.com/aergoio/aergo/fee"
 	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/internal/loglevel"
 	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
 	jsoniter "github.com/json-iterator/go"
@@ -47,14 +47,16 @@ const (
 	callMaxInstLimit     = C.int(5000000)
 	queryMaxInstLimit    = callMaxInstLimit * C.int(10)
 	dbUpdateMaxLimit     = fee.StateDbMaxUpdateSize
+	sqlGasMaxLimit       = uint64(1000000000)
 	MaxCallDepth         = 5
 	checkFeeDelegationFn = "check_delegation"
 	constructor          = "constructor"
+	migrateFn            = "migrate"
 )
 
 var (
 	maxContext     int
-	ctrLgr         *log.Logger
+	ctrLgr         *loglevel.Logger
 	contexts       []*vmContext
 	lastQueryIndex int
 	querySync      sync.Mutex
@@ -70,6 +72,8 @@ type callState struct {
 	prevState *types.State
 	curState  *types.State
 	tx        sqlTx
+	isDeploy  bool // the contract is deployed by the current tx
+	isUpgrade bool // the code of an upgradeable contract is replaced
 }
 
 type contractInfo struct {
@@ -96,6 +100,7 @@ type vmContext struct {
 	callState         map[types.AccountID]*callState
 	lastRecoveryEntry *recoveryEntry
 	dbUpdateTotalSize int64
+	sqlGas            uint64
 	seed              *rand.Rand
 	events            []*types.Event
 	eventCount        int32
@@ -113,6 +118,7 @@ type recoveryEntry struct {
 	callState     *callState
 	onlySend      bool
 	isDeploy      bool
+	prevCode      []byte // the code replaced by an upgrade
 	sqlSaveName   *string
 	stateRevision state.Snapshot
 	prev          *recoveryEntry
@@ -134,7 +140,7 @@ type executor struct {
 }
 
 func init() {
-	ctrLgr = log.NewLogger("contract")
+	ctrLgr = loglevel.NewLogger("contract")
 	lastQueryIndex = ChainService
 }
 
@@ -354,7 +360,11 @@ func newExecutor(
 	}
 
 	if isCreate {
-		f, err := resolveFunction(ctrState, ctx.bs, constructor, isCreate)
+		fname := constructor
+		if ctx.curContract.callState.isUpgrade {
+			fname = migrateFn
+		}
+		f, err := resolveFunction(ctrState, ctx.bs, fname, isCreate)
 		if err != nil {
 			ce.preErr = err
 			ctrLgr.Debug().Err(ce.err).Str("contract", types.EncodeAddress(contractId)).Msg("not found function")
@@ -362,7 +372,7 @@ func newExecutor(
 		}
 		if f == nil {
 			f = &types.Function{
-				Name:    constructor,
+				Name:    fname,
 				Payable: false,
 			}
 		}
@@ -373,7 +383,7 @@ func newExecutor(
 			return ce
 		}
 		ce.isView = f.View
-		ce.fname = constructor
+		ce.fname = fname
 		ce.isAutoload = true
 		ce.numArgs = C.int(len(ci.Args))
 	} else if isDelegation {
@@ -542,7 +552,7 @@ func (ce *executor) call(instLimit C.int, target *LState) C.int {
 	}
 	if ce.isAutoload {
 		if loaded := vmAutoload(ce.L, ce.fname); !loaded {
-			if ce.fname != constructor {
+			if ce.fname != constructor && ce.fname != migrateFn {
 				ce.err = errors.New(fmt.Sprintf("contract autoload failed %s : %s",
 					types.EncodeAddress(ce.ctx.curContract.contractId), ce.fname))
 			}
@@ -562,10 +572,13 @@ func (ce *executor) call(instLimit C.int, target *LState) C.int {
 	}
 	ce.setCountHook(instLimit)
 	nret := C.int(0)
-	if cErrMsg := C.vm_pcall(ce.L, ce.numArgs, &nret); cErrMsg != nil {
+	revert := C.int(0)
+	if cErrMsg := C.vm_pcall(ce.L, ce.numArgs, &nret, &revert); cErrMsg != nil {
 		errMsg := C.GoString(cErrMsg)
 		if C.luaL_hassyserror(ce.L) != C.int(0) {
 			ce.err = newVmSystemError(errors.New(errMsg))
+		} else if revert != C.int(0) {
+			ce.err = newRevertError(errMsg)
 		} else {
 			if C.luaL_hasuncatchablerror(ce.L) != C.int(0) &&
 				C.ERR_BF_TIMEOUT == errMsg {
@@ -984,9 +997,14 @@ func Create(
 	if err != nil {
 		return "", nil, ctx.usedFee(), err
 	}
-	err = contractState.SetData(creatorMetaKey, []byte(types.EncodeAddress(ctx.curContract.sender)))
-	if err != nil {
-		return "", nil, ctx.usedFee(), err
+	cs := ctx.curContract.callState
+	cs.isDeploy = true
+	// the creator of an upgradeable contract is kept
+	if !cs.isUpgrade {
+		err = contractState.SetData(creatorMetaKey, []byte(types.EncodeAddress(ctx.curContract.sender)))
+		if err != nil {
+			return "", nil, ctx.usedFee(), err
+		}
 	}
 	var ci types.CallInfo
 	if len(args) > 0 {
@@ -1040,6 +1058,10 @@ func Create(
 		ctrLgr.Error().Err(err).Msg("commit state")
 		return "", ce.getEvents(), ctx.usedFee(), err
 	}
+	err = addCodeHistory(ctx, contractState, ctx.curContract.sender)
+	if err != nil {
+		return "", ce.getEvents(), ctx.usedFee(), err
+	}
 	if ctx.traceFile != nil {
 		_, _ = ctx.traceFile.WriteString(fmt.Sprintf("[ret] : %s\n", ce.jsonRet))
 		_, _ = ctx.traceFile.WriteString(fmt.Sprintf("[usedFee] : %s\n", ctx.usedFee().String()))
@@ -1248,6 +1270,38 @@ func GetABI(contractState *state.ContractState, bs *state.BlockState) (*types.AB
 	return abi, nil
 }
 
+// MaxSourceSize is the largest source accepted by VerifySource.
+const MaxSourceSize = 1024 * 1024
+
+// VerifySource compiles the source and checks that it produces the bytecode
+// deployed to the contract. The source comes from anyone, so it is only
+// loaded and dumped, never run; the ABI is not compared since generating
+// it would run the chunk.
+func VerifySource(contractState *state.ContractState, source string) (*types.ContractSource, error) {
+	if len(source) > MaxSourceSize {
+		return nil, errors.New("source is too large")
+	}
+	code, err := contractState.GetCode()
+	if err != nil {
+		return nil, err
+	}
+	if len(code) == 0 {
+		return nil, errors.New("cannot find contract")
+	}
+	byteCode, err := luacUtil.ByteCode(source)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(byteCode, luacUtil.LuaCode(code).ByteCode()) {
+		return nil, types.ErrSourceNotMatch
+	}
+	return &types.ContractSource{
+		Source:          source,
+		CompilerVersion: luacUtil.CompilerVersion(),
+		CodeHash:        contractState.State.GetCodeHash(),
+	}, nil
+}
+
 func (re *recoveryEntry) recovery(bs *state.BlockState) error {
 	var zero big.Int
 	cs := re.callState
@@ -1281,6 +1335,13 @@ func (re *recoveryEntry) recovery(bs *state.BlockState) error {
 			}
 			bs.RemoveCache(cs.ctrState.GetAccountID())
 		}
+		if re.prevCode != nil {
+			err := cs.ctrState.SetCode(re.prevCode)
+			if err != nil {
+				return newDbSystemError(err)
+			}
+			bs.RemoveCache(cs.ctrState.GetAccountID())
+		}
 	}
 	if cs.tx != nil {
 		if re.sqlSaveName == nil {
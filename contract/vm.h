@@ -16,7 +16,7 @@ void vm_closestates(lua_State* s[], int count);
 int vm_autoload(lua_State *L, char *func_name);
 void vm_remove_constructor(lua_State *L);
 const char *vm_loadbuff(lua_State *L, const char *code, size_t sz, char *hex_id, int service);
-const char *vm_pcall(lua_State *L, int argc, int* nresult);
+const char *vm_pcall(lua_State *L, int argc, int* nresult, int *revert);
 const char *vm_get_json_ret(lua_State *L, int nresult, int *err);
 const char *vm_copy_result(lua_State *L, lua_State *target, int cnt);
 sqlite3 *vm_get_db(lua_State *L);
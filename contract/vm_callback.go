@@ -22,11 +22,17 @@ struct rlp_obj {
 	void *data;
 	size_t size;
 };
+
+struct bytes_arg {
+	void *data;
+	size_t len;
+};
 */
 import "C"
 import (
 	"bytes"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"index/suffixarray"
@@ -38,20 +44,27 @@ import (
 
 	"github.com/aergoio/aergo/cmd/aergoluac/util"
 
+	"github.com/aergoio/aergo/internal/bls12381"
 	"github.com/aergoio/aergo/internal/common"
 
 	"github.com/aergoio/aergo/contract/name"
 	"github.com/aergoio/aergo/contract/system"
+	"github.com/aergoio/aergo/fee"
 	"github.com/aergoio/aergo/internal/enc"
 	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/minio/sha256-simd"
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/ed25519"
+	"golang.org/x/crypto/ripemd160"
 )
 
 var (
 	mulAergo, mulGaer, zeroBig *big.Int
 	creatorMetaKey             = []byte("Creator")
+	upgradeOwnerMetaKey        = []byte("UpgradeOwner")
+	codeHistoryMetaKey         = []byte("CodeHistory")
 )
 
 const (
@@ -78,6 +91,37 @@ func addUpdateSize(s *vmContext, updateSize int64) error {
 	return nil
 }
 
+// addSqlGas charges the gas of the sql statements. If the gas system isn't
+// used, as in queries, the statements are only limited by sqlGasMaxLimit.
+func addSqlGas(L *LState, s *vmContext, gas uint64) error {
+	if vmIsGasSystem(s) {
+		remained := uint64(C.lua_gasget(L))
+		if remained < gas {
+			C.lua_gasset(L, 0)
+			return errors.New("not enough gas")
+		}
+		C.lua_gasset(L, C.ulonglong(remained-gas))
+		return nil
+	}
+	if s.sqlGas+gas > sqlGasMaxLimit {
+		return errors.New("exceeded gas of sql statements")
+	}
+	s.sqlGas += gas
+	return nil
+}
+
+//export luaChargeSqlGas
+func luaChargeSqlGas(L *LState, service C.int, gas C.ulonglong) C.int {
+	ctx := contexts[service]
+	if ctx == nil {
+		return 1
+	}
+	if err := addSqlGas(L, ctx, uint64(gas)); err != nil {
+		return 1
+	}
+	return 0
+}
+
 //export luaSetDB
 func luaSetDB(L *LState, service C.int, key unsafe.Pointer, keyLen C.int, value *C.char) *C.char {
 	ctx := contexts[service]
@@ -243,33 +287,33 @@ func minusCallCount(ctx *vmContext, curCount, deduc C.int) C.int {
 
 //export luaCallContract
 func luaCallContract(L *LState, service C.int, contractId *C.char, fname *C.char, args *C.char,
-	amount *C.char, gas uint64) (C.int, *C.char) {
+	amount *C.char, gas uint64) (C.int, *C.char, *C.char) {
 	fnameStr := C.GoString(fname)
 	argsStr := C.GoString(args)
 
 	ctx := contexts[service]
 	if ctx == nil {
-		return -1, C.CString("[Contract.LuaCallContract] contract state not found")
+		return -1, C.CString("[Contract.LuaCallContract] contract state not found"), nil
 	}
 	contractAddress := C.GoString(contractId)
-	cid, err := getAddressNameResolved(contractAddress, ctx.bs)
+	cid, err := getAddressNameResolved(contractAddress, ctx)
 	if err != nil {
-		return -1, C.CString("[Contract.LuaCallContract] invalid contractId: " + err.Error())
+		return -1, C.CString("[Contract.LuaCallContract] invalid contractId: " + err.Error()), nil
 	}
 	aid := types.ToAccountID(cid)
 	amountBig, err := transformAmount(C.GoString(amount))
 	if err != nil {
-		return -1, C.CString("[Contract.LuaCallContract] invalid amount: " + err.Error())
+		return -1, C.CString("[Contract.LuaCallContract] invalid amount: " + err.Error()), nil
 	}
 
 	cs, err := getCtrState(ctx, aid)
 	if err != nil {
-		return -1, C.CString("[Contract.LuaCallContract] getAccount error: " + err.Error())
+		return -1, C.CString("[Contract.LuaCallContract] getAccount error: " + err.Error()), nil
 	}
 
 	callee := getContract(cs.ctrState, ctx.bs)
 	if callee == nil {
-		return -1, C.CString("[Contract.LuaCallContract] cannot find contract " + C.GoString(contractId))
+		return -1, C.CString("[Contract.LuaCallContract] cannot find contract " + C.GoString(contractId)), nil
 	}
 
 	prevContractInfo := ctx.curContract
@@ -278,7 +322,7 @@ func luaCallContract(L *LState, service C.int, contractId *C.char, fname *C.char
 	ci.Name = fnameStr
 	err = getCallInfo(&ci.Args, []byte(argsStr), cid)
 	if err != nil {
-		return -1, C.CString("[Contract.LuaCallContract] invalid arguments: " + err.Error())
+		return -1, C.CString("[Contract.LuaCallContract] invalid arguments: " + err.Error()), nil
 	}
 
 	refreshGas(ctx, L)
@@ -289,16 +333,16 @@ func luaCallContract(L *LState, service C.int, contractId *C.char, fname *C.char
 	}()
 
 	if ce.err != nil {
-		return -1, C.CString("[Contract.LuaCallContract] newExecutor error: " + ce.err.Error())
+		return -1, C.CString("[Contract.LuaCallContract] newExecutor error: " + ce.err.Error()), nil
 	}
 
 	senderState := prevContractInfo.callState.curState
 	if amountBig.Cmp(zeroBig) > 0 {
 		if ctx.isQuery == true || ctx.nestedView > 0 {
-			return -1, C.CString("[Contract.LuaCallContract] send not permitted in query")
+			return -1, C.CString("[Contract.LuaCallContract] send not permitted in query"), nil
 		}
 		if r := sendBalance(L, senderState, cs.curState, amountBig); r != nil {
-			return -1, r
+			return -1, r, nil
 		}
 	}
 	seq, err := setRecoveryPoint(aid, ctx, senderState, cs, amountBig, false, false)
@@ -311,7 +355,7 @@ func luaCallContract(L *LState, service C.int, contractId *C.char, fname *C.char
 			senderState.GetBalanceBigInt().String(), cs.curState.GetBalanceBigInt().String()))
 	}
 	if err != nil {
-		return -1, C.CString("[System.LuaCallContract] database error: " + err.Error())
+		return -1, C.CString("[System.LuaCallContract] database error: " + err.Error()), nil
 	}
 	ctx.curContract = newContractInfo(cs, prevContractInfo.contractId, cid,
 		cs.curState.SqlRecoveryPoint, amountBig)
@@ -324,20 +368,23 @@ func luaCallContract(L *LState, service C.int, contractId *C.char, fname *C.char
 	if ce.err != nil {
 		err := clearRecovery(L, ctx, seq, true)
 		if err != nil {
-			return -1, C.CString("[Contract.LuaCallContract] recovery err: " + err.Error())
+			return -1, C.CString("[Contract.LuaCallContract] recovery err: " + err.Error()), nil
 		}
 		if ctx.traceFile != nil {
 			_, _ = ctx.traceFile.WriteString(fmt.Sprintf("recovery snapshot: %d\n", seq))
 		}
-		return -1, C.CString("[Contract.LuaCallContract] call err: " + ce.err.Error())
+		if rErr, ok := ce.err.(*RevertError); ok {
+			return -1, nil, C.CString(rErr.payload)
+		}
+		return -1, C.CString("[Contract.LuaCallContract] call err: " + ce.err.Error()), nil
 	}
 	if seq == 1 {
 		err := clearRecovery(L, ctx, seq, false)
 		if err != nil {
-			return -1, C.CString("[Contract.LuaCallContract] recovery err: " + err.Error())
+			return -1, C.CString("[Contract.LuaCallContract] recovery err: " + err.Error()), nil
 		}
 	}
-	return ret, nil
+	return ret, nil, nil
 }
 
 func getOnlyContractState(ctx *vmContext, aid types.AccountID) (*state.ContractState, error) {
@@ -350,34 +397,34 @@ func getOnlyContractState(ctx *vmContext, aid types.AccountID) (*state.ContractS
 
 //export luaDelegateCallContract
 func luaDelegateCallContract(L *LState, service C.int, contractId *C.char,
-	fname *C.char, args *C.char, gas uint64) (C.int, *C.char) {
+	fname *C.char, args *C.char, gas uint64) (C.int, *C.char, *C.char) {
 	contractIdStr := C.GoString(contractId)
 	fnameStr := C.GoString(fname)
 	argsStr := C.GoString(args)
 
 	ctx := contexts[service]
 	if ctx == nil {
-		return -1, C.CString("[Contract.LuaDelegateCallContract] contract state not found")
+		return -1, C.CString("[Contract.LuaDelegateCallContract] contract state not found"), nil
 	}
-	cid, err := getAddressNameResolved(contractIdStr, ctx.bs)
+	cid, err := getAddressNameResolved(contractIdStr, ctx)
 	if err != nil {
-		return -1, C.CString("[Contract.LuaDelegateCallContract] invalid contractId: " + err.Error())
+		return -1, C.CString("[Contract.LuaDelegateCallContract] invalid contractId: " + err.Error()), nil
 	}
 	aid := types.ToAccountID(cid)
 	contractState, err := getOnlyContractState(ctx, aid)
 	if err != nil {
-		return -1, C.CString("[Contract.LuaDelegateCallContract]getContractState error" + err.Error())
+		return -1, C.CString("[Contract.LuaDelegateCallContract]getContractState error" + err.Error()), nil
 	}
 	contract := getContract(contractState, ctx.bs)
 	if contract == nil {
-		return -1, C.CString("[Contract.LuaDelegateCallContract] cannot find contract " + contractIdStr)
+		return -1, C.CString("[Contract.LuaDelegateCallContract] cannot find contract " + contractIdStr), nil
 	}
 
 	var ci types.CallInfo
 	ci.Name = fnameStr
 	err = getCallInfo(&ci.Args, []byte(argsStr), cid)
 	if err != nil {
-		return -1, C.CString("[Contract.LuaDelegateCallContract] invalid arguments: " + err.Error())
+		return -1, C.CString("[Contract.LuaDelegateCallContract] invalid arguments: " + err.Error()), nil
 	}
 
 	refreshGas(ctx, L)
@@ -388,12 +435,12 @@ func luaDelegateCallContract(L *LState, service C.int, contractId *C.char,
 	}()
 
 	if ce.err != nil {
-		return -1, C.CString("[Contract.LuaDelegateCallContract] newExecutor error: " + ce.err.Error())
+		return -1, C.CString("[Contract.LuaDelegateCallContract] newExecutor error: " + ce.err.Error()), nil
 	}
 
 	seq, err := setRecoveryPoint(aid, ctx, nil, ctx.curContract.callState, zeroBig, false, false)
 	if err != nil {
-		return -1, C.CString("[System.LuaDelegateCallContract] database error: " + err.Error())
+		return -1, C.CString("[System.LuaDelegateCallContract] database error: " + err.Error()), nil
 	}
 	if ctx.traceFile != nil {
 		_, _ = ctx.traceFile.WriteString(fmt.Sprintf("[DELEGATECALL Contract %v %v]\n", contractIdStr, fnameStr))
@@ -405,28 +452,31 @@ func luaDelegateCallContract(L *LState, service C.int, contractId *C.char,
 	if ce.err != nil {
 		err := clearRecovery(L, ctx, seq, true)
 		if err != nil {
-			return -1, C.CString("[Contract.LuaDelegateCallContract] recovery error: " + err.Error())
+			return -1, C.CString("[Contract.LuaDelegateCallContract] recovery error: " + err.Error()), nil
 		}
 		if ctx.traceFile != nil {
 			_, _ = ctx.traceFile.WriteString(fmt.Sprintf("recovery snapshot: %d\n", seq))
 		}
-		return -1, C.CString("[Contract.LuaDelegateCallContract] call error: " + ce.err.Error())
+		if rErr, ok := ce.err.(*RevertError); ok {
+			return -1, nil, C.CString(rErr.payload)
+		}
+		return -1, C.CString("[Contract.LuaDelegateCallContract] call error: " + ce.err.Error()), nil
 	}
 	if seq == 1 {
 		err := clearRecovery(L, ctx, seq, false)
 		if err != nil {
-			return -1, C.CString("[Contract.LuaDelegateCallContract] recovery error: " + err.Error())
+			return -1, C.CString("[Contract.LuaDelegateCallContract] recovery error: " + err.Error()), nil
 		}
 	}
-	return ret, nil
+	return ret, nil, nil
 }
 
-func getAddressNameResolved(account string, bs *state.BlockState) ([]byte, error) {
+func getAddressNameResolved(account string, ctx *vmContext) ([]byte, error) {
 	accountLen := len(account)
 	if accountLen == types.EncodedAddressLength {
 		return types.DecodeAddress(account)
-	} else if accountLen == types.NameLength {
-		cid, err := name.Resolve(bs, []byte(account), false)
+	} else if accountLen == types.NameLength || types.IsSubName([]byte(account)) {
+		cid, err := name.Resolve(ctx.bs, []byte(account), false, ctx.blockInfo.No)
 		if err != nil {
 			return nil, err
 		}
@@ -451,7 +501,7 @@ func luaSendAmount(L *LState, service C.int, contractId *C.char, amount *C.char)
 	if (ctx.isQuery == true || ctx.nestedView > 0) && amountBig.Cmp(zeroBig) > 0 {
 		return C.CString("[Contract.LuaSendAmount] send not permitted in query")
 	}
-	cid, err := getAddressNameResolved(C.GoString(contractId), ctx.bs)
+	cid, err := getAddressNameResolved(C.GoString(contractId), ctx)
 	if err != nil {
 		return C.CString("[Contract.LuaSendAmount] invalid contractId: " + err.Error())
 	}
@@ -589,6 +639,7 @@ func setRecoveryPoint(aid types.AccountID, ctx *vmContext, senderState *types.St
 		isSend,
 		isDeploy,
 		nil,
+		nil,
 		-1,
 		prev,
 	}
@@ -673,7 +724,7 @@ func luaGetBalance(L *LState, service C.int, contractId *C.char) (*C.char, *C.ch
 	if contractId == nil {
 		return C.CString(ctx.curContract.callState.ctrState.GetBalanceBigInt().String()), nil
 	}
-	cid, err := getAddressNameResolved(C.GoString(contractId), ctx.bs)
+	cid, err := getAddressNameResolved(C.GoString(contractId), ctx)
 	if err != nil {
 		return nil, C.CString("[Contract.LuaGetBalance] invalid contractId: " + err.Error())
 	}
@@ -937,13 +988,139 @@ func luaCryptoVerifyProof(
 //export luaCryptoKeccak256
 func luaCryptoKeccak256(data unsafe.Pointer, dataLen C.int) (unsafe.Pointer, int) {
 	d, isHex := luaCryptoToBytes(data, dataLen)
-	h := keccak256(d)
+	return luaCryptoHashResult(keccak256(d), isHex)
+}
+
+func luaCryptoHashResult(h []byte, isHex bool) (unsafe.Pointer, int) {
 	if isHex {
 		hexb := []byte("0x" + hex.EncodeToString(h))
 		return C.CBytes(hexb), len(hexb)
+	}
+	return C.CBytes(h), len(h)
+}
+
+//export luaCryptoRipemd160
+func luaCryptoRipemd160(data unsafe.Pointer, dataLen C.int) (unsafe.Pointer, int) {
+	d, isHex := luaCryptoToBytes(data, dataLen)
+	h := ripemd160.New()
+	h.Write(d)
+	return luaCryptoHashResult(h.Sum(nil), isHex)
+}
+
+//export luaCryptoBlake2b
+func luaCryptoBlake2b(data unsafe.Pointer, dataLen C.int) (unsafe.Pointer, int) {
+	d, isHex := luaCryptoToBytes(data, dataLen)
+	h := blake2b.Sum256(d)
+	return luaCryptoHashResult(h[:], isHex)
+}
+
+//export luaCryptoEcRecover
+func luaCryptoEcRecover(L *LState, service C.int, msg *C.char, sig *C.char) (*C.char, *C.char) {
+	bMsg, err := decodeHex(C.GoString(msg))
+	if err != nil {
+		return nil, C.CString("[Contract.LuaEcRecover] invalid message format: " + err.Error())
+	}
+	bSig, err := decodeHex(C.GoString(sig))
+	if err != nil {
+		return nil, C.CString("[Contract.LuaEcRecover] invalid signature format: " + err.Error())
+	}
+	ctx := contexts[service]
+	if ctx == nil {
+		return nil, C.CString("[Contract.LuaEcRecover] not found contract state")
+	}
+	setInstMinusCount(ctx, L, 10000)
+
+	// r || s || v as in ethereum, v is 0, 1, 27 or 28
+	if len(bSig) != 65 {
+		return nil, C.CString("[Contract.LuaEcRecover] invalid signature length")
+	}
+	v := bSig[64]
+	if v >= 27 {
+		v -= 27
+	}
+	if v > 1 {
+		return nil, C.CString("[Contract.LuaEcRecover] invalid recovery id")
+	}
+	btcsig := make([]byte, 65)
+	btcsig[0] = v + 27
+	copy(btcsig[1:], bSig[:64])
+	pub, _, err := btcec.RecoverCompact(btcec.S256(), btcsig, bMsg)
+	if err != nil {
+		return nil, C.CString("[Contract.LuaEcRecover] error recoverCompact: " + err.Error())
+	}
+	return C.CString("0x" + hex.EncodeToString(pub.SerializeUncompressed())), nil
+}
+
+//export luaCryptoEd25519Verify
+func luaCryptoEd25519Verify(L *LState, service C.int, msg unsafe.Pointer, msgLen C.int, sig *C.char, pubKey *C.char) (C.int, *C.char) {
+	bMsg, _ := luaCryptoToBytes(msg, msgLen)
+	bSig, err := decodeHex(C.GoString(sig))
+	if err != nil {
+		return -1, C.CString("[Contract.LuaEd25519Verify] invalid signature format: " + err.Error())
+	}
+	bPub, err := decodeHex(C.GoString(pubKey))
+	if err != nil {
+		return -1, C.CString("[Contract.LuaEd25519Verify] invalid public key format: " + err.Error())
+	}
+	ctx := contexts[service]
+	if ctx == nil {
+		return -1, C.CString("[Contract.LuaEd25519Verify] not found contract state")
+	}
+	setInstMinusCount(ctx, L, 10000)
+
+	if len(bPub) != ed25519.PublicKeySize {
+		return -1, C.CString("[Contract.LuaEd25519Verify] invalid public key length")
+	}
+	if len(bSig) != ed25519.SignatureSize {
+		return -1, C.CString("[Contract.LuaEd25519Verify] invalid signature length")
+	}
+	if ed25519.Verify(ed25519.PublicKey(bPub), bMsg, bSig) {
+		return C.int(1), nil
+	}
+	return C.int(0), nil
+}
+
+func luaCryptoBytesArgs(args unsafe.Pointer, n C.int) [][]byte {
+	cArgs := (*[1 << 30]C.struct_bytes_arg)(args)[:n:n]
+	b := make([][]byte, int(n))
+	for i, a := range cArgs {
+		b[i], _ = luaCryptoToBytes(a.data, C.int(a.len))
+	}
+	return b
+}
+
+//export luaCryptoBlsAggregateVerify
+func luaCryptoBlsAggregateVerify(
+	L *LState, service C.int,
+	sig unsafe.Pointer, sigLen C.int,
+	pubKeys unsafe.Pointer, nPubKey C.int,
+	msgs unsafe.Pointer, nMsg C.int,
+	sameMsg C.int,
+) (C.int, *C.char) {
+	ctx := contexts[service]
+	if ctx == nil {
+		return -1, C.CString("[Contract.LuaBlsAggregateVerify] not found contract state")
+	}
+	bSig, _ := luaCryptoToBytes(sig, sigLen)
+	bPubKeys := luaCryptoBytesArgs(pubKeys, nPubKey)
+	bMsgs := luaCryptoBytesArgs(msgs, nMsg)
+
+	var ok bool
+	var err error
+	if sameMsg != 0 {
+		setInstMinusCount(ctx, L, 100000+100*nPubKey)
+		ok, err = bls12381.FastAggregateVerify(bPubKeys, bMsgs[0], bSig)
 	} else {
-		return C.CBytes(h), len(h)
+		setInstMinusCount(ctx, L, 100000+50000*nPubKey)
+		ok, err = bls12381.AggregateVerify(bPubKeys, bMsgs, bSig)
 	}
+	if err != nil {
+		return -1, C.CString("[Contract.LuaBlsAggregateVerify] " + err.Error())
+	}
+	if ok {
+		return C.int(1), nil
+	}
+	return C.int(0), nil
 }
 
 func transformAmount(amountStr string) (*big.Int, error) {
@@ -1015,6 +1192,15 @@ func transformAmount(amountStr string) (*big.Int, error) {
 	return ret, nil
 }
 
+//export luaDecimalOp
+func luaDecimalOp(op C.int, a, b *C.char, scale C.int, mode *C.char) (*C.char, C.lua_Integer, *C.char) {
+	ret, gas, err := decimalOp(int(op), C.GoString(a), C.GoString(b), int(scale), C.GoString(mode))
+	if err != nil {
+		return nil, C.lua_Integer(gas), C.CString(err.Error())
+	}
+	return C.CString(ret), C.lua_Integer(gas), nil
+}
+
 //export luaDeployContract
 func luaDeployContract(
 	L *LState,
@@ -1039,7 +1225,7 @@ func luaDeployContract(
 	// get code
 	var code []byte
 
-	cid, err := getAddressNameResolved(contractStr, bs)
+	cid, err := getAddressNameResolved(contractStr, ctx)
 	if err == nil {
 		aid := types.ToAccountID(cid)
 		contractState, err := getOnlyContractState(ctx, aid)
@@ -1089,7 +1275,7 @@ func luaDeployContract(
 		return -1, C.CString("[Contract.LuaDeployContract]:" + err.Error())
 	}
 
-	cs := &callState{ctrState: contractState, prevState: &types.State{}, curState: newContract.State()}
+	cs := &callState{ctrState: contractState, prevState: &types.State{}, curState: newContract.State(), isDeploy: true}
 	ctx.callState[newContract.AccountID()] = cs
 
 	amountBig, err := transformAmount(C.GoString(amount))
@@ -1172,6 +1358,9 @@ func luaDeployContract(
 			}
 			return -1, C.CString("[Contract.LuaDeployContract] call err:" + ce.err.Error())
 		}
+		if err := addCodeHistory(ctx, contractState, prevContractInfo.contractId); err != nil {
+			return -1, C.CString("[Contract.LuaDeployContract]:" + err.Error())
+		}
 	}
 	if seq == 1 {
 		err := clearRecovery(L, ctx, seq, false)
@@ -1182,6 +1371,244 @@ func luaDeployContract(
 	return ret, addr
 }
 
+//export luaSetUpgradeOwner
+func luaSetUpgradeOwner(L *LState, service C.int, owner *C.char) *C.char {
+	ctx := contexts[service]
+	if ctx == nil {
+		return C.CString("[Contract.LuaSetUpgradeOwner] contract state not found")
+	}
+	if ctx.isQuery == true || ctx.nestedView > 0 {
+		return C.CString("[Contract.LuaSetUpgradeOwner] not permitted in query")
+	}
+	if ctx.blockInfo.Version < 3 {
+		return C.CString("[Contract.LuaSetUpgradeOwner] not supported")
+	}
+	cs := ctx.curContract.callState
+	cur, err := cs.ctrState.GetData(upgradeOwnerMetaKey)
+	if err != nil {
+		return C.CString("[Contract.LuaSetUpgradeOwner] " + err.Error())
+	}
+	// a contract can be made upgradeable only when it is deployed
+	if len(cur) == 0 && !cs.isDeploy {
+		return C.CString("[Contract.LuaSetUpgradeOwner] " + types.ErrNotUpgradeable.Error())
+	}
+	ownerStr := C.GoString(owner)
+	if len(ownerStr) == 0 {
+		err = cs.ctrState.DeleteData(upgradeOwnerMetaKey)
+	} else {
+		var addr []byte
+		addr, err = getAddressNameResolved(ownerStr, ctx)
+		if err != nil {
+			return C.CString("[Contract.LuaSetUpgradeOwner] invalid owner: " + err.Error())
+		}
+		err = cs.ctrState.SetData(upgradeOwnerMetaKey, []byte(types.EncodeAddress(addr)))
+	}
+	if err != nil {
+		return C.CString("[Contract.LuaSetUpgradeOwner] " + err.Error())
+	}
+	return nil
+}
+
+//export luaUpgradeContract
+func luaUpgradeContract(L *LState, service C.int, contract *C.char, template *C.char, args *C.char) (C.int, *C.char) {
+	ctx := contexts[service]
+	if ctx == nil {
+		return -1, C.CString("[Contract.LuaUpgradeContract] contract state not found")
+	}
+	if ctx.isQuery == true || ctx.nestedView > 0 {
+		return -1, C.CString("[Contract.LuaUpgradeContract] upgrade not permitted in query")
+	}
+	if ctx.blockInfo.Version < 3 {
+		return -1, C.CString("[Contract.LuaUpgradeContract] upgrade not supported")
+	}
+	bs := ctx.bs
+	prevContractInfo := ctx.curContract
+
+	cid, err := getAddressNameResolved(C.GoString(contract), ctx)
+	if err != nil {
+		return -1, C.CString("[Contract.LuaUpgradeContract] invalid contractId: " + err.Error())
+	}
+	if bytes.Equal(cid, prevContractInfo.contractId) {
+		return -1, C.CString("[Contract.LuaUpgradeContract] cannot upgrade the running contract")
+	}
+	aid := types.ToAccountID(cid)
+	cs, err := getCtrState(ctx, aid)
+	if err != nil {
+		return -1, C.CString("[Contract.LuaUpgradeContract] getAccount error: " + err.Error())
+	}
+	if len(cs.curState.GetCodeHash()) == 0 {
+		return -1, C.CString("[Contract.LuaUpgradeContract] not found contract " + C.GoString(contract))
+	}
+	// the contract must be upgradeable by the calling contract
+	owner, err := cs.ctrState.GetData(upgradeOwnerMetaKey)
+	if err != nil {
+		return -1, C.CString("[Contract.LuaUpgradeContract] " + err.Error())
+	}
+	if len(owner) == 0 {
+		return -1, C.CString("[Contract.LuaUpgradeContract] " + types.ErrNotUpgradeable.Error())
+	}
+	if !bytes.Equal(owner, []byte(types.EncodeAddress(prevContractInfo.contractId))) {
+		return -1, C.CString("[Contract.LuaUpgradeContract] " + types.ErrUpgradeOwnerNotMatch.Error())
+	}
+
+	// the new code is the one of a deployed contract
+	tid, err := getAddressNameResolved(C.GoString(template), ctx)
+	if err != nil {
+		return -1, C.CString("[Contract.LuaUpgradeContract] invalid code address: " + err.Error())
+	}
+	templateState, err := getOnlyContractState(ctx, types.ToAccountID(tid))
+	if err != nil {
+		return -1, C.CString("[Contract.LuaUpgradeContract] " + err.Error())
+	}
+	code, err := templateState.GetCode()
+	if err != nil {
+		return -1, C.CString("[Contract.LuaUpgradeContract] " + err.Error())
+	} else if len(code) == 0 {
+		return -1, C.CString("[Contract.LuaUpgradeContract] not found code")
+	}
+	prevCode, err := cs.ctrState.GetCode()
+	if err != nil {
+		return -1, C.CString("[Contract.LuaUpgradeContract] " + err.Error())
+	}
+	err = addUpdateSize(ctx, int64(len(code)))
+	if err != nil {
+		return -1, C.CString("[Contract.LuaUpgradeContract] " + err.Error())
+	}
+
+	var ci types.CallInfo
+	err = getCallInfo(&ci.Args, []byte(C.GoString(args)), cid)
+	if err != nil {
+		return -1, C.CString("[Contract.LuaUpgradeContract] invalid arguments: " + err.Error())
+	}
+
+	senderState := prevContractInfo.callState.curState
+	seq, err := setRecoveryPoint(aid, ctx, senderState, cs, zeroBig, false, false)
+	if err != nil {
+		return -1, C.CString("[System.LuaUpgradeContract] database error: " + err.Error())
+	}
+	// the previous code is restored on recovery
+	ctx.lastRecoveryEntry.prevCode = prevCode
+	if ctx.traceFile != nil {
+		_, _ = ctx.traceFile.WriteString(fmt.Sprintf("[UPGRADE] %s(%s)\n",
+			types.EncodeAddress(cid), aid.String()))
+		_, _ = ctx.traceFile.WriteString(fmt.Sprintf("upgrade snapshot set %d\n", seq))
+	}
+	ctx.curContract = newContractInfo(cs, prevContractInfo.contractId, cid,
+		cs.curState.SqlRecoveryPoint, zeroBig)
+	cs.isUpgrade = true
+	defer func() {
+		ctx.curContract = prevContractInfo
+		cs.isUpgrade = false
+	}()
+
+	err = cs.ctrState.SetCode(code)
+	if err != nil {
+		return -1, C.CString("[Contract.LuaUpgradeContract] " + err.Error())
+	}
+	bs.RemoveCache(aid)
+
+	refreshGas(ctx, L)
+	ce := newExecutor(util.LuaCode(code).ByteCode(), cid, ctx, &ci, zeroBig, true, false, cs.ctrState)
+	defer func() {
+		ce.close()
+		moveGas(L, ctx)
+	}()
+	if ce.err != nil {
+		if err := clearRecovery(L, ctx, seq, true); err != nil {
+			return -1, C.CString("[Contract.LuaUpgradeContract] recovery error: " + err.Error())
+		}
+		return -1, C.CString("[Contract.LuaUpgradeContract] newExecutor error: " + ce.err.Error())
+	}
+	defer setInstCount(ctx, L, ce.L)
+
+	ret := ce.call(minusCallCount(ctx, C.vm_instcount(L), luaCallCountDeduc), L)
+	if ce.err != nil {
+		err := clearRecovery(L, ctx, seq, true)
+		if err != nil {
+			return -1, C.CString("[Contract.LuaUpgradeContract] recovery error: " + err.Error())
+		}
+		if ctx.traceFile != nil {
+			_, _ = ctx.traceFile.WriteString(fmt.Sprintf("recovery snapshot: %d\n", seq))
+		}
+		return -1, C.CString("[Contract.LuaUpgradeContract] call err: " + ce.err.Error())
+	}
+	if err := addCodeHistory(ctx, cs.ctrState, prevContractInfo.contractId); err != nil {
+		return -1, C.CString("[Contract.LuaUpgradeContract] " + err.Error())
+	}
+	if seq == 1 {
+		err := clearRecovery(L, ctx, seq, false)
+		if err != nil {
+			return -1, C.CString("[Contract.LuaUpgradeContract] recovery error: " + err.Error())
+		}
+	}
+	return ret, nil
+}
+
+//export luaSchedule
+func luaSchedule(L *LState, service C.int, blockNo C.lua_Integer, gas C.lua_Integer,
+	fname *C.char, args *C.char) (*C.char, *C.char) {
+	ctx := contexts[service]
+	if ctx == nil {
+		return nil, C.CString("[Contract.LuaSchedule] contract state not found")
+	}
+	if ctx.isQuery == true || ctx.nestedView > 0 {
+		return nil, C.CString("[Contract.LuaSchedule] schedule not permitted in query")
+	}
+	if ctx.blockInfo.Version < 3 {
+		return nil, C.CString("[Contract.LuaSchedule] schedule not supported")
+	}
+	// without the fee, only the instruction limit of a call bounds the
+	// execution of a scheduled call
+	if fee.IsZeroFee() && vmIsGasSystem(ctx) {
+		return nil, C.CString("[Contract.LuaSchedule] schedule not supported on a zero-fee chain")
+	}
+	if uint64(blockNo) <= ctx.blockInfo.No {
+		return nil, C.CString(fmt.Sprintf("[Contract.LuaSchedule] block number must be greater than %d", ctx.blockInfo.No))
+	}
+	if uint64(blockNo) > ctx.blockInfo.No+system.MaxScheduleDelay {
+		return nil, C.CString(fmt.Sprintf("[Contract.LuaSchedule] block number must not be greater than %d", ctx.blockInfo.No+system.MaxScheduleDelay))
+	}
+	curContract := ctx.curContract
+	fnameStr := C.GoString(fname)
+	if _, err := resolveFunction(curContract.callState.ctrState, ctx.bs, fnameStr, false); err != nil {
+		return nil, C.CString("[Contract.LuaSchedule] " + err.Error())
+	}
+	prepaid := new(big.Int)
+	if !fee.IsZeroFee() {
+		prepaid.Mul(ctx.bs.GasPrice, new(big.Int).SetUint64(uint64(gas)))
+	}
+
+	aid := types.ToAccountID([]byte(types.AergoSystem))
+	scsState, err := getCtrState(ctx, aid)
+	if err != nil {
+		return nil, C.CString("[Contract.LuaSchedule] getAccount error: " + err.Error())
+	}
+	senderState := curContract.callState.curState
+	if r := sendBalance(L, senderState, scsState.curState, prepaid); r != nil {
+		return nil, r
+	}
+	if ctx.lastRecoveryEntry != nil {
+		if _, err = setRecoveryPoint(aid, ctx, senderState, scsState, prepaid, false, false); err != nil {
+			return nil, C.CString("[Contract.LuaSchedule] database error: " + err.Error())
+		}
+	}
+	idx, err := system.AddScheduledCall(scsState.ctrState, types.BlockNo(blockNo), &system.ScheduledCall{
+		Contract: curContract.contractId,
+		Function: fnameStr,
+		Args:     C.GoString(args),
+		GasLimit: uint64(gas),
+		Fee:      prepaid,
+	})
+	if err != nil {
+		return nil, C.CString("[Contract.LuaSchedule] " + err.Error())
+	}
+	if ctx.traceFile != nil {
+		_, _ = ctx.traceFile.WriteString(fmt.Sprintf("[Schedule] %s at %d : %s\n",
+			fnameStr, blockNo, prepaid.String()))
+	}
+	return C.CString(enc.ToString(system.ScheduledTxHash(types.BlockNo(blockNo), idx, curContract.contractId))), nil
+}
+
 //export isPublic
 func isPublic() C.int {
 	if PubNet {
@@ -1201,7 +1628,7 @@ func luaRandomInt(min, max, service C.int) C.int {
 }
 
 //export luaEvent
-func luaEvent(L *LState, service C.int, eventName *C.char, args *C.char) *C.char {
+func luaEvent(L *LState, service C.int, eventName *C.char, args *C.char, nIndexed C.int) *C.char {
 	ctx := contexts[service]
 	if ctx.isQuery == true || ctx.nestedView > 0 {
 		return C.CString("[Contract.Event] event not permitted in query")
@@ -1215,6 +1642,14 @@ func luaEvent(L *LState, service C.int, eventName *C.char, args *C.char) *C.char
 	if len(C.GoString(args)) > maxEventArgSize {
 		return C.CString(fmt.Sprintf("[Contract.Event] exceeded the maximum length of event args(%d)", maxEventArgSize))
 	}
+	// the indexed arguments add their hashes to the receipt and its bloom
+	if nIndexed != 0 && ctx.blockInfo.Version < 3 {
+		return C.CString("[Contract.Event] indexed event not supported")
+	}
+	indexed, err := indexEventArgs(C.GoString(args), int(nIndexed))
+	if err != nil {
+		return C.CString("[Contract.Event] " + err.Error())
+	}
 	ctx.events = append(
 		ctx.events,
 		&types.Event{
@@ -1222,19 +1657,48 @@ func luaEvent(L *LState, service C.int, eventName *C.char, args *C.char) *C.char
 			EventIdx:        ctx.eventCount,
 			EventName:       C.GoString(eventName),
 			JsonArgs:        C.GoString(args),
+			Indexed:         indexed,
 		},
 	)
 	ctx.eventCount++
 	return nil
 }
 
+// indexEventArgs returns the hashes of the first n arguments of an event.
+func indexEventArgs(jsonArgs string, n int) ([][]byte, error) {
+	if n == 0 {
+		return nil, nil
+	}
+	if n < 0 || n > types.MaxIndexedEventArgs {
+		return nil, fmt.Errorf("the number of indexed arguments must be between 0 and %d", types.MaxIndexedEventArgs)
+	}
+	var args []interface{}
+	d := json.NewDecoder(strings.NewReader(jsonArgs))
+	d.UseNumber()
+	if err := d.Decode(&args); err != nil {
+		return nil, err
+	}
+	if n > len(args) {
+		return nil, fmt.Errorf("not enough arguments to index(%d)", n)
+	}
+	indexed := make([][]byte, n)
+	for i := range indexed {
+		h, err := types.EventArgHash(args[i])
+		if err != nil {
+			return nil, err
+		}
+		indexed[i] = h
+	}
+	return indexed, nil
+}
+
 //export luaIsContract
 func luaIsContract(L *LState, service C.int, contractId *C.char) (C.int, *C.char) {
 	ctx := contexts[service]
 	if ctx == nil {
 		return -1, C.CString("[Contract.LuaIsContract] contract state not found")
 	}
-	cid, err := getAddressNameResolved(C.GoString(contractId), ctx.bs)
+	cid, err := getAddressNameResolved(C.GoString(contractId), ctx)
 	if err != nil {
 		return -1, C.CString("[Contract.LuaIsContract] invalid contractId: " + err.Error())
 	}
@@ -1450,7 +1914,7 @@ func luaGetStaking(service C.int, addr *C.char) (*C.char, C.lua_Integer, *C.char
 	if err != nil {
 		return nil, 0, C.CString(err.Error())
 	}
-	staking, err = system.GetStaking(scs, name.GetAddress(namescs, types.ToAddress(C.GoString(addr))))
+	staking, err = system.GetStaking(scs, name.GetAddress(namescs, types.ToAddress(C.GoString(addr)), ctx.blockInfo.No))
 	if err != nil {
 		return nil, 0, C.CString(err.Error())
 	}
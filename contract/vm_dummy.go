@@ -143,6 +143,39 @@ func (bc *DummyChain) GetABI(contract string) (*types.ABI, error) {
 	return GetABI(cState, nil)
 }
 
+func (bc *DummyChain) GetCodeHistory(contract string) (*types.CodeHistory, error) {
+	cState, err := bc.sdb.GetStateDB().OpenContractStateAccount(types.ToAccountID(strHash(contract)))
+	if err != nil {
+		return nil, err
+	}
+	return GetCodeHistory(cState)
+}
+
+func (bc *DummyChain) VerifySource(contract, source string) error {
+	cState, err := bc.sdb.GetStateDB().OpenContractStateAccount(types.ToAccountID(strHash(contract)))
+	if err != nil {
+		return err
+	}
+	_, err = VerifySource(cState, source)
+	return err
+}
+
+func (bc *DummyChain) GetDBStats(contract string) (*types.ContractDBStats, error) {
+	cState, err := bc.sdb.GetStateDB().OpenContractStateAccount(types.ToAccountID(strHash(contract)))
+	if err != nil {
+		return nil, err
+	}
+	return GetDBStats(cState)
+}
+
+func (bc *DummyChain) QuerySQL(contract, query string, limit uint32) (*types.SQLQueryResult, error) {
+	cState, err := bc.sdb.GetStateDB().OpenContractStateAccount(types.ToAccountID(strHash(contract)))
+	if err != nil {
+		return nil, err
+	}
+	return QuerySQL(cState, query, limit)
+}
+
 func (bc *DummyChain) GetEvents(txhash []byte) []*types.Event {
 	receipt := bc.GetReceipt(txhash)
 	if receipt != nil {
@@ -428,6 +461,11 @@ func contractFrame(l luaTxContract, bs *state.BlockState, cdb ChainAccessor, rec
 		rv = err.Error()
 	}
 	r := types.NewReceipt(l.contract(), status, rv)
+	if revert := GetRevertError(err); revert != nil {
+		r.RevertCode = revert.Code
+		r.RevertMessage = revert.Message
+		r.RevertData = revert.Data
+	}
 	r.TxHash = l.Hash()
 	r.GasUsed = usedFee.Uint64()
 	r.Events = evs
@@ -581,6 +619,9 @@ func (bc *DummyChain) ConnectBlock(txs ...LuaTxTester) error {
 		}
 	}()
 	SetBPTimeout(timeout)
+	if err := bc.runScheduled(blockState, types.NewBlockHeaderInfo(bc.cBlock), tx); err != nil {
+		return err
+	}
 	for _, x := range txs {
 		if err := x.run(blockState, bc, types.NewBlockHeaderInfo(bc.cBlock), tx); err != nil {
 			return err
@@ -605,6 +646,42 @@ func (bc *DummyChain) ConnectBlock(txs ...LuaTxTester) error {
 	return nil
 }
 
+func (bc *DummyChain) runScheduled(bs *state.BlockState, bi *types.BlockHeaderInfo, receiptTx db.Transaction) error {
+	scs, err := bs.GetSystemAccountState()
+	if err != nil {
+		return err
+	}
+	calls, err := system.GetScheduledCalls(scs, bi.No)
+	if err != nil || len(calls) == 0 {
+		return err
+	}
+	if err = system.RemoveScheduledCalls(scs, bi.No); err != nil {
+		return err
+	}
+	if err = bs.StageContractState(scs); err != nil {
+		return err
+	}
+	for i, call := range calls {
+		txHash := system.ScheduledTxHash(bi.No, i, call.Contract)
+		rv, evs, usedFee, err := ExecuteScheduledCall(bs, bc, call, txHash, bi, BlockFactory)
+		if err != nil && !IsRuntimeError(err) {
+			return err
+		}
+		status := "SUCCESS"
+		if err != nil {
+			status = "ERROR"
+			rv = err.Error()
+		}
+		r := types.NewReceipt(call.Contract, status, rv)
+		r.TxHash = txHash
+		r.GasUsed = usedFee.Uint64()
+		r.Events = evs
+		b, _ := r.MarshalBinaryTest()
+		receiptTx.Set(txHash, b)
+	}
+	return nil
+}
+
 func (bc *DummyChain) DisConnectBlock() error {
 	if len(bc.blockIds) == 1 {
 		return errors.New("genesis block")
@@ -12,6 +12,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/aergoio/aergo/contract/system"
+	"github.com/aergoio/aergo/internal/enc"
 	"github.com/aergoio/aergo/types"
 )
 
@@ -161,6 +163,338 @@ abi.register(hello)`),
 	}
 }
 
+func TestGetTypedABI(t *testing.T) {
+	bc, err := LoadDummyChain()
+	if err != nil {
+		t.Errorf("failed to create test database: %v", err)
+	}
+	defer bc.Release()
+
+	err = bc.ConnectBlock(
+		NewLuaTxAccount("ktlee", 100000000000000000),
+		NewLuaTxDef("ktlee", "typed", 0,
+			`function transfer(to, amount)
+  contract.event("transfer", to, amount)
+  return true
+end
+
+abi.register(transfer)
+abi.types("transfer", {"address", "bignum"}, {"bool"})
+abi.event("transfer", "to:address", "amount:bignum", "memo")`),
+	)
+	if err != nil {
+		t.Error(err)
+	}
+	abi, err := bc.GetABI("typed")
+	if err != nil {
+		t.Error(err)
+	}
+	b, err := json.Marshal(abi)
+	if err != nil {
+		t.Error(err)
+	}
+	if string(b) != `{"version":"0.2","language":"lua","functions":[{"name":"transfer","arguments":[{"name":"to","type":"address"},{"name":"amount","type":"bignum"}],"returns":[{"type":"bool"}]}],"events":[{"name":"transfer","arguments":[{"name":"to","type":"address"},{"name":"amount","type":"bignum"},{"name":"memo"}]}]}` {
+		t.Error(string(b))
+	}
+
+	err = bc.ConnectBlock(
+		NewLuaTxDef("ktlee", "badtype", 0,
+			`function f(a) end
+abi.register(f)
+abi.types("f", {"uint"})`),
+	)
+	if err == nil || !strings.Contains(err.Error(), "unknown type uint") {
+		t.Errorf("expected: unknown type uint, but got: %v", err)
+	}
+}
+
+func TestUpgradeableContract(t *testing.T) {
+	bc, err := LoadDummyChain()
+	if err != nil {
+		t.Errorf("failed to create test database: %v", err)
+	}
+	defer bc.Release()
+
+	definition := `
+function constructor()
+  contract.setUpgradeOwner(system.getCreator())
+end
+function lock()
+  contract.setUpgradeOwner()
+end
+abi.register(lock)`
+
+	err = bc.ConnectBlock(
+		NewLuaTxAccount("ktlee", 100000000000000000),
+		NewLuaTxDef("ktlee", "upgradeable", 0, definition),
+		NewLuaTxDef("ktlee", "immutable", 0, `function f() contract.setUpgradeOwner(system.getSender()) end abi.register(f)`),
+	)
+	if err != nil {
+		t.Error(err)
+	}
+	history, err := bc.GetCodeHistory("upgradeable")
+	if err != nil {
+		t.Error(err)
+	}
+	if len(history.Versions) != 1 || history.Versions[0].Version != 1 {
+		t.Errorf("unexpected code history: %v", history)
+	}
+	history, err = bc.GetCodeHistory("immutable")
+	if err != nil {
+		t.Error(err)
+	}
+	if len(history.Versions) != 0 {
+		t.Errorf("unexpected code history: %v", history)
+	}
+
+	err = bc.ConnectBlock(
+		NewLuaTxCall("ktlee", "immutable", 0, `{"Name":"f"}`).Fail(types.ErrNotUpgradeable.Error()),
+		NewLuaTxCall("ktlee", "upgradeable", 0, `{"Name":"lock"}`),
+	)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestVerifySource(t *testing.T) {
+	bc, err := LoadDummyChain()
+	if err != nil {
+		t.Errorf("failed to create test database: %v", err)
+	}
+	defer bc.Release()
+
+	source := "function hello(name) return 'hello ' .. name end abi.register(hello)"
+	err = bc.ConnectBlock(
+		NewLuaTxAccount("ktlee", 100000000000000000),
+		NewLuaTxDef("ktlee", "hello", 0, source),
+	)
+	if err != nil {
+		t.Error(err)
+	}
+	if err = bc.VerifySource("hello", source); err != nil {
+		t.Error(err)
+	}
+	err = bc.VerifySource("hello", "function hello(name) return 'hi ' .. name end abi.register(hello)")
+	if err != types.ErrSourceNotMatch {
+		t.Errorf("expected: %v, but got: %v", types.ErrSourceNotMatch, err)
+	}
+	err = bc.VerifySource("hello", "function hello(")
+	if err == nil {
+		t.Error("expected a compile error")
+	}
+}
+
+func TestRevertReason(t *testing.T) {
+	bc, err := LoadDummyChain()
+	if err != nil {
+		t.Errorf("failed to create test database: %v", err)
+	}
+	defer bc.Release()
+
+	callee := `
+function fail(need)
+  error({code = "E01", message = "insufficient funds", data = {need = need}})
+end
+abi.register(fail)`
+
+	caller := `
+function relay(addr)
+  contract.call(addr, "fail", 10)
+end
+function catch(addr)
+  local ok, e = pcall(contract.call, addr, "fail", 10)
+  return ok, e.code, e.data.need
+end
+abi.register(relay, catch)`
+
+	err = bc.ConnectBlock(
+		NewLuaTxAccount("ktlee", 100000000000000000),
+		NewLuaTxDef("ktlee", "callee", 0, callee),
+		NewLuaTxDef("ktlee", "caller", 0, caller),
+	)
+	if err != nil {
+		t.Error(err)
+	}
+	addr := types.EncodeAddress(strHash("callee"))
+
+	tx := NewLuaTxCall("ktlee", "caller", 0, fmt.Sprintf(`{"Name":"relay", "Args":["%s"]}`, addr)).Fail("E01: insufficient funds")
+	if err = bc.ConnectBlock(tx); err != nil {
+		t.Error(err)
+	}
+	receipt := bc.GetReceipt(tx.Hash())
+	if receipt.GetRevertCode() != "E01" || receipt.GetRevertMessage() != "insufficient funds" || receipt.GetRevertData() != `{"need":10}` {
+		t.Errorf("unexpected revert reason: %s", receipt.String())
+	}
+
+	tx = NewLuaTxCall("ktlee", "caller", 0, fmt.Sprintf(`{"Name":"catch", "Args":["%s"]}`, addr))
+	if err = bc.ConnectBlock(tx); err != nil {
+		t.Error(err)
+	}
+	receipt = bc.GetReceipt(tx.Hash())
+	if receipt.GetRet() != `[false,"E01",10]` {
+		t.Errorf("unexpected result: %s", receipt.GetRet())
+	}
+}
+
+func TestScheduledCall(t *testing.T) {
+	bc, err := LoadDummyChain()
+	if err != nil {
+		t.Errorf("failed to create test database: %v", err)
+	}
+	defer bc.Release()
+
+	src := `
+state.var { total = state.value() }
+function after(n, fname, v)
+  return contract.schedule(system.getBlockheight() + n, 100000, fname, v)
+end
+function add(v)
+  total:set((total:get() or 0) + v)
+end
+function fail()
+  error("boom")
+end
+function get()
+  return total:get()
+end
+function view_schedule()
+  return contract.schedule(system.getBlockheight() + 1, 100000, "add", 1)
+end
+abi.register(after, add, fail)
+abi.register_view(get, view_schedule)`
+
+	err = bc.ConnectBlock(
+		NewLuaTxAccount("ktlee", 100000000000000000),
+		NewLuaTxDef("ktlee", "sched", 0, src),
+	)
+	if err != nil {
+		t.Error(err)
+	}
+	tx := NewLuaTxCall("ktlee", "sched", 0, `{"Name":"after", "Args":[2, "add", 7]}`)
+	failTx := NewLuaTxCall("ktlee", "sched", 0, `{"Name":"after", "Args":[2, "fail"]}`)
+	if err = bc.ConnectBlock(tx, failTx); err != nil {
+		t.Error(err)
+	}
+	err = bc.ConnectBlock(
+		NewLuaTxCall("ktlee", "sched", 0, `{"Name":"after", "Args":[0, "add", 1]}`).Fail("block number must be greater than"),
+		NewLuaTxCall("ktlee", "sched", 0, fmt.Sprintf(`{"Name":"after", "Args":[%d, "add", 1]}`, system.MaxScheduleDelay+1)).Fail("block number must not be greater than"),
+		NewLuaTxCall("ktlee", "sched", 0, `{"Name":"after", "Args":[1, "unknown", 1]}`).Fail("not found function: unknown"),
+		NewLuaTxCall("ktlee", "sched", 0, `{"Name":"view_schedule"}`).Fail("not permitted in query"),
+	)
+	if err != nil {
+		t.Error(err)
+	}
+	if err = bc.Query("sched", `{"Name":"get"}`, "", "null"); err != nil {
+		t.Error(err)
+	}
+	if err = bc.ConnectBlock(); err != nil {
+		t.Error(err)
+	}
+	if err = bc.Query("sched", `{"Name":"get"}`, "", "7"); err != nil {
+		t.Error(err)
+	}
+
+	scheduledReceipt := func(tx *luaTxCall) *types.Receipt {
+		var hash string
+		if err := json.Unmarshal([]byte(bc.GetReceipt(tx.Hash()).GetRet()), &hash); err != nil {
+			t.Fatal(err)
+		}
+		h, err := enc.ToBytes(hash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return bc.GetReceipt(h)
+	}
+	if receipt := scheduledReceipt(tx); receipt.GetStatus() != "SUCCESS" {
+		t.Errorf("unexpected receipt of the scheduled call: %s", receipt.String())
+	}
+	if receipt := scheduledReceipt(failTx); receipt.GetStatus() != "ERROR" || !strings.Contains(receipt.GetRet(), "boom") {
+		t.Errorf("unexpected receipt of the scheduled call: %s", receipt.String())
+	}
+}
+
+func TestUpgradeByContract(t *testing.T) {
+	bc, err := LoadDummyChain()
+	if err != nil {
+		t.Errorf("failed to create test database: %v", err)
+	}
+	defer bc.Release()
+
+	dao := `
+function upgrade(target, code, v)
+  return contract.upgrade(target, code, v)
+end
+abi.register(upgrade)`
+
+	v1 := `
+function constructor(owner)
+  contract.setUpgradeOwner(owner)
+end
+function version()
+  return 1
+end
+abi.register_view(version)`
+
+	v2 := `
+function migrate(v)
+  if v == 0 then
+    error("invalid value")
+  end
+  system.setItem("v", v)
+  return v
+end
+function version()
+  return 2, system.getItem("v")
+end
+abi.register_view(version)`
+
+	daoAddr := types.EncodeAddress(strHash("dao"))
+	err = bc.ConnectBlock(
+		NewLuaTxAccount("ktlee", 100000000000000000),
+		NewLuaTxDef("ktlee", "dao", 0, dao),
+		NewLuaTxDef("ktlee", "target", 0, v1).Constructor(fmt.Sprintf(`["%s"]`, daoAddr)),
+		NewLuaTxDef("ktlee", "other", 0, v1).Constructor(`["ktlee"]`),
+		NewLuaTxDef("ktlee", "v2", 0, v2),
+	)
+	if err != nil {
+		t.Error(err)
+	}
+	target := types.EncodeAddress(strHash("target"))
+	other := types.EncodeAddress(strHash("other"))
+	code := types.EncodeAddress(strHash("v2"))
+
+	err = bc.ConnectBlock(
+		NewLuaTxCall("ktlee", "dao", 0, fmt.Sprintf(`{"Name":"upgrade", "Args":["%s", "%s", 7]}`, other, code)).
+			Fail(types.ErrUpgradeOwnerNotMatch.Error()),
+		NewLuaTxCall("ktlee", "dao", 0, fmt.Sprintf(`{"Name":"upgrade", "Args":["%s", "%s", 0]}`, target, code)).
+			Fail("invalid value"),
+	)
+	if err != nil {
+		t.Error(err)
+	}
+	if err = bc.Query("target", `{"Name":"version"}`, "", "1"); err != nil {
+		t.Error(err)
+	}
+
+	err = bc.ConnectBlock(
+		NewLuaTxCall("ktlee", "dao", 0, fmt.Sprintf(`{"Name":"upgrade", "Args":["%s", "%s", 7]}`, target, code)),
+	)
+	if err != nil {
+		t.Error(err)
+	}
+	if err = bc.Query("target", `{"Name":"version"}`, "", "[2,7]"); err != nil {
+		t.Error(err)
+	}
+	history, err := bc.GetCodeHistory("target")
+	if err != nil {
+		t.Error(err)
+	}
+	if len(history.Versions) != 2 || history.Versions[1].Deployer == nil ||
+		types.EncodeAddress(history.Versions[1].Deployer) != daoAddr {
+		t.Errorf("unexpected code history: %v", history)
+	}
+}
+
 func TestContractQuery(t *testing.T) {
 	bc, err := LoadDummyChain()
 	if err != nil {
@@ -860,6 +1194,128 @@ abi.register(createAndInsert, insertRollbackData, query, count, all)`
 	}
 }
 
+func TestSqlVmDBStats(t *testing.T) {
+	bc, err := LoadDummyChain()
+	if err != nil {
+		t.Errorf("failed to create test database: %v", err)
+	}
+	defer bc.Release()
+
+	definition := `
+function init()
+    db.exec("create table if not exists book(title text)")
+    db.exec("create table if not exists author(name text)")
+end
+
+function add(n)
+    local stmt = db.prepare("insert into book values (?)")
+    for i = 1, n do
+        stmt:exec("book" .. i)
+    end
+end
+
+abi.register(init, add)`
+
+	err = bc.ConnectBlock(
+		NewLuaTxAccount("ktlee", 100000000000000000),
+		NewLuaTxDef("ktlee", "stats", 0, definition),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stats, err := bc.GetDBStats("stats")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats.Tables) != 0 || stats.Size != 0 {
+		t.Errorf("stats of the contract without database: %v", stats)
+	}
+
+	err = bc.ConnectBlock(
+		NewLuaTxCall("ktlee", "stats", 0, `{"Name":"init"}`),
+		NewLuaTxCall("ktlee", "stats", 0, `{"Name":"add", "Args":[10]}`),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stats, err = bc.GetDBStats("stats")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Size == 0 || stats.Size != stats.PageCount*stats.PageSize {
+		t.Errorf("invalid size: %v", stats)
+	}
+	if len(stats.Tables) != 2 {
+		t.Fatalf("expected 2 tables, got %v", stats.Tables)
+	}
+	if stats.Tables[0].Name != "author" || stats.Tables[0].Rows != 0 {
+		t.Errorf("invalid table stats: %v", stats.Tables[0])
+	}
+	if stats.Tables[1].Name != "book" || stats.Tables[1].Rows != 10 {
+		t.Errorf("invalid table stats: %v", stats.Tables[1])
+	}
+}
+
+func TestSqlVmQuerySQL(t *testing.T) {
+	bc, err := LoadDummyChain()
+	if err != nil {
+		t.Errorf("failed to create test database: %v", err)
+	}
+	defer bc.Release()
+
+	definition := `
+function init()
+    db.exec("create table if not exists book(id integer, title text)")
+    local stmt = db.prepare("insert into book values (?, ?)")
+    for i = 1, 5 do
+        stmt:exec(i, "book" .. i)
+    end
+    db.exec("insert into book values (6, null)")
+end
+
+abi.register(init)`
+
+	err = bc.ConnectBlock(
+		NewLuaTxAccount("ktlee", 100000000000000000),
+		NewLuaTxDef("ktlee", "sqlquery", 0, definition),
+		NewLuaTxCall("ktlee", "sqlquery", 0, `{"Name":"init"}`),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := bc.QuerySQL("sqlquery", "select id, title from book order by id", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Columns) != 2 || result.Columns[0] != "id" || result.Columns[1] != "title" {
+		t.Errorf("invalid columns: %v", result.Columns)
+	}
+	if len(result.Rows) != 6 || result.Truncated {
+		t.Fatalf("invalid rows: %v", result)
+	}
+	if result.Rows[0] != `[1,"book1"]` || result.Rows[5] != `[6,null]` {
+		t.Errorf("invalid rows: %v", result.Rows)
+	}
+
+	result, err = bc.QuerySQL("sqlquery", "select id from book order by id", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Rows) != 2 || !result.Truncated {
+		t.Errorf("expected 2 rows and truncated, got %v", result)
+	}
+
+	_, err = bc.QuerySQL("sqlquery", "delete from book", 0)
+	if err == nil || !strings.Contains(err.Error(), "only select queries allowed") {
+		t.Errorf("expected error, got %v", err)
+	}
+	_, err = bc.QuerySQL("ktlee", "select 1", 0)
+	if err == nil {
+		t.Error("expected error for the account without database")
+	}
+}
+
 func TestSqlVmFail(t *testing.T) {
 	bc, err := LoadDummyChain()
 	if err != nil {
@@ -3020,6 +3476,72 @@ abi.register(get, checkEther, checkAergo, keccak256)
 	}
 }
 
+func TestCryptoSignatures(t *testing.T) {
+	src := `
+function hashes(s)
+	return crypto.ripemd160(s), crypto.blake2b(s)
+end
+
+function recover()
+	return crypto.ecrecover("0xce0677bb30baa8cf067c88db9811f4333d131bf8bcf12fe7065d211dce971008",
+"0x90f27b8b488db00b00606796d2987f6a5f59ae62ea05effe84fef5b8b0e549984a691139ad57a3f0b906637673aa2f63d1f55cb1a69199d4009eea23ceaddc9301")
+end
+
+function ed25519(msg)
+	return crypto.ed25519verify(msg,
+"0x92a009a9f0d4cab8720e820b5f642540a2b27b5416503f8fb3762223ebdb69da085ac1e43e15996e458f3613d0f11d8c387b2eaeb4302aeeb00d291612bb0c00",
+"0x3d4017c3e843895a92b70aa74d1b7ebc9c982ccf2ec4968cc0cd55f12af4660c")
+end
+
+function bls(msg)
+	local pk = "0xa491d1b0ecd9bb917989f0e74f0dea0422eac4a873e5e2644f368dffb9a6e20fd6e10c1b77654d067c0618f6e5a7f79a"
+	local sig = "0xb6ed936746e01f8ecf281f020953fbf1f01debd5657c4a383940b020b26507f6076334f91e2366c96e9ab279fb5158090352ea1c5b0c9274504f4f0e7053af24802e51e4568d164fe986834f41e55c8e850ce1f98458c0cfc9ab380b55285a55"
+	return crypto.blsAggregateVerify(sig, {pk}, msg), crypto.blsAggregateVerify(sig, {pk}, {msg})
+end
+
+abi.register(hashes, recover, ed25519, bls)
+`
+	bc, err := LoadDummyChain()
+	if err != nil {
+		t.Errorf("failed to create test database: %v", err)
+	}
+	defer bc.Release()
+
+	err = bc.ConnectBlock(
+		NewLuaTxAccount("ktlee", 100000000000000000),
+		NewLuaTxDef("ktlee", "crypto", 0, src),
+	)
+	if err != nil {
+		t.Error(err)
+	}
+	err = bc.Query("crypto", `{"Name": "hashes", "Args" : ["0x616263"]}`, "",
+		`["0x8eb208f7e05d987a9b044a8e98c6b087f15a0bfc","0xbddd813c634239723171ef3fee98579b94964e3bb1cb3e427262c8c068d52319"]`)
+	if err != nil {
+		t.Error(err)
+	}
+	err = bc.Query("crypto", `{"Name": "recover", "Args" : []}`, "",
+		`"0x04e32df42865e97135acfb65f3bae71bdc86f4d49150ad6a440b6f15878109880a0a2b2667f7e725ceea70c673093bf67663e0312623c8e091b13cf2c0f11ef652"`)
+	if err != nil {
+		t.Error(err)
+	}
+	err = bc.Query("crypto", `{"Name": "ed25519", "Args" : ["0x72"]}`, "", `true`)
+	if err != nil {
+		t.Error(err)
+	}
+	err = bc.Query("crypto", `{"Name": "ed25519", "Args" : ["0x73"]}`, "", `false`)
+	if err != nil {
+		t.Error(err)
+	}
+	err = bc.Query("crypto", `{"Name": "bls", "Args" : ["0x0000000000000000000000000000000000000000000000000000000000000000"]}`, "", `[true,true]`)
+	if err != nil {
+		t.Error(err)
+	}
+	err = bc.Query("crypto", `{"Name": "bls", "Args" : ["0x0000000000000000000000000000000000000000000000000000000000000001"]}`, "", `[false,false]`)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestPayable(t *testing.T) {
 	src := `
 state.var {
@@ -3276,6 +3798,84 @@ abi.payable(constructor)
 	}
 }
 
+func TestDecimal(t *testing.T) {
+	src := `
+function interest(principal, rate, mode)
+	local p = decimal.new(principal)
+	return tostring(decimal.mul(p, rate, 2, mode))
+end
+
+function calc()
+	local a = decimal.new("1.10")
+	local b = decimal.new("2.205")
+	assert(decimal.isdecimal(a) and not decimal.isdecimal("1.10"))
+	assert(a + b == decimal.new("3.305") and b - a == decimal.new("1.105"))
+	assert(-a < a and a <= decimal.new("1.1") and a == decimal.new("1.1"))
+	assert(a:scale() == 2 and (a * b):scale() == 5)
+	assert(decimal.compare(a, 1) == 1)
+	return tostring(a / 3), tostring(b:round(2)), tostring(b:round(2, "up")), tostring(decimal.abs(-b))
+end
+
+function convert()
+	local d = decimal.div(bignum.number("12345"), 100, 2)
+	local n = d:tobignum()
+	assert(bignum.isbignum(n))
+	return tostring(d), tostring(n), tostring(d:tobignum("ceil")), d:tostring()
+end
+
+function divzero()
+	return decimal.new(1) / 0
+end
+
+function badscale()
+	return decimal.new("1.5", -1)
+end
+
+abi.register(interest, calc, convert, divzero, badscale)`
+
+	bc, err := LoadDummyChain()
+	if err != nil {
+		t.Errorf("failed to create test database: %v", err)
+	}
+	defer bc.Release()
+
+	err = bc.ConnectBlock(
+		NewLuaTxAccount("ktlee", 100000000000000000),
+		NewLuaTxDef("ktlee", "decimal", 0, src),
+	)
+	if err != nil {
+		t.Error(err)
+	}
+	err = bc.Query("decimal", `{"Name":"interest", "Args":["1000.25", "0.035"]}`, "", `"35.01"`)
+	if err != nil {
+		t.Error(err)
+	}
+	err = bc.Query("decimal", `{"Name":"interest", "Args":["1000.25", "0.035", "down"]}`, "", `"35.00"`)
+	if err != nil {
+		t.Error(err)
+	}
+	err = bc.Query("decimal", `{"Name":"interest", "Args":["1000.25", "0.035", "sideways"]}`, "invalid rounding mode")
+	if err != nil {
+		t.Error(err)
+	}
+	err = bc.Query("decimal", `{"Name":"calc"}`, "", `["0.366666666666666667","2.20","2.21","2.205"]`)
+	if err != nil {
+		t.Error(err)
+	}
+	err = bc.Query("decimal", `{"Name":"convert"}`, "", `["123.45","123","124","123.45"]`)
+	if err != nil {
+		t.Error(err)
+	}
+	err = bc.Query("decimal", `{"Name":"divzero"}`, "decimal divide by zero")
+	if err != nil {
+		t.Error(err)
+	}
+	err = bc.Query("decimal", `{"Name":"badscale"}`, "decimal scale must not be negative")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestDeploy(t *testing.T) {
 	deploy := `
 function hello()
@@ -3878,6 +4478,64 @@ func TestEvent(t *testing.T) {
 	}
 }
 
+func TestEventIndexed(t *testing.T) {
+	bc, err := LoadDummyChain()
+	if err != nil {
+		t.Errorf("failed to create test database: %v", err)
+	}
+	defer bc.Release()
+
+	definition := `
+    function transfer(to, amount)
+        contract.event_indexed("transfer", 1, to, amount, "memo")
+    end
+    function too_many()
+        contract.event_indexed("transfer", 4, 1, 2, 3, 4)
+    end
+    function not_enough()
+        contract.event_indexed("transfer", 2, 1)
+    end
+    abi.register(transfer, too_many, not_enough)`
+
+	err = bc.ConnectBlock(
+		NewLuaTxAccount("ktlee", 100000000000000000),
+		NewLuaTxDef("ktlee", "indexed", 0, definition),
+	)
+	if err != nil {
+		t.Error(err)
+	}
+	to := types.EncodeAddress(strHash("ktlee"))
+	tx := NewLuaTxCall("ktlee", "indexed", 0, fmt.Sprintf(`{"Name": "transfer", "Args":["%s", 10]}`, to))
+	err = bc.ConnectBlock(tx)
+	if err != nil {
+		t.Error(err)
+	}
+	events := bc.GetEvents(tx.Hash())
+	if len(events) != 1 || len(events[0].Indexed) != 1 {
+		t.Fatalf("unexpected events: %v", events)
+	}
+	h, _ := types.EventArgHash(to)
+	if !bytes.Equal(events[0].Indexed[0], h) {
+		t.Errorf("unexpected indexed hash: %v", events[0].Indexed)
+	}
+	fi := &types.FilterInfo{IndexedFilter: []byte(fmt.Sprintf(`{"0":"%s"}`, to))}
+	indexed, err := fi.GetExIndexedFilter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !events[0].Filter(fi, nil, indexed) {
+		t.Error("the event must be matched by the indexed argument")
+	}
+
+	err = bc.ConnectBlock(
+		NewLuaTxCall("ktlee", "indexed", 0, `{"Name": "too_many", "Args":[]}`).Fail("the number of indexed arguments must be between 0 and 3"),
+		NewLuaTxCall("ktlee", "indexed", 0, `{"Name": "not_enough", "Args":[]}`).Fail("not enough arguments to index(2)"),
+	)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestView(t *testing.T) {
 	bc, err := LoadDummyChain()
 	if err != nil {
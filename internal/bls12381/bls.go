@@ -0,0 +1,103 @@
+// Package bls12381 verifies the BLS signatures of the proof of possession
+// scheme with the public keys in G1 and the signatures in G2. The curve
+// arithmetic is done by github.com/kilic/bls12-381.
+package bls12381
+
+import (
+	"errors"
+
+	bls "github.com/kilic/bls12-381"
+)
+
+// DST is the domain separation tag of the proof of possession scheme with
+// the public keys in G1, which is used by Ethereum.
+var DST = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+var (
+	ErrInvalidInput = errors.New("bls12381: the number of public keys and messages differ")
+	ErrNoPublicKey  = errors.New("bls12381: no public key")
+
+	errPointAtInfinity = errors.New("bls12381: public key is the point at infinity")
+)
+
+// decodePublicKey decodes a compressed public key, which must be in the
+// subgroup and not the point at infinity.
+func decodePublicKey(g1 *bls.G1, in []byte) (*bls.PointG1, error) {
+	pk, err := g1.FromCompressed(in)
+	if err != nil {
+		return nil, errors.New("bls12381: " + err.Error())
+	}
+	if g1.IsZero(pk) {
+		return nil, errPointAtInfinity
+	}
+	return pk, nil
+}
+
+// decodeSignature decodes a compressed signature, which must be in the
+// subgroup.
+func decodeSignature(g2 *bls.G2, in []byte) (*bls.PointG2, error) {
+	sig, err := g2.FromCompressed(in)
+	if err != nil {
+		return nil, errors.New("bls12381: " + err.Error())
+	}
+	return sig, nil
+}
+
+// AggregateVerify verifies the aggregated signature of msgs[i] signed by the
+// owner of pubkeys[i]. The public keys and the signature are compressed. It
+// returns an error only if an input is malformed.
+func AggregateVerify(pubkeys, msgs [][]byte, sig []byte) (bool, error) {
+	if len(pubkeys) != len(msgs) {
+		return false, ErrInvalidInput
+	}
+	if len(pubkeys) == 0 {
+		return false, ErrNoPublicKey
+	}
+	e := bls.NewEngine()
+	s, err := decodeSignature(e.G2, sig)
+	if err != nil {
+		return false, err
+	}
+	for i, in := range pubkeys {
+		pk, err := decodePublicKey(e.G1, in)
+		if err != nil {
+			return false, err
+		}
+		h, err := e.G2.HashToCurve(msgs[i], DST)
+		if err != nil {
+			return false, err
+		}
+		e.AddPair(pk, h)
+	}
+	// e(pk_1, H(m_1)) * ... * e(pk_n, H(m_n)) * e(-g1, sig) == 1
+	e.AddPairInv(e.G1.One(), s)
+	return e.Check(), nil
+}
+
+// FastAggregateVerify verifies the aggregated signature of the same msg
+// signed by the owners of pubkeys.
+func FastAggregateVerify(pubkeys [][]byte, msg []byte, sig []byte) (bool, error) {
+	if len(pubkeys) == 0 {
+		return false, ErrNoPublicKey
+	}
+	e := bls.NewEngine()
+	s, err := decodeSignature(e.G2, sig)
+	if err != nil {
+		return false, err
+	}
+	agg := e.G1.Zero()
+	for _, in := range pubkeys {
+		pk, err := decodePublicKey(e.G1, in)
+		if err != nil {
+			return false, err
+		}
+		e.G1.Add(agg, agg, pk)
+	}
+	h, err := e.G2.HashToCurve(msg, DST)
+	if err != nil {
+		return false, err
+	}
+	e.AddPair(agg, h)
+	e.AddPairInv(e.G1.One(), s)
+	return e.Check(), nil
+}
@@ -0,0 +1,86 @@
+package bls12381
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	bls "github.com/kilic/bls12-381"
+	"github.com/stretchr/testify/assert"
+)
+
+func sign(sk *big.Int, msg []byte) ([]byte, []byte) {
+	g1, g2 := bls.NewG1(), bls.NewG2()
+	pk := g1.MulScalarBig(g1.New(), g1.One(), sk)
+	h, _ := g2.HashToCurve(msg, DST)
+	sig := g2.MulScalarBig(g2.New(), h, sk)
+	return g1.ToCompressed(pk), g2.ToCompressed(sig)
+}
+
+func TestSignatureVector(t *testing.T) {
+	// a vector of the Ethereum consensus specs
+	pk, _ := hex.DecodeString("a491d1b0ecd9bb917989f0e74f0dea0422eac4a873e5e2644f368dffb9a6e20fd6e10c1b77654d067c0618f6e5a7f79a")
+	sig, _ := hex.DecodeString("b6ed936746e01f8ecf281f020953fbf1f01debd5657c4a383940b020b26507f6076334f91e2366c96e9ab279fb5158090352ea1c5b0c9274504f4f0e7053af24802e51e4568d164fe986834f41e55c8e850ce1f98458c0cfc9ab380b55285a55")
+	msg := make([]byte, 32)
+
+	ok, err := FastAggregateVerify([][]byte{pk}, msg, sig)
+	assert.NoError(t, err)
+	assert.True(t, ok, "valid signature")
+
+	sk, _ := new(big.Int).SetString("263dbd792f5b1be47ed85f8938c0f29586af0d3ac7b977f21c278fe1462040e3", 16)
+	gotPk, gotSig := sign(sk, msg)
+	assert.Equal(t, pk, gotPk, "public key")
+	assert.Equal(t, sig, gotSig, "signature")
+}
+
+func TestAggregateVerify(t *testing.T) {
+	g2 := bls.NewG2()
+	sks := []*big.Int{big.NewInt(11), big.NewInt(2222), big.NewInt(333333)}
+	msgs := [][]byte{[]byte("a"), []byte("b"), []byte("c")}
+	var pks [][]byte
+	agg := g2.Zero()
+	for i, sk := range sks {
+		pk, sig := sign(sk, msgs[i])
+		pks = append(pks, pk)
+		s, err := g2.FromCompressed(sig)
+		assert.NoError(t, err)
+		g2.Add(agg, agg, s)
+	}
+	ok, err := AggregateVerify(pks, msgs, g2.ToCompressed(agg))
+	assert.NoError(t, err)
+	assert.True(t, ok, "valid aggregate")
+
+	ok, err = AggregateVerify(pks, [][]byte{[]byte("a"), []byte("b"), []byte("d")}, g2.ToCompressed(agg))
+	assert.NoError(t, err)
+	assert.False(t, ok, "wrong message")
+
+	_, err = AggregateVerify(pks[:2], msgs, g2.ToCompressed(agg))
+	assert.Equal(t, ErrInvalidInput, err)
+
+	// the same message
+	msg := []byte("same")
+	agg = g2.Zero()
+	pks = nil
+	for _, sk := range sks {
+		pk, sig := sign(sk, msg)
+		pks = append(pks, pk)
+		s, _ := g2.FromCompressed(sig)
+		g2.Add(agg, agg, s)
+	}
+	ok, err = FastAggregateVerify(pks, msg, g2.ToCompressed(agg))
+	assert.NoError(t, err)
+	assert.True(t, ok, "valid fast aggregate")
+
+	ok, err = FastAggregateVerify(pks[:2], msg, g2.ToCompressed(agg))
+	assert.NoError(t, err)
+	assert.False(t, ok, "missing signer")
+
+	_, err = FastAggregateVerify(pks, msg, []byte{0x80})
+	assert.Error(t, err, "malformed signature")
+
+	// the point at infinity is not a public key
+	inf := make([]byte, 48)
+	inf[0] = 0xc0
+	_, err = FastAggregateVerify([][]byte{inf}, msg, g2.ToCompressed(agg))
+	assert.Equal(t, errPointAtInfinity, err)
+}
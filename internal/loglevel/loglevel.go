@@ -0,0 +1,192 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+// Package loglevel keeps loggers of modules and changes their levels in runtime, without restarting server.
+//
+// Loggers must be created by NewLogger of this package instead of log.NewLogger to be controlled. The initial level
+// follows the log configuration file as before. The level of a module is kept in an atomic variable and checked by
+// every log event, so loggers are never replaced while other goroutines use them. IsDebugEnabled() of the returned
+// Logger follows the current level, but that of the embedded log.Logger, which is handed to components, keeps
+// returning the configured level.
+package loglevel
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/rs/zerolog"
+)
+
+var (
+	ErrUnknownModule = errors.New("unknown log module")
+	ErrInvalidLevel  = errors.New("invalid log level")
+)
+
+// ModuleLevel is the current log level of a module
+type ModuleLevel struct {
+	Module     string
+	Level      string
+	Configured string
+	// RevertAt is the time when the level will be reverted, or zero time if no revert is scheduled.
+	RevertAt time.Time
+}
+
+// Logger is a log.Logger whose level is changed in runtime.
+type Logger struct {
+	*log.Logger
+	m *module
+}
+
+// IsDebugEnabled reports whether debug logs are printed at the current level.
+func (l *Logger) IsDebugEnabled() bool {
+	return l.m.level() <= zerolog.DebugLevel
+}
+
+// Level returns the current level name.
+func (l *Logger) Level() string {
+	return levelString(l.m.level())
+}
+
+// GetLevel returns the current level.
+func (l *Logger) GetLevel() zerolog.Level {
+	return l.m.level()
+}
+
+// levelSampler drops events below the current level of the module. zerolog consults the sampler before creating an
+// event, so it works as an atomic level check of the logger.
+type levelSampler struct {
+	m *module
+}
+
+func (s levelSampler) Sample(lvl zerolog.Level) bool {
+	return lvl >= s.m.level()
+}
+
+type module struct {
+	name       string
+	configured zerolog.Level
+	// current is the level in zerolog.Level, accessed atomically since loggers read it without mutex.
+	current int32
+
+	revertTimer *time.Timer
+	revertTo    zerolog.Level
+	revertAt    time.Time
+}
+
+var (
+	mutex   sync.Mutex
+	modules = make(map[string]*module)
+)
+
+// NewLogger creates a logger of the module in the same way of log.NewLogger, and registers it to change the level
+// in runtime. Loggers with same module name are changed together.
+func NewLogger(name string) *Logger {
+	logger := log.NewLogger(name)
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	m, exist := modules[name]
+	if !exist {
+		configured := logger.GetLevel()
+		m = &module{name: name, configured: configured, current: int32(configured)}
+		modules[name] = m
+	}
+	// the level of zerolog logger is opened once before the logger is shared, and the sampler does the actual check.
+	base := logger.Logger.Level(zerolog.TraceLevel).Sample(levelSampler{m})
+	logger.Logger = &base
+	return &Logger{Logger: logger, m: m}
+}
+
+// SetLevel changes the level of all loggers of the module. Empty level means the configured level. If revertAfter
+// is positive, the level is reverted to the previous one after that duration.
+func SetLevel(name string, level string, revertAfter time.Duration) error {
+	mutex.Lock()
+	defer mutex.Unlock()
+	m, exist := modules[name]
+	if !exist {
+		return ErrUnknownModule
+	}
+	lvl := m.configured
+	if len(level) > 0 {
+		var err error
+		if lvl, err = parseLevel(level); err != nil {
+			return err
+		}
+	}
+
+	prev := m.level()
+	if m.revertTimer != nil {
+		// the level before temporary change is the one to revert
+		m.revertTimer.Stop()
+		m.revertTimer = nil
+		prev = m.revertTo
+		m.revertAt = time.Time{}
+	}
+	m.setLevel(lvl)
+	if revertAfter > 0 {
+		var timer *time.Timer
+		timer = time.AfterFunc(revertAfter, func() {
+			mutex.Lock()
+			defer mutex.Unlock()
+			// check if the revert is canceled in the meantime
+			if m.revertTimer != timer {
+				return
+			}
+			m.revertTimer = nil
+			m.revertAt = time.Time{}
+			m.setLevel(m.revertTo)
+		})
+		m.revertTimer = timer
+		m.revertTo = prev
+		m.revertAt = time.Now().Add(revertAfter)
+	}
+	return nil
+}
+
+// Levels returns current levels of all registered modules, sorted by module name.
+func Levels() []ModuleLevel {
+	mutex.Lock()
+	defer mutex.Unlock()
+	levels := make([]ModuleLevel, 0, len(modules))
+	for _, m := range modules {
+		levels = append(levels, ModuleLevel{Module: m.name, Level: levelString(m.level()), Configured: levelString(m.configured), RevertAt: m.revertAt})
+	}
+	sort.Slice(levels, func(i, j int) bool {
+		return levels[i].Module < levels[j].Module
+	})
+	return levels
+}
+
+func (m *module) level() zerolog.Level {
+	return zerolog.Level(atomic.LoadInt32(&m.current))
+}
+
+// setLevel must be called with mutex held.
+func (m *module) setLevel(lvl zerolog.Level) {
+	atomic.StoreInt32(&m.current, int32(lvl))
+}
+
+func parseLevel(level string) (zerolog.Level, error) {
+	if level == "disabled" {
+		return zerolog.Disabled, nil
+	}
+	lvl, err := zerolog.ParseLevel(level)
+	if err != nil || lvl == zerolog.NoLevel {
+		return zerolog.NoLevel, fmt.Errorf("%s: %s", ErrInvalidLevel.Error(), level)
+	}
+	return lvl, nil
+}
+
+func levelString(lvl zerolog.Level) string {
+	if lvl == zerolog.Disabled {
+		return "disabled"
+	}
+	return lvl.String()
+}
@@ -0,0 +1,117 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package loglevel
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSetLevel(t *testing.T) {
+	logger := NewLogger("loglevel.test")
+	other := NewLogger("loglevel.test")
+	configured := logger.GetLevel().String()
+
+	tests := []struct {
+		name   string
+		module string
+		level  string
+
+		wantErr   bool
+		wantDebug bool
+		wantError bool
+	}{
+		{"TDebug", "loglevel.test", "debug", false, true, true},
+		{"TError", "loglevel.test", "error", false, false, true},
+		{"TDisabled", "loglevel.test", "disabled", false, false, false},
+		{"TWrongLevel", "loglevel.test", "verbose", true, false, false},
+		{"TUnknownModule", "no.such.module", "debug", true, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer SetLevel("loglevel.test", "", 0)
+			err := SetLevel(tt.module, tt.level, 0)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SetLevel() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := logger.Debug().Enabled(); got != tt.wantDebug {
+				t.Errorf("debug enabled = %v, want %v", got, tt.wantDebug)
+			}
+			if got := logger.IsDebugEnabled(); got != tt.wantDebug {
+				t.Errorf("IsDebugEnabled() = %v, want %v", got, tt.wantDebug)
+			}
+			if got := logger.Error().Enabled(); got != tt.wantError {
+				t.Errorf("error enabled = %v, want %v", got, tt.wantError)
+			}
+			// all loggers of the module are changed together
+			if got := other.Debug().Enabled(); got != tt.wantDebug {
+				t.Errorf("debug enabled of other logger = %v, want %v", got, tt.wantDebug)
+			}
+			// logger created after the change also follows current level
+			if got := NewLogger("loglevel.test").Debug().Enabled(); got != tt.wantDebug {
+				t.Errorf("debug enabled of new logger = %v, want %v", got, tt.wantDebug)
+			}
+		})
+	}
+	if got := logger.GetLevel().String(); got != configured {
+		t.Errorf("level after reset = %v, want %v", got, configured)
+	}
+}
+
+func TestSetLevel_Revert(t *testing.T) {
+	logger := NewLogger("loglevel.revert")
+	if err := SetLevel("loglevel.revert", "warn", 0); err != nil {
+		t.Fatalf("SetLevel() error = %v", err)
+	}
+	if err := SetLevel("loglevel.revert", "debug", time.Millisecond*50); err != nil {
+		t.Fatalf("SetLevel() error = %v", err)
+	}
+	// changing level again while revert is scheduled keeps the level to revert
+	if err := SetLevel("loglevel.revert", "info", time.Millisecond*50); err != nil {
+		t.Fatalf("SetLevel() error = %v", err)
+	}
+	level := findLevel("loglevel.revert")
+	if level.Level != "info" || level.RevertAt.IsZero() {
+		t.Errorf("Levels() = %v, want info with scheduled revert", level)
+	}
+
+	time.Sleep(time.Millisecond * 200)
+	level = findLevel("loglevel.revert")
+	if level.Level != "warn" || !level.RevertAt.IsZero() {
+		t.Errorf("Levels() after revert = %v, want warn without scheduled revert", level)
+	}
+	if logger.Info().Enabled() {
+		t.Errorf("info log is enabled after revert to warn")
+	}
+}
+
+func TestSetLevel_Concurrent(t *testing.T) {
+	logger := NewLogger("loglevel.concurrent")
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 1000; i++ {
+			logger.Debug().Int("i", i).Msg("concurrent")
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		SetLevel("loglevel.concurrent", "debug", 0)
+		SetLevel("loglevel.concurrent", "", 0)
+	}
+	<-done
+}
+
+func findLevel(name string) ModuleLevel {
+	for _, l := range Levels() {
+		if l.Module == name {
+			return l
+		}
+	}
+	return ModuleLevel{}
+}
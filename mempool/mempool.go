@@ -19,7 +19,6 @@ import (
 
 	"github.com/aergoio/aergo-actor/actor"
 	"github.com/aergoio/aergo-actor/router"
-	"github.com/aergoio/aergo-lib/log"
 	"github.com/aergoio/aergo/account/key"
 	"github.com/aergoio/aergo/chain"
 	cfg "github.com/aergoio/aergo/config"
@@ -29,6 +28,7 @@ import (
 	"github.com/aergoio/aergo/fee"
 	"github.com/aergoio/aergo/internal/common"
 	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/internal/loglevel"
 	"github.com/aergoio/aergo/message"
 	"github.com/aergoio/aergo/pkg/component"
 	"github.com/aergoio/aergo/state"
@@ -102,7 +102,7 @@ func NewMemPoolService(cfg *cfg.Config, cs *chain.ChainService) *MemPool {
 		verifier: nil,
 		quit:     make(chan bool),
 	}
-	actor.BaseComponent = component.NewBaseComponent(message.MemPoolSvc, actor, log.NewLogger("mempool"))
+	actor.BaseComponent = component.NewBaseComponent(message.MemPoolSvc, actor, loglevel.NewLogger("mempool").Logger)
 	if cfg.Mempool.EnableFadeout == false {
 		evictPeriod = 0
 	} else if cfg.Mempool.FadeoutPeriod > 0 {
@@ -270,6 +270,9 @@ func (mp *MemPool) Receive(context actor.Context) {
 
 		txs := mp.existEx(bucketHash)
 		context.Respond(&message.MemPoolExistExRsp{Txs: txs})
+	case *message.MemPoolExistShort:
+		txs := mp.existShort(msg.BlockHash, msg.ShortIDs)
+		context.Respond(&message.MemPoolExistExRsp{Txs: txs})
 
 	case *message.MemPoolSetWhitelist:
 		mp.whitelist.SetWhitelist(msg.Accounts)
@@ -545,7 +548,7 @@ func (mp *MemPool) removeOnBlockArrival(block *types.Block) error {
 
 // signiture verification
 func (mp *MemPool) verifyTx(tx types.Transaction) error {
-	err := tx.Validate(mp.acceptChainIdHash, mp.isPublic)
+	err := tx.Validate(mp.acceptChainIdHash, mp.isPublic, mp.nextBlockVersion())
 	if err != nil {
 		return err
 	}
@@ -597,9 +600,9 @@ func (mp *MemPool) getNameDest(account []byte, owner bool) []byte {
 		return nil
 	}
 	if owner {
-		return name.GetOwner(scs, account)
+		return name.GetOwner(scs, account, mp.bestBlockInfo.No+1)
 	}
-	return name.GetAddress(scs, account)
+	return name.GetAddress(scs, account, mp.bestBlockInfo.No+1)
 }
 
 func (mp *MemPool) nextBlockVersion() int32 {
@@ -629,7 +632,7 @@ func (mp *MemPool) validateTx(tx types.Transaction, account types.Address) error
 
 	switch tx.GetBody().GetType() {
 	case types.TxType_REDEPLOY:
-		if chain.IsPublic() {
+		if chain.IsPublic() && mp.nextBlockVersion() < 3 {
 			return types.ErrTxInvalidType
 		}
 		if tx.GetBody().GetRecipient() == nil {
@@ -689,7 +692,11 @@ func (mp *MemPool) validateTx(tx types.Transaction, account types.Address) error
 			if err != nil {
 				return err
 			}
-			if _, err := name.ValidateNameTx(tx.GetBody(), sender, scs, systemcs); err != nil {
+			nextBlockInfo := types.BlockHeaderInfo{
+				No:      mp.bestBlockInfo.No + 1,
+				Version: mp.nextBlockVersion(),
+			}
+			if _, err := name.ValidateNameTx(tx.GetBody(), sender, scs, systemcs, &nextBlockInfo); err != nil {
 				return err
 			}
 		case types.AergoEnterprise:
@@ -771,6 +778,38 @@ func (mp *MemPool) existEx(hashes []types.TxHash) []*types.Tx {
 	return ret
 }
 
+// existShort finds txs by short ids salted by the block hash. Ambiguous ids, which are duplicated in the request or
+// shared by txs in mempool, are treated as missing.
+func (mp *MemPool) existShort(blockHash []byte, shortIDs [][]byte) []*types.Tx {
+	found := make(map[string]*types.Tx, len(shortIDs))
+	ambiguous := make(map[string]bool)
+	for _, id := range shortIDs {
+		if _, exist := found[string(id)]; exist {
+			ambiguous[string(id)] = true
+		}
+		found[string(id)] = nil
+	}
+	mp.cache.Range(func(_, v interface{}) bool {
+		tx := v.(types.Transaction).GetTx()
+		id := string(types.ShortTxID(blockHash, tx.GetHash()))
+		if prev, requested := found[id]; requested {
+			if prev != nil {
+				ambiguous[id] = true
+			}
+			found[id] = tx
+		}
+		return true
+	})
+
+	ret := make([]*types.Tx, len(shortIDs))
+	for i, id := range shortIDs {
+		if !ambiguous[string(id)] {
+			ret[i] = found[string(id)]
+		}
+	}
+	return ret
+}
+
 func (mp *MemPool) acquireMemPoolList(acc []byte) (*txList, error) {
 	list := mp.getMemPoolList(acc)
 	if list != nil {
@@ -744,6 +744,36 @@ func TestMemPool_listHash(t *testing.T) {
 	}
 }
 
+func TestMemPool_existShort(t *testing.T) {
+	initTest(t)
+	defer deinitTest()
+
+	txs := make([]types.Transaction, 0)
+	for i := 0; i < 5; i++ {
+		txs = append(txs, genTx(0, 0, uint64(i+1), uint64(i+1)))
+	}
+	errs := pool.puts(txs...)
+	for i := 0; i < len(errs); i++ {
+		assert.NoError(t, errs[i], "%dth tx failed", i)
+	}
+
+	blockHash := []byte("block")
+	ids := make([][]byte, len(txs))
+	for i, tx := range txs {
+		ids[i] = types.ShortTxID(blockHash, tx.GetHash())
+	}
+	unknown := types.ShortTxID(blockHash, []byte("unknown"))
+
+	got := pool.existShort(blockHash, append(ids, unknown))
+	for i, tx := range txs {
+		assert.Equal(t, tx.GetTx(), got[i], "%dth tx", i)
+	}
+	assert.Nil(t, got[len(txs)], "unknown tx")
+
+	got = pool.existShort(blockHash, [][]byte{ids[0], ids[1], ids[0]})
+	assert.Equal(t, []*types.Tx{nil, txs[1].GetTx(), nil}, got, "duplicated ids")
+}
+
 type accTxs struct {
 	acc []byte
 	txs []types.Transaction
@@ -88,6 +88,50 @@ type GetABIRsp struct {
 	Err error
 }
 
+type GetCodeHistory struct {
+	Contract []byte
+}
+type GetCodeHistoryRsp struct {
+	History *types.CodeHistory
+	Err     error
+}
+
+type VerifyContractSource struct {
+	Contract []byte
+	Source   string
+}
+type VerifyContractSourceRsp struct {
+	Source *types.ContractSource
+	Err    error
+}
+
+type GetContractSource struct {
+	Contract []byte
+}
+type GetContractSourceRsp struct {
+	Source *types.ContractSource
+	Err    error
+}
+
+type GetContractDBStats struct {
+	Contract []byte
+}
+type GetContractDBStatsRsp struct {
+	Stats *types.ContractDBStats
+	Err   error
+}
+
+type QueryContractSQL struct {
+	Contract []byte
+	Query    string
+	BlockNo  types.BlockNo
+	Limit    uint32
+}
+type QueryContractSQLRsp struct {
+	Result *types.SQLQueryResult
+	Err    error
+}
+
 type GetQuery struct {
 	Contract  []byte
 	Queryinfo []byte
@@ -134,6 +178,14 @@ type GetAccountVoteRsp struct {
 	Err  error
 }
 
+// ListProposals is request to get the proposals created by the stakers
+type ListProposals struct{}
+
+type ListProposalsRsp struct {
+	Proposals *types.ProposalList
+	Err       error
+}
+
 type GetStaking struct {
 	Addr []byte
 }
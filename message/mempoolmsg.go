@@ -66,6 +66,13 @@ type MemPoolExistExRsp struct {
 	Txs []*types.Tx
 }
 
+// MemPoolExistShort is for retrieving txs of a compact block by their short ids. It is responded by MemPoolExistExRsp,
+// whose element is nil if no tx or more than one tx have the short id.
+type MemPoolExistShort struct {
+	BlockHash []byte
+	ShortIDs  [][]byte
+}
+
 type MemPoolSetWhitelist struct {
 	Accounts []string
 }
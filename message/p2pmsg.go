@@ -134,6 +134,8 @@ type PeerInfo struct {
 	LastBlockNumber uint64
 	State           types.PeerState
 	Self            bool
+	// Reputation is the penalty score of remote peer. higher is worse
+	Reputation int
 }
 
 // GetPeersRsp contains peer meta information and current states.
@@ -144,6 +146,46 @@ type GetPeersRsp struct {
 type GetMetrics struct {
 }
 
+// PenaltyReason is the kind of misbehavior of remote peer
+type PenaltyReason int
+
+const (
+	// PenaltyFailResponse is for the response with failure status
+	PenaltyFailResponse PenaltyReason = iota
+	// PenaltyInvalidResponse is for the malformed or unexpected response
+	PenaltyInvalidResponse
+	// PenaltyTimeout is for the request that was not answered in time
+	PenaltyTimeout
+	// PenaltyInvalidBlock is for the block that is rejected by chain
+	PenaltyInvalidBlock
+	// PenaltyRateExceeded is for the peer which persistently sends messages more than the rate limits
+	PenaltyRateExceeded
+)
+
+func (r PenaltyReason) String() string {
+	switch r {
+	case PenaltyFailResponse:
+		return "FailResponse"
+	case PenaltyInvalidResponse:
+		return "InvalidResponse"
+	case PenaltyTimeout:
+		return "Timeout"
+	case PenaltyInvalidBlock:
+		return "InvalidBlock"
+	case PenaltyRateExceeded:
+		return "RateExceeded"
+	default:
+		return "Unknown"
+	}
+}
+
+// PenalizePeer is sent to p2p actor to add penalty to the remote peer. The peer is
+// disconnected and banned for a while if its penalty score exceeds the threshold.
+type PenalizePeer struct {
+	ToWhom types.PeerID
+	Reason PenaltyReason
+}
+
 // GetSyncAncestor is sent from Syncer, send types.GetAncestorRequest to dest peer.
 type GetSyncAncestor struct {
 	Seq    uint64
@@ -210,11 +252,57 @@ type RaftClusterEvent struct {
 }
 
 // ChangeDesignatedPeers will trigger connect or disconnect peers
+// The actor returns *P2PAdminRsp
 type ChangeDesignatedPeers struct {
 	Add    []types.PeerAddress
 	Remove []types.PeerID
 }
 
+// DisconnectPeer requests p2p actor to close the connection to the peer. Designated peer will be reconnected after cool time.
+// The actor returns *P2PAdminRsp
+type DisconnectPeer struct {
+	ToWhom types.PeerID
+}
+
+// ChangeBlacklist requests p2p actor to add or remove entries of local blacklist. Entries are in the same format of
+// whitelist entry, and connected peers matched to added entries are disconnected.
+// The actor returns *P2PAdminRsp
+type ChangeBlacklist struct {
+	Add    []string
+	Remove []string
+}
+
+// GetBlacklist requests p2p actor to get entries of local blacklist.
+// The actor returns *GetBlacklistRsp
+type GetBlacklist struct {
+}
+
+type GetBlacklistRsp struct {
+	Entries []string
+}
+
+// GetWaitingPeers requests p2p actor to get peers which are waiting for (re)connection.
+// The actor returns *GetWaitingPeersRsp
+type GetWaitingPeers struct {
+}
+
+type WaitingPeerInfo struct {
+	Addr       *types.PeerAddress
+	Designated bool
+	TrialCnt   int
+	NextTrial  time.Time
+	LastResult error
+}
+
+type GetWaitingPeersRsp struct {
+	Peers []*WaitingPeerInfo
+}
+
+// P2PAdminRsp is the result of admin requests which change the state of p2p actor.
+type P2PAdminRsp struct {
+	Err error
+}
+
 type SendRaft struct {
 	ToWhom types.PeerID
 	Body   interface{} // for avoiding dependency cycle, though it must be raftpb.Message.
@@ -308,3 +308,73 @@ func (p2ps *P2P) TossBPNotice(msg message.TossBPNotice) bool {
 	p2ps.Debug().Int("skipCnt", skipped).Int("sendCnt", sent).Str("Target", targetZone.String()).Str(p2putil.LogMsgID, orgMsg.ID().String()).Msg("Tossing block produced notice")
 	return true
 }
+
+// ChangeDesignatedPeers adds or removes designated peers in runtime. Added peers are connected immediately, but
+// removed peers are not disconnected.
+func (p2ps *P2P) ChangeDesignatedPeers(context actor.Context, msg *message.ChangeDesignatedPeers) {
+	metas := make([]p2pcommon.PeerMeta, 0, len(msg.Add))
+	for i := range msg.Add {
+		meta := p2pcommon.FromPeerAddress(&msg.Add[i])
+		if len(meta.ID) == 0 || len(meta.Addresses) == 0 {
+			context.Respond(&message.P2PAdminRsp{Err: fmt.Errorf("invalid peer address %v", msg.Add[i].Addresses)})
+			return
+		}
+		if meta.ID == p2ps.SelfNodeID() {
+			context.Respond(&message.P2PAdminRsp{Err: fmt.Errorf("self peer can not be designated")})
+			return
+		}
+		metas = append(metas, meta)
+	}
+	for _, meta := range metas {
+		p2ps.Info().Str(p2putil.LogPeerName, p2putil.ShortMetaForm(meta)).Msg("adding designated peer by admin")
+		p2ps.pm.AddDesignatedPeer(meta)
+		p2ps.pm.AddNewPeer(meta)
+	}
+	for _, pid := range msg.Remove {
+		p2ps.Info().Str(p2putil.LogPeerID, p2putil.ShortForm(pid)).Msg("removing designated peer by admin")
+		p2ps.pm.RemoveDesignatedPeer(pid)
+	}
+	context.Respond(&message.P2PAdminRsp{})
+}
+
+// DisconnectPeer closes connection to the peer.
+func (p2ps *P2P) DisconnectPeer(context actor.Context, msg *message.DisconnectPeer) {
+	remotePeer, exists := p2ps.pm.GetPeer(msg.ToWhom)
+	if !exists {
+		context.Respond(&message.P2PAdminRsp{Err: message.PeerNotFoundError})
+		return
+	}
+	p2ps.Info().Str(p2putil.LogPeerName, remotePeer.Name()).Msg("disconnecting peer by admin")
+	remotePeer.Stop()
+	context.Respond(&message.P2PAdminRsp{})
+}
+
+// ChangeBlacklist adds or removes entries of local blacklist, and disconnects connected peers which are newly banned.
+func (p2ps *P2P) ChangeBlacklist(context actor.Context, msg *message.ChangeBlacklist) {
+	if err := p2ps.lm.ChangeBlacklist(msg.Add, msg.Remove); err != nil {
+		context.Respond(&message.P2PAdminRsp{Err: fmt.Errorf("failed to change blacklist: %s", err.Error())})
+		return
+	}
+	if len(msg.Add) > 0 {
+		p2ps.Info().Array("entries", p2putil.NewLogStringsMarshaller(msg.Add, 10)).Msg("blacklist entries are added by admin")
+		for _, peer := range p2ps.pm.GetPeers() {
+			ip := peer.RemoteInfo().Connection.IP
+			if p2ps.lm.Blacklisted(ip.String(), peer.ID()) {
+				p2ps.Info().Str(p2putil.LogPeerName, peer.Name()).Msg("peer is in blacklist")
+				peer.Stop()
+			}
+		}
+	}
+	context.Respond(&message.P2PAdminRsp{})
+}
+
+// GetWaitingPeers returns peers which are waiting for (re)connection
+func (p2ps *P2P) GetWaitingPeers(context actor.Context, msg *message.GetWaitingPeers) {
+	wps := p2ps.pm.GetWaitingPeers()
+	peers := make([]*message.WaitingPeerInfo, len(wps))
+	for i, wp := range wps {
+		addr := wp.Meta.ToPeerAddress()
+		peers[i] = &message.WaitingPeerInfo{Addr: &addr, Designated: wp.Designated, TrialCnt: wp.TrialCnt, NextTrial: wp.NextTrial, LastResult: wp.LastResult}
+	}
+	context.Respond(&message.GetWaitingPeersRsp{Peers: peers})
+}
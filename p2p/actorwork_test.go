@@ -6,9 +6,11 @@
 package p2p
 
 import (
+	"errors"
 	"github.com/aergoio/aergo/p2p/p2pcommon"
 	"github.com/aergoio/aergo/types"
 	"github.com/aergoio/etcd/raft/raftpb"
+	"net"
 	"testing"
 	"time"
 
@@ -250,4 +252,56 @@ func TestP2P_NotifyBlockProduced(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+func TestP2P_ChangeBlacklist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	entry := `{"address":"192.168.1.13"}`
+	tests := []struct {
+		name      string
+		add       []string
+		remove    []string
+		changeErr error
+
+		blacklisted bool
+		wantErr     bool
+	}{
+		{"TAdd", []string{entry}, nil, nil, false, false},
+		{"TAddAndDisconnect", []string{entry}, nil, nil, true, false},
+		{"TAddFail", []string{entry}, nil, types.InvalidEntryErr, false, true},
+		{"TRemove", nil, []string{entry}, nil, false, false},
+		{"TRemoveNotExist", nil, []string{entry}, errors.New("entry is not in blacklist"), false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtx := p2pmock.NewMockContext(ctrl)
+			mockPM := p2pmock.NewMockPeerManager(ctrl)
+			mockLM := p2pmock.NewMockListManager(ctrl)
+			mockPeer := p2pmock.NewMockRemotePeer(ctrl)
+			mockPeer.EXPECT().ID().Return(samplePeerID).AnyTimes()
+			mockPeer.EXPECT().Name().Return("16..aadecf@1").AnyTimes()
+			mockPeer.EXPECT().RemoteInfo().Return(p2pcommon.RemoteInfo{Connection: p2pcommon.RemoteConn{IP: net.ParseIP("192.168.1.13")}}).AnyTimes()
+
+			mockLM.EXPECT().ChangeBlacklist(tt.add, tt.remove).Return(tt.changeErr).Times(1)
+			if len(tt.add) > 0 && tt.changeErr == nil {
+				mockPM.EXPECT().GetPeers().Return([]p2pcommon.RemotePeer{mockPeer})
+				mockLM.EXPECT().Blacklisted("192.168.1.13", samplePeerID).Return(tt.blacklisted)
+			}
+			stopCnt := 0
+			if tt.blacklisted {
+				stopCnt = 1
+			}
+			mockPeer.EXPECT().Stop().Times(stopCnt)
+			mockCtx.EXPECT().Respond(gomock.Any()).Do(func(rsp interface{}) {
+				if err := rsp.(*message.P2PAdminRsp).Err; (err != nil) != tt.wantErr {
+					t.Errorf("ChangeBlacklist() err %v, wantErr %v", err, tt.wantErr)
+				}
+			})
+
+			ps := &P2P{pm: mockPM, lm: mockLM}
+			ps.BaseComponent = component.NewBaseComponent(message.P2PSvc, ps, log.NewLogger("p2p"))
+			ps.ChangeBlacklist(mockCtx, &message.ChangeBlacklist{Add: tt.add, Remove: tt.remove})
+		})
+	}
+}
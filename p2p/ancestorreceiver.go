@@ -48,9 +48,21 @@ func (br *AncestorReceiver) ReceiveResp(msg p2pcommon.Message, msgBody p2pcommon
 		br.peer.ConsumeRequest(br.requestID)
 		return
 	}
+	// remote peer response malformed data
+	data, ok := msgBody.(*types.GetAncestorResponse)
+	if !ok {
+		penalizePeer(br.actor, br.peer.ID(), message.PenaltyInvalidResponse)
+		br.actor.TellRequest(message.SyncerSvc, &message.GetSyncAncestorRsp{Seq:br.syncerSeq, Ancestor: nil})
+		br.finished = true
+		br.peer.ConsumeRequest(br.requestID)
+		return
+	}
 	// remote peer response failure
-	data := msgBody.(*types.GetAncestorResponse)
 	if data.Status != types.ResultStatus_OK {
+		// not found is not a misbehavior, the peer just has no common ancestor
+		if data.Status != types.ResultStatus_NOT_FOUND {
+			penalizePeer(br.actor, br.peer.ID(), message.PenaltyFailResponse)
+		}
 		br.actor.TellRequest(message.SyncerSvc, &message.GetSyncAncestorRsp{Seq:br.syncerSeq, Ancestor: nil})
 		br.finished = true
 		br.peer.ConsumeRequest(br.requestID)
@@ -102,6 +102,10 @@ func TestAncestorReceiver_ReceiveResp(t *testing.T) {
 			//mockContext.On("Respond",mock.AnythingOfType("*message.GetBlockChunksRsp"))
 			mockMF := p2pmock.NewMockMoFactory(ctrl)
 			mockPeer := p2pmock.NewMockRemotePeer(ctrl)
+			if test.sentResp > 0 && test.rspStatus != types.ResultStatus_OK && test.rspStatus != types.ResultStatus_NOT_FOUND {
+				mockPeer.EXPECT().ID().Return(dummyPeerID)
+				mockActor.EXPECT().TellRequest(message.P2PSvc, &message.PenalizePeer{ToWhom: dummyPeerID, Reason: message.PenaltyFailResponse})
+			}
 			//	mockPeer.On("ID").Return(dummyPeerID)
 			mockPeer.EXPECT().MF().Return(mockMF)
 			mockMo := createDummyMo(ctrl)
@@ -143,6 +143,7 @@ func (br *BlocksChunkReceiver) cancelReceiving(err error, hasNext bool) {
 	br.status = receiverStatusCanceled
 	br.actor.TellRequest(message.SyncerSvc,
 		&message.GetBlockChunksRsp{Seq: br.syncerSeq, ToWhom: br.peer.ID(), Err: err})
+	penalizePeer(br.actor, br.peer.ID(), penaltyReasonOf(err))
 
 	// check time again. since negative duration of timer will not fire channel.
 	interval := br.timeout.Sub(time.Now())
@@ -111,6 +111,11 @@ func TestBlocksChunkReceiver_ReceiveResp(t *testing.T) {
 						t.Fatalf("Wrong seqNo %d, want %d)\n", arg.Seq, seqNo)
 					}
 				}).Times(1)
+			penalties := 0
+			if test.respError {
+				penalties = 1
+			}
+			mockActor.EXPECT().TellRequest(message.P2PSvc, gomock.AssignableToTypeOf(&message.PenalizePeer{})).Times(penalties)
 
 			mockMF := p2pmock.NewMockMoFactory(ctrl)
 			mockMo := createDummyMo(ctrl)
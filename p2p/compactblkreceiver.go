@@ -0,0 +1,239 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2p
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/chain"
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/p2putil"
+	"github.com/aergoio/aergo/types"
+)
+
+// compactBlockTTL is the time limit to reconstruct a block, including the time for fetching missing txs.
+const compactBlockTTL = time.Second * 4
+
+var (
+	errCompactBlockTimeout = errors.New("compact block timeout")
+	// errTxsRootNotMatch can be caused by a short id collision in local mempool, so the remote peer is not blamed.
+	errTxsRootNotMatch = errors.New("txs root of reconstructed block not matched")
+)
+
+// CompactBlockReceiver gets the header and short tx ids of a new block from remote peer, and reconstructs the block
+// with txs in local mempool. Only txs missing in mempool are requested to remote peer. The reconstructed txs are
+// verified by the txs root in the header, and it falls back to fetching the whole block if the block cannot be
+// reconstructed in time or correctly.
+type CompactBlockReceiver struct {
+	peer      p2pcommon.RemotePeer
+	actor     p2pcommon.ActorService
+	logger    *log.Logger
+	msgHelper message.Helper
+
+	blockHash []byte
+	ttl       time.Duration
+	timeout   time.Time
+	timer     *time.Timer
+
+	header   *types.BlockHeader
+	shortIDs [][]byte
+
+	// mutex guards the fields below, which are changed by the mempool query go routine or the timer.
+	mutex    sync.Mutex
+	finished bool
+	txs      []*types.Tx
+	// missing is indexes of txs which are not found in local mempool
+	missing []uint32
+}
+
+func NewCompactBlockReceiver(actor p2pcommon.ActorService, peer p2pcommon.RemotePeer, blockHash []byte, ttl time.Duration, logger *log.Logger) *CompactBlockReceiver {
+	timeout := time.Now().Add(ttl)
+	return &CompactBlockReceiver{actor: actor, peer: peer, logger: logger, msgHelper: message.GetHelper(), blockHash: blockHash, ttl: ttl, timeout: timeout}
+}
+
+func (br *CompactBlockReceiver) StartGet() {
+	// fall back if the remote peer doesn't respond in time
+	br.mutex.Lock()
+	br.timer = time.AfterFunc(br.ttl, func() { br.fallback(errCompactBlockTimeout) })
+	br.mutex.Unlock()
+	req := &types.GetCompactBlockRequest{BlockHash: br.blockHash}
+	mo := br.peer.MF().NewMsgRequestOrderWithReceiver(br.ReceiveResp, p2pcommon.GetCompactBlockRequest, req)
+	br.peer.SendMessage(mo)
+}
+
+// ReceiveResp must be called just in read go routine
+func (br *CompactBlockReceiver) ReceiveResp(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) (ret bool) {
+	ret = true
+	// the receiver sends more than one request, so the request of this response is consumed instead of the latest one
+	br.peer.ConsumeRequest(msg.OriginalID())
+	if br.isFinished() {
+		// silently ignore already finished job, which has already fallen back if it was timed out.
+		return
+	}
+	if br.timeout.Before(time.Now()) {
+		br.fallback(errCompactBlockTimeout)
+		return
+	}
+	switch body := msgBody.(type) {
+	case *types.GetCompactBlockResponse:
+		br.handleCompactBlock(body)
+	case *types.GetBlockTxsResponse:
+		br.handleBlockTxs(body)
+	default:
+		br.fallback(message.UnexpectedBlockError)
+	}
+	return
+}
+
+func (br *CompactBlockReceiver) handleCompactBlock(body *types.GetCompactBlockResponse) {
+	if body.Status != types.ResultStatus_OK {
+		br.fallback(message.RemotePeerFailError)
+		return
+	}
+	if br.header != nil {
+		// compact block is already received
+		br.fallback(message.UnexpectedBlockError)
+		return
+	}
+	header := body.Header
+	if header == nil || !bytes.Equal((&types.Block{Header: header}).BlockHash(), br.blockHash) {
+		br.fallback(message.WrongBlockHashError)
+		return
+	}
+	for _, id := range body.ShortTxIDs {
+		if len(id) != types.ShortTxIDLength {
+			br.fallback(message.UnexpectedBlockError)
+			return
+		}
+	}
+	br.header = header
+	br.shortIDs = body.ShortTxIDs
+	// querying mempool can take some time, so it must not block read go routine
+	go br.reconstruct()
+}
+
+// reconstruct fills txs of block from local mempool, and requests remote peer to send remaining txs.
+func (br *CompactBlockReceiver) reconstruct() {
+	txs := make([]*types.Tx, len(br.shortIDs))
+	result, err := br.actor.CallRequestDefaultTimeout(message.MemPoolSvc, &message.MemPoolExistShort{BlockHash: br.blockHash, ShortIDs: br.shortIDs})
+	found, err := br.msgHelper.ExtractTxsFromResponseAndError(result, err)
+	if err != nil {
+		// all txs will be fetched from remote peer
+		br.logger.Debug().Err(err).Msg("failed to get txs from mempool")
+	} else {
+		copy(txs, found)
+	}
+	var missing []uint32
+	for i, tx := range txs {
+		if tx == nil {
+			missing = append(missing, uint32(i))
+		}
+	}
+
+	br.mutex.Lock()
+	if br.finished {
+		// timed out while querying mempool
+		br.mutex.Unlock()
+		return
+	}
+	br.txs, br.missing = txs, missing
+	br.mutex.Unlock()
+	if len(missing) == 0 {
+		br.complete(txs)
+		return
+	}
+
+	br.logger.Debug().Str(p2putil.LogPeerName, br.peer.Name()).Str(p2putil.LogBlkHash, enc.ToString(br.blockHash)).Int("missing", len(missing)).Int("total", len(txs)).Msg("requesting txs missing in mempool")
+	req := &types.GetBlockTxsRequest{BlockHash: br.blockHash, Indexes: missing}
+	mo := br.peer.MF().NewMsgRequestOrderWithReceiver(br.ReceiveResp, p2pcommon.GetBlockTxsRequest, req)
+	br.peer.SendMessage(mo)
+}
+
+func (br *CompactBlockReceiver) handleBlockTxs(body *types.GetBlockTxsResponse) {
+	br.mutex.Lock()
+	txs, missing := br.txs, br.missing
+	br.mutex.Unlock()
+	if txs == nil {
+		// txs response before the request is not expected
+		br.fallback(message.UnexpectedBlockError)
+		return
+	}
+	if body.Status != types.ResultStatus_OK {
+		br.fallback(message.RemotePeerFailError)
+		return
+	}
+	if len(body.Txs) != len(missing) {
+		br.fallback(message.UnexpectedBlockError)
+		return
+	}
+	for i, tx := range body.Txs {
+		hash := tx.CalculateTxHash()
+		if !bytes.Equal(tx.GetHash(), hash) || !bytes.Equal(types.ShortTxID(br.blockHash, hash), br.shortIDs[missing[i]]) {
+			br.fallback(message.UnexpectedBlockError)
+			return
+		}
+		txs[missing[i]] = tx
+	}
+	br.complete(txs)
+}
+
+// complete verifies reconstructed txs and sends the block to chainservice
+func (br *CompactBlockReceiver) complete(txs []*types.Tx) {
+	if !bytes.Equal(types.CalculateTxsRootHash(txs), br.header.GetTxsRootHash()) {
+		br.fallback(errTxsRootNotMatch)
+		return
+	}
+	if !br.finish() {
+		return
+	}
+	block := &types.Block{Hash: br.blockHash, Header: br.header, Body: &types.BlockBody{Txs: txs}}
+	// check if block size is over the limit
+	if block.Size() > int(chain.MaxBlockSize()) {
+		br.logger.Info().Str(p2putil.LogPeerName, br.peer.Name()).Str(p2putil.LogBlkHash, block.BlockID().String()).Int("size", block.Size()).Msg("cancel to add block. block size exceed limit")
+		return
+	}
+	br.actor.SendRequest(message.ChainSvc, &message.AddBlock{PeerID: br.peer.ID(), Block: block, Bstate: nil})
+}
+
+// fallback gives up reconstruction and requests the whole block to remote peer
+func (br *CompactBlockReceiver) fallback(err error) {
+	if !br.finish() {
+		return
+	}
+	br.logger.Debug().Err(err).Str(p2putil.LogPeerName, br.peer.Name()).Str(p2putil.LogBlkHash, enc.ToString(br.blockHash)).Msg("failed to reconstruct compact block. fetching whole block")
+	if err != message.RemotePeerFailError && err != errCompactBlockTimeout && err != errTxsRootNotMatch {
+		penalizePeer(br.actor, br.peer.ID(), message.PenaltyInvalidResponse)
+	}
+	br.actor.SendRequest(message.P2PSvc, &message.GetBlockInfos{ToWhom: br.peer.ID(),
+		Hashes: []message.BlockHash{message.BlockHash(br.blockHash)}})
+}
+
+func (br *CompactBlockReceiver) isFinished() bool {
+	br.mutex.Lock()
+	defer br.mutex.Unlock()
+	return br.finished
+}
+
+// finish marks the job finished, and reports whether it was not finished before. The reconstruction can be
+// finished by the read go routine, the mempool query or the timer, whichever comes first.
+func (br *CompactBlockReceiver) finish() bool {
+	br.mutex.Lock()
+	defer br.mutex.Unlock()
+	if br.finished {
+		return false
+	}
+	br.finished = true
+	if br.timer != nil {
+		br.timer.Stop()
+	}
+	return true
+}
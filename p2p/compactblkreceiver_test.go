@@ -0,0 +1,293 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2p
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/chain"
+	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/p2pmock"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/mock/gomock"
+)
+
+func createCompactTestBlock(txCnt int) *types.Block {
+	txs := make([]*types.Tx, txCnt)
+	for i := range txs {
+		txs[i] = &types.Tx{Body: &types.TxBody{Nonce: uint64(i + 1), Account: dummyBlockHash}}
+		txs[i].Hash = txs[i].CalculateTxHash()
+	}
+	block := &types.Block{Header: &types.BlockHeader{BlockNo: 100, TxsRootHash: types.CalculateTxsRootHash(txs)}, Body: &types.BlockBody{Txs: txs}}
+	block.BlockHash()
+	return block
+}
+
+func shortIDsOf(block *types.Block) [][]byte {
+	ids := make([][]byte, len(block.Body.Txs))
+	for i, tx := range block.Body.Txs {
+		ids[i] = types.ShortTxID(block.Hash, tx.Hash)
+	}
+	return ids
+}
+
+func TestCompactBlockReceiver_handleCompactBlock(t *testing.T) {
+	logger := log.NewLogger("test.p2p")
+	sampleBlock := createCompactTestBlock(5)
+	otherBlock := createCompactTestBlock(4)
+
+	wrongIDs := shortIDsOf(sampleBlock)
+	wrongIDs[1] = wrongIDs[1][1:]
+
+	tests := []struct {
+		name   string
+		status types.ResultStatus
+		header *types.BlockHeader
+		ids    [][]byte
+
+		wantFallback bool
+		wantPenalty  bool
+	}{
+		{"TSucc", types.ResultStatus_OK, sampleBlock.Header, shortIDsOf(sampleBlock), false, false},
+		{"TRemoteFail", types.ResultStatus_NOT_FOUND, nil, nil, true, false},
+		{"TNoHeader", types.ResultStatus_OK, nil, shortIDsOf(sampleBlock), true, true},
+		{"TWrongHeader", types.ResultStatus_OK, otherBlock.Header, shortIDsOf(otherBlock), true, true},
+		{"TWrongShortIDs", types.ResultStatus_OK, sampleBlock.Header, wrongIDs, true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockActor := p2pmock.NewMockActorService(ctrl)
+			mockPeer := p2pmock.NewMockRemotePeer(ctrl)
+			mockPeer.EXPECT().Name().Return("16..aadecf@1").AnyTimes()
+			mockPeer.EXPECT().ID().Return(sampleMeta.ID).AnyTimes()
+			mockPeer.EXPECT().ConsumeRequest(gomock.Any()).Times(1)
+
+			fallbackCnt, penaltyCnt := 0, 0
+			if tt.wantFallback {
+				fallbackCnt = 1
+			}
+			if tt.wantPenalty {
+				penaltyCnt = 1
+			}
+			mockActor.EXPECT().SendRequest(message.P2PSvc, gomock.AssignableToTypeOf(&message.GetBlockInfos{})).Times(fallbackCnt)
+			mockActor.EXPECT().TellRequest(message.P2PSvc, gomock.AssignableToTypeOf(&message.PenalizePeer{})).Times(penaltyCnt)
+			done := make(chan interface{}, 1)
+			if !tt.wantFallback {
+				// all txs are found in mempool
+				mockActor.EXPECT().CallRequestDefaultTimeout(message.MemPoolSvc, gomock.Any()).Return(&message.MemPoolExistExRsp{Txs: sampleBlock.Body.Txs}, nil)
+				mockActor.EXPECT().SendRequest(message.ChainSvc, gomock.Any()).Do(func(a string, arg interface{}) {
+					done <- arg
+				})
+			}
+
+			br := NewCompactBlockReceiver(mockActor, mockPeer, sampleBlock.Hash, time.Minute, logger)
+			msg := p2pcommon.NewSimpleMsgVal(p2pcommon.GetCompactBlockResponse, sampleMsgID)
+			br.ReceiveResp(msg, &types.GetCompactBlockResponse{Status: tt.status, Header: tt.header, ShortTxIDs: tt.ids})
+
+			if !tt.wantFallback {
+				select {
+				case <-done:
+				case <-time.After(time.Second):
+					t.Fatalf("block was not reconstructed")
+				}
+			} else if !br.finished {
+				t.Errorf("receiver is not finished after fallback")
+			}
+		})
+	}
+}
+
+func TestCompactBlockReceiver_reconstruct(t *testing.T) {
+	chain.Init(1024*1024, "", false, 0, 0)
+	logger := log.NewLogger("test.p2p")
+	sampleBlock := createCompactTestBlock(5)
+	allTxs := sampleBlock.Body.Txs
+	someTxs := []*types.Tx{allTxs[0], nil, allTxs[2], nil, allTxs[4]}
+	// a tx in mempool whose short id collides with a tx in the block
+	collided := []*types.Tx{allTxs[0], allTxs[2], allTxs[2], allTxs[3], allTxs[4]}
+
+	tests := []struct {
+		name         string
+		mempool      []*types.Tx
+		mpErr        error
+		wantReq      []uint32
+		wantDone     bool
+		wantFallback bool
+	}{
+		{"TAllFound", allTxs, nil, nil, true, false},
+		{"TSomeMissing", someTxs, nil, []uint32{1, 3}, false, false},
+		{"TMempoolFail", nil, errors.New("timeout"), []uint32{0, 1, 2, 3, 4}, false, false},
+		{"TCollision", collided, nil, nil, true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockActor := p2pmock.NewMockActorService(ctrl)
+			mockPeer := p2pmock.NewMockRemotePeer(ctrl)
+			mockPeer.EXPECT().Name().Return("16..aadecf@1").AnyTimes()
+			mockPeer.EXPECT().ID().Return(sampleMeta.ID).AnyTimes()
+			if tt.mpErr != nil {
+				mockActor.EXPECT().CallRequestDefaultTimeout(message.MemPoolSvc, gomock.Any()).Return(nil, tt.mpErr)
+			} else {
+				mockActor.EXPECT().CallRequestDefaultTimeout(message.MemPoolSvc, gomock.Any()).Return(&message.MemPoolExistExRsp{Txs: tt.mempool}, nil)
+			}
+			if tt.wantFallback {
+				// falls back to the whole block without penalty
+				mockActor.EXPECT().SendRequest(message.P2PSvc, gomock.AssignableToTypeOf(&message.GetBlockInfos{})).Times(1)
+				mockActor.EXPECT().TellRequest(message.P2PSvc, gomock.Any()).Times(0)
+			} else if tt.wantDone {
+				mockActor.EXPECT().SendRequest(message.ChainSvc, gomock.Any()).Do(func(a string, arg *message.AddBlock) {
+					if !reflect.DeepEqual(arg.Block.Body.Txs, allTxs) {
+						t.Errorf("reconstructed txs differ")
+					}
+				})
+			} else {
+				mockMF := p2pmock.NewMockMoFactory(ctrl)
+				mockMo := createDummyMo(ctrl)
+				mockPeer.EXPECT().MF().Return(mockMF)
+				mockMF.EXPECT().NewMsgRequestOrderWithReceiver(gomock.Any(), p2pcommon.GetBlockTxsRequest, gomock.Any()).DoAndReturn(func(r p2pcommon.ResponseReceiver, p p2pcommon.SubProtocol, req *types.GetBlockTxsRequest) p2pcommon.MsgOrder {
+					if !reflect.DeepEqual(req.Indexes, tt.wantReq) {
+						t.Errorf("requested indexes %v, want %v", req.Indexes, tt.wantReq)
+					}
+					return mockMo
+				})
+				mockPeer.EXPECT().SendMessage(mockMo)
+			}
+
+			br := NewCompactBlockReceiver(mockActor, mockPeer, sampleBlock.Hash, time.Minute, logger)
+			br.header = sampleBlock.Header
+			br.shortIDs = shortIDsOf(sampleBlock)
+			br.reconstruct()
+
+			if br.finished != tt.wantDone {
+				t.Errorf("finished %v, want %v", br.finished, tt.wantDone)
+			}
+		})
+	}
+}
+
+func TestCompactBlockReceiver_handleBlockTxs(t *testing.T) {
+	chain.Init(1024*1024, "", false, 0, 0)
+	logger := log.NewLogger("test.p2p")
+	sampleBlock := createCompactTestBlock(5)
+	allTxs := sampleBlock.Body.Txs
+	tamperedTx := &types.Tx{Hash: allTxs[3].Hash, Body: &types.TxBody{Nonce: 999, Account: dummyBlockHash}}
+
+	tests := []struct {
+		name   string
+		status types.ResultStatus
+		txs    []*types.Tx
+
+		wantAdd     bool
+		wantPenalty bool
+	}{
+		{"TSucc", types.ResultStatus_OK, []*types.Tx{allTxs[1], allTxs[3]}, true, false},
+		{"TRemoteFail", types.ResultStatus_NOT_FOUND, nil, false, false},
+		{"TTooFew", types.ResultStatus_OK, []*types.Tx{allTxs[1]}, false, true},
+		{"TWrongOrder", types.ResultStatus_OK, []*types.Tx{allTxs[3], allTxs[1]}, false, true},
+		{"TTampered", types.ResultStatus_OK, []*types.Tx{allTxs[1], tamperedTx}, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockActor := p2pmock.NewMockActorService(ctrl)
+			mockPeer := p2pmock.NewMockRemotePeer(ctrl)
+			mockPeer.EXPECT().Name().Return("16..aadecf@1").AnyTimes()
+			mockPeer.EXPECT().ID().Return(sampleMeta.ID).AnyTimes()
+			mockPeer.EXPECT().ConsumeRequest(gomock.Any()).Times(1)
+			if tt.wantAdd {
+				mockActor.EXPECT().SendRequest(message.ChainSvc, gomock.AssignableToTypeOf(&message.AddBlock{})).Times(1)
+			} else {
+				mockActor.EXPECT().SendRequest(message.P2PSvc, gomock.AssignableToTypeOf(&message.GetBlockInfos{})).Times(1)
+			}
+			penaltyCnt := 0
+			if tt.wantPenalty {
+				penaltyCnt = 1
+			}
+			mockActor.EXPECT().TellRequest(message.P2PSvc, gomock.AssignableToTypeOf(&message.PenalizePeer{})).Times(penaltyCnt)
+
+			br := NewCompactBlockReceiver(mockActor, mockPeer, sampleBlock.Hash, time.Minute, logger)
+			br.header = sampleBlock.Header
+			br.shortIDs = shortIDsOf(sampleBlock)
+			br.txs = []*types.Tx{allTxs[0], nil, allTxs[2], nil, allTxs[4]}
+			br.missing = []uint32{1, 3}
+
+			msg := p2pcommon.NewSimpleMsgVal(p2pcommon.GetBlockTxsResponse, sampleMsgID)
+			br.ReceiveResp(msg, &types.GetBlockTxsResponse{Status: tt.status, Txs: tt.txs})
+			if !br.finished {
+				t.Errorf("receiver is not finished")
+			}
+		})
+	}
+}
+
+func TestCompactBlockReceiver_timeout(t *testing.T) {
+	logger := log.NewLogger("test.p2p")
+	sampleBlock := createCompactTestBlock(5)
+
+	tests := []struct {
+		name     string
+		startGet bool
+	}{
+		{"TNoResponse", true},
+		{"TLateResponse", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockActor := p2pmock.NewMockActorService(ctrl)
+			mockPeer := p2pmock.NewMockRemotePeer(ctrl)
+			mockPeer.EXPECT().Name().Return("16..aadecf@1").AnyTimes()
+			mockPeer.EXPECT().ID().Return(sampleMeta.ID).AnyTimes()
+			mockPeer.EXPECT().ConsumeRequest(gomock.Any()).Times(1)
+			// falls back without penalty only once
+			done := make(chan interface{}, 1)
+			mockActor.EXPECT().SendRequest(message.P2PSvc, gomock.AssignableToTypeOf(&message.GetBlockInfos{})).Do(func(a string, arg interface{}) {
+				done <- arg
+			}).Times(1)
+			mockActor.EXPECT().TellRequest(message.P2PSvc, gomock.Any()).Times(0)
+
+			var br *CompactBlockReceiver
+			if tt.startGet {
+				mockMF := p2pmock.NewMockMoFactory(ctrl)
+				mockMo := createDummyMo(ctrl)
+				mockPeer.EXPECT().MF().Return(mockMF)
+				mockMF.EXPECT().NewMsgRequestOrderWithReceiver(gomock.Any(), p2pcommon.GetCompactBlockRequest, gomock.Any()).Return(mockMo)
+				mockPeer.EXPECT().SendMessage(mockMo)
+
+				br = NewCompactBlockReceiver(mockActor, mockPeer, sampleBlock.Hash, time.Millisecond*10, logger)
+				br.StartGet()
+				select {
+				case <-done:
+				case <-time.After(time.Second):
+					t.Fatalf("receiver did not fall back")
+				}
+			} else {
+				br = NewCompactBlockReceiver(mockActor, mockPeer, sampleBlock.Hash, -time.Second, logger)
+			}
+
+			msg := p2pcommon.NewSimpleMsgVal(p2pcommon.GetCompactBlockResponse, sampleMsgID)
+			br.ReceiveResp(msg, &types.GetCompactBlockResponse{Status: types.ResultStatus_OK, Header: sampleBlock.Header, ShortTxIDs: shortIDsOf(sampleBlock)})
+			if !br.isFinished() {
+				t.Errorf("receiver is not finished")
+			}
+		})
+	}
+}
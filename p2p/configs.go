@@ -44,6 +44,8 @@ const (
 	DefaultPeerTxCacheSize   = 10000
 	// DefaultPeerTxQueueSize is maximum size of hashes in a single tx notice message
 	DefaultPeerTxQueueSize = 2000
+	// DefaultPeerTxNoticeBatchSize is the number of hashes that makes tx notice be sent without waiting txNoticeInterval
+	DefaultPeerTxNoticeBatchSize = 500
 	// value to sent to cache, since block and tx cache need only hash itself (stored as key of map)
 	cachePlaceHolder = true
 )
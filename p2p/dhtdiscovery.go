@@ -0,0 +1,159 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2p
+
+import (
+	"context"
+	"crypto/sha256"
+	"sync/atomic"
+	"time"
+
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/p2putil"
+	"github.com/aergoio/aergo/types"
+	"github.com/libp2p/go-libp2p-core/peer"
+	discovery "github.com/libp2p/go-libp2p-discovery"
+	dht "github.com/libp2p/go-libp2p-kad-dht"
+	dhtopts "github.com/libp2p/go-libp2p-kad-dht/opts"
+	"github.com/multiformats/go-multiaddr"
+)
+
+const (
+	// dhtProtocolID is different from the one of ipfs, so aergo nodes make their own DHT and never join the public one.
+	dhtProtocolID = "/aergo/kad/1.0.0"
+
+	dhtConnectTimeout = time.Second * 10
+	dhtFindTimeout    = time.Second * 30
+)
+
+// dhtDiscovery finds peers of the same chain by Kademlia DHT, so nodes can discover each other with only a few
+// bootstrap peers and without polaris. Each node advertises itself under the rendezvous namespace made from chain id,
+// and looks up other nodes which advertise the same namespace. Found peers are sent to peerManager in the same way as
+// the result of polaris query.
+type dhtDiscovery struct {
+	logger     *log.Logger
+	nt         p2pcommon.NetworkTransport
+	pm         p2pcommon.PeerManager
+	selfID     types.PeerID
+	ns         string
+	bootstraps []p2pcommon.PeerMeta
+	expose     bool
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	kad    *dht.IpfsDHT
+	rd     *discovery.RoutingDiscovery
+
+	// finding is 1 while lookup is in progress
+	finding int32
+}
+
+func newDHTDiscovery(logger *log.Logger, nt p2pcommon.NetworkTransport, pm p2pcommon.PeerManager, selfID types.PeerID, chainID []byte, bootstraps []p2pcommon.PeerMeta, expose bool) *dhtDiscovery {
+	return &dhtDiscovery{logger: logger, nt: nt, pm: pm, selfID: selfID, ns: dhtNamespace(chainID), bootstraps: bootstraps, expose: expose}
+}
+
+// dhtNamespace returns rendezvous namespace of the chain. Nodes of different chains are never found each other even
+// if they are in the same DHT.
+func dhtNamespace(chainID []byte) string {
+	h := sha256.Sum256(chainID)
+	return "/aergo/chain/" + enc.ToString(h[:])
+}
+
+// Start joins DHT. It must be called after network transport is started.
+func (d *dhtDiscovery) Start() error {
+	d.ctx, d.cancel = context.WithCancel(context.Background())
+	kad, err := dht.New(d.ctx, d.nt, dhtopts.Protocols(dhtProtocolID))
+	if err != nil {
+		d.cancel()
+		return err
+	}
+	d.kad = kad
+	d.rd = discovery.NewRoutingDiscovery(kad)
+	d.logger.Info().Str("namespace", d.ns).Int("bootstraps", len(d.bootstraps)).Msg("Starting DHT peer discovery")
+	go d.bootstrap()
+	return nil
+}
+
+func (d *dhtDiscovery) Stop() {
+	if d.kad == nil {
+		return
+	}
+	d.cancel()
+	if err := d.kad.Close(); err != nil {
+		d.logger.Warn().Err(err).Msg("Error on closing DHT")
+	}
+}
+
+func (d *dhtDiscovery) bootstrap() {
+	connected := 0
+	for _, meta := range d.bootstraps {
+		ctx, cancel := context.WithTimeout(d.ctx, dhtConnectTimeout)
+		err := d.nt.Connect(ctx, peer.AddrInfo{ID: meta.ID, Addrs: meta.Addresses})
+		cancel()
+		if err != nil {
+			d.logger.Info().Err(err).Str(p2putil.LogPeerID, p2putil.ShortForm(meta.ID)).Msg("Failed to connect DHT bootstrap peer")
+			continue
+		}
+		connected++
+	}
+	if len(d.bootstraps) > 0 && connected == 0 {
+		d.logger.Warn().Msg("No DHT bootstrap peer is connected. Peers will be found only after other node connects to this node")
+	}
+	// routing table is refreshed periodically in background
+	if err := d.kad.Bootstrap(d.ctx); err != nil {
+		d.logger.Warn().Err(err).Msg("Failed to bootstrap DHT")
+	}
+	if d.expose {
+		discovery.Advertise(d.ctx, d.rd, d.ns)
+	}
+}
+
+// FindPeers looks up at most max peers in background. It does nothing if the previous lookup is not finished yet.
+func (d *dhtDiscovery) FindPeers(max int) {
+	if d.rd == nil || !atomic.CompareAndSwapInt32(&d.finding, 0, 1) {
+		return
+	}
+	go func() {
+		defer atomic.StoreInt32(&d.finding, 0)
+		ctx, cancel := context.WithTimeout(d.ctx, dhtFindTimeout)
+		defer cancel()
+		found, err := d.rd.FindPeers(ctx, d.ns, discovery.Limit(max))
+		if err != nil {
+			d.logger.Info().Err(err).Msg("Failed to find peers in DHT")
+			return
+		}
+		metas := make([]p2pcommon.PeerMeta, 0, max)
+		for ai := range found {
+			if meta, ok := d.toPeerMeta(ai); ok {
+				metas = append(metas, meta)
+			}
+		}
+		d.logger.Debug().Int("found", len(metas)).Msg("found peers in DHT")
+		if len(metas) > 0 {
+			d.pm.NotifyPeerAddressReceived(metas)
+		}
+	}()
+}
+
+// toPeerMeta converts address info found in DHT to PeerMeta. Only tcp addresses are available in aergo network.
+func (d *dhtDiscovery) toPeerMeta(ai peer.AddrInfo) (p2pcommon.PeerMeta, bool) {
+	if ai.ID == d.selfID {
+		return p2pcommon.PeerMeta{}, false
+	}
+	addrs := make([]types.Multiaddr, 0, len(ai.Addrs))
+	for _, addr := range ai.Addrs {
+		protos := addr.Protocols()
+		if len(protos) == 2 && types.IsAddress(protos[0]) && protos[1].Code == multiaddr.P_TCP {
+			addrs = append(addrs, addr)
+		}
+	}
+	if len(addrs) == 0 {
+		return p2pcommon.PeerMeta{}, false
+	}
+	return p2pcommon.PeerMeta{ID: ai.ID, Addresses: addrs}, true
+}
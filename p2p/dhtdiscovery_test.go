@@ -0,0 +1,62 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2p
+
+import (
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/libp2p/go-libp2p-core/peer"
+)
+
+func Test_dhtNamespace(t *testing.T) {
+	ns1 := dhtNamespace([]byte("chain1"))
+	if ns1 != dhtNamespace([]byte("chain1")) {
+		t.Errorf("dhtNamespace() is not deterministic")
+	}
+	if ns1 == dhtNamespace([]byte("chain2")) {
+		t.Errorf("dhtNamespace() of different chains are same %v", ns1)
+	}
+}
+
+func Test_dhtDiscovery_toPeerMeta(t *testing.T) {
+	selfID, otherID := types.RandomPeerID(), types.RandomPeerID()
+	d := &dhtDiscovery{selfID: selfID}
+	tests := []struct {
+		name  string
+		id    types.PeerID
+		addrs []string
+
+		wantOK    bool
+		wantAddrs int
+	}{
+		{"TSucc", otherID, []string{"/ip4/192.168.0.1/tcp/7846"}, true, 1},
+		{"TMulti", otherID, []string{"/ip4/192.168.0.1/tcp/7846", "/ip6/::1/tcp/7846", "/dns4/node.aergo.io/tcp/7846"}, true, 3},
+		{"TFilter", otherID, []string{"/ip4/192.168.0.1/udp/7846", "/ip4/192.168.0.1/tcp/7846"}, true, 1},
+		{"TNoTCP", otherID, []string{"/ip4/192.168.0.1/udp/7846/quic"}, false, 0},
+		{"TNoAddr", otherID, nil, false, 0},
+		{"TSelf", selfID, []string{"/ip4/192.168.0.1/tcp/7846"}, false, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ai := peer.AddrInfo{ID: tt.id}
+			for _, str := range tt.addrs {
+				addr, err := types.ParseMultiaddr(str)
+				if err != nil {
+					t.Fatalf("invalid test input %v: %v", str, err)
+				}
+				ai.Addrs = append(ai.Addrs, addr)
+			}
+			meta, ok := d.toPeerMeta(ai)
+			if ok != tt.wantOK {
+				t.Fatalf("toPeerMeta() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && (meta.ID != tt.id || len(meta.Addresses) != tt.wantAddrs) {
+				t.Errorf("toPeerMeta() = %v, want %v addresses", meta, tt.wantAddrs)
+			}
+		})
+	}
+}
@@ -177,6 +177,8 @@ func TestInboundWireHandshker_handleInboundPeer(t *testing.T) {
 			if !tt.wantErr {
 				if got == nil {
 					t.Errorf("InboundWireHandshaker.handleInboundPeer() got msgrw nil, want not")
+				} else if got.Version != tt.bestVer {
+					t.Errorf("InboundWireHandshaker.handleInboundPeer() got version %v, want %v", got.Version, tt.bestVer)
 				}
 			}
 		})
@@ -191,7 +193,7 @@ func TestOutboundWireHandshaker_handleOutboundPeer(t *testing.T) {
 	sampleResult := &p2pcommon.HandshakeResult{}
 	logger := log.NewLogger("p2p.test")
 	// This bytes is actually hard-coded in source handshake_v2.go.
-	outBytes := p2pcommon.HSHeadReq{p2pcommon.MAGICMain, []p2pcommon.P2PVersion{p2pcommon.P2PVersion200, p2pcommon.P2PVersion033, p2pcommon.P2PVersion032, p2pcommon.P2PVersion031}}.Marshal()
+	outBytes := p2pcommon.HSHeadReq{p2pcommon.MAGICMain, []p2pcommon.P2PVersion{p2pcommon.P2PVersion210, p2pcommon.P2PVersion200, p2pcommon.P2PVersion033, p2pcommon.P2PVersion032, p2pcommon.P2PVersion031}}.Marshal()
 
 	tests := []struct {
 		name string
@@ -256,6 +258,8 @@ func TestOutboundWireHandshaker_handleOutboundPeer(t *testing.T) {
 			if !tt.wantErr {
 				if got == nil {
 					t.Errorf("OutboundWireHandshaker.handleOutboundPeer() got msgrw nil, want not")
+				} else if got.Version != tt.remoteRespVer {
+					t.Errorf("OutboundWireHandshaker.handleOutboundPeer() got version %v, want %v", got.Version, tt.remoteRespVer)
 				}
 			}
 		})
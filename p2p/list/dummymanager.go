@@ -32,6 +32,25 @@ func (*dummyListManager) IsBanned(addr string, pid types.PeerID) (bool, time.Tim
 	return false, UndefinedTime
 }
 
+func (*dummyListManager) AddTempBan(pid types.PeerID, until time.Time) {
+}
+
+func (*dummyListManager) TempBanned(pid types.PeerID) (bool, time.Time) {
+	return false, UndefinedTime
+}
+
+func (*dummyListManager) ChangeBlacklist(add, remove []string) error {
+	return nil
+}
+
+func (*dummyListManager) ListBlacklist() []string {
+	return nil
+}
+
+func (*dummyListManager) Blacklisted(addr string, pid types.PeerID) bool {
+	return false
+}
+
 func (*dummyListManager) RefineList() {
 }
 
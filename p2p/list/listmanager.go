@@ -6,13 +6,18 @@
 package list
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
 	"github.com/aergoio/aergo-lib/log"
 	"github.com/aergoio/aergo/config"
 	"github.com/aergoio/aergo/contract/enterprise"
 	"github.com/aergoio/aergo/p2p/p2pcommon"
 	"github.com/aergoio/aergo/types"
+	"io/ioutil"
 	"net"
+	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 	"time"
@@ -40,6 +45,11 @@ type listManagerImpl struct {
 	rwLock  sync.RWMutex
 	authDir string
 
+	// tempBans are peers banned for a while, mainly by low reputation
+	tempBans map[types.PeerID]time.Time
+	// blacklist is set by administrator and saved in local file
+	blacklist []types.WhiteListEntry
+
 	stopScheduler chan interface{}
 }
 
@@ -51,6 +61,7 @@ func NewListManager(conf *config.AuthConfig, authDir string, chainAcc types.Chai
 		publicNet: publicNet,
 
 		authDir:       authDir,
+		tempBans:      make(map[types.PeerID]time.Time),
 		stopScheduler: make(chan interface{}),
 	}
 
@@ -61,6 +72,7 @@ func (lm *listManagerImpl) Start() {
 	lm.logger.Debug().Msg("starting up list manager")
 
 	lm.RefineList()
+	lm.loadBlacklist()
 }
 
 func (lm *listManagerImpl) Stop() {
@@ -68,6 +80,13 @@ func (lm *listManagerImpl) Stop() {
 }
 
 func (lm *listManagerImpl) IsBanned(addr string, pid types.PeerID) (bool, time.Time) {
+	// temporary ban precedes whitelist
+	if banned, until := lm.TempBanned(pid); banned {
+		return true, until
+	}
+	if lm.Blacklisted(addr, pid) {
+		return true, FarawayFuture
+	}
 	// empty entry is
 	if len(lm.entries) == 0 {
 		return false, FarawayFuture
@@ -93,6 +112,153 @@ func (lm *listManagerImpl) IsBanned(addr string, pid types.PeerID) (bool, time.T
 	return true, FarawayFuture
 }
 
+func (lm *listManagerImpl) AddTempBan(pid types.PeerID, until time.Time) {
+	lm.rwLock.Lock()
+	defer lm.rwLock.Unlock()
+	if prev, exist := lm.tempBans[pid]; exist && prev.After(until) {
+		return
+	}
+	lm.tempBans[pid] = until
+}
+
+func (lm *listManagerImpl) TempBanned(pid types.PeerID) (bool, time.Time) {
+	lm.rwLock.RLock()
+	until, exist := lm.tempBans[pid]
+	lm.rwLock.RUnlock()
+	if !exist {
+		return false, UndefinedTime
+	}
+	if !until.After(time.Now()) {
+		// ban expired
+		lm.rwLock.Lock()
+		if cur, exist := lm.tempBans[pid]; exist && cur.Equal(until) {
+			delete(lm.tempBans, pid)
+		}
+		lm.rwLock.Unlock()
+		return false, UndefinedTime
+	}
+	return true, until
+}
+
+func (lm *listManagerImpl) ChangeBlacklist(add, remove []string) error {
+	toAdd, err := parseEntries(add)
+	if err != nil {
+		return err
+	}
+	toRemove, err := parseEntries(remove)
+	if err != nil {
+		return err
+	}
+	lm.rwLock.Lock()
+	defer lm.rwLock.Unlock()
+	// changes are applied to a copy, so blacklist is not changed if any of them fails
+	changed := make([]types.WhiteListEntry, len(lm.blacklist), len(lm.blacklist)+len(toAdd))
+	copy(changed, lm.blacklist)
+	for _, ent := range toAdd {
+		if indexOfEntry(changed, ent) < 0 {
+			changed = append(changed, ent)
+		}
+	}
+	for i, ent := range toRemove {
+		idx := indexOfEntry(changed, ent)
+		if idx < 0 {
+			return fmt.Errorf("entry %s is not in blacklist", remove[i])
+		}
+		changed = append(changed[:idx], changed[idx+1:]...)
+	}
+	if err := lm.saveBlacklist(changed); err != nil {
+		return err
+	}
+	lm.blacklist = changed
+	return nil
+}
+
+func parseEntries(entries []string) ([]types.WhiteListEntry, error) {
+	parsed := make([]types.WhiteListEntry, len(entries))
+	for i, entry := range entries {
+		ent, err := types.ParseListEntry(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid blacklist entry %s: %s", entry, err.Error())
+		}
+		parsed[i] = ent
+	}
+	return parsed, nil
+}
+
+func (lm *listManagerImpl) ListBlacklist() []string {
+	lm.rwLock.RLock()
+	defer lm.rwLock.RUnlock()
+	entries := make([]string, len(lm.blacklist))
+	for i, e := range lm.blacklist {
+		entries[i] = e.String()
+	}
+	return entries
+}
+
+func (lm *listManagerImpl) Blacklisted(addr string, pid types.PeerID) bool {
+	ip := net.ParseIP(addr)
+	lm.rwLock.RLock()
+	defer lm.rwLock.RUnlock()
+	for _, ent := range lm.blacklist {
+		if ent.Contains(ip, pid) {
+			return true
+		}
+	}
+	return false
+}
+
+// sameEntry compares the ranges of entries, since literals of same entry can be different.
+func sameEntry(e1, e2 types.WhiteListEntry) bool {
+	return e1.PeerID == e2.PeerID && e1.IpNet.String() == e2.IpNet.String()
+}
+
+func indexOfEntry(entries []types.WhiteListEntry, ent types.WhiteListEntry) int {
+	for i, e := range entries {
+		if sameEntry(e, ent) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (lm *listManagerImpl) listFilePath() string {
+	return filepath.Join(lm.authDir, localListFile)
+}
+
+func (lm *listManagerImpl) loadBlacklist() {
+	if len(lm.authDir) == 0 {
+		return
+	}
+	data, err := ioutil.ReadFile(lm.listFilePath())
+	if err != nil {
+		if !os.IsNotExist(err) {
+			lm.logger.Warn().Err(err).Str("file", lm.listFilePath()).Msg("failed to read local blacklist")
+		}
+		return
+	}
+	entries, err := types.ReadEntries(data)
+	if err != nil {
+		lm.logger.Warn().Err(err).Str("file", lm.listFilePath()).Msg("invalid local blacklist file. blacklist is ignored")
+		return
+	}
+	lm.rwLock.Lock()
+	lm.blacklist = entries
+	lm.rwLock.Unlock()
+	lm.logger.Info().Int("entries", len(entries)).Msg("loaded local blacklist")
+}
+
+// saveBlacklist writes entries of blacklist to local file. It must be called with write lock.
+func (lm *listManagerImpl) saveBlacklist(entries []types.WhiteListEntry) error {
+	if len(lm.authDir) == 0 {
+		return nil
+	}
+	buf := &bytes.Buffer{}
+	if err := types.WriteEntries(entries, buf); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(lm.listFilePath(), buf.Bytes(), 0600)
+}
+
 func (lm *listManagerImpl) RefineList() {
 	if lm.publicNet {
 		lm.logger.Info().Msg("network is public, apply default policy instead (allow all)")
@@ -143,6 +309,10 @@ func (lm *listManagerImpl) Summary() map[string]interface{} {
 	}
 	sum["whitelist"] = entries
 	sum["whitelist_on"] = lm.enabled
+	lm.rwLock.RLock()
+	sum["tempbans"] = len(lm.tempBans)
+	sum["blacklist"] = len(lm.blacklist)
+	lm.rwLock.RUnlock()
 
 	return sum
 }
@@ -12,7 +12,11 @@ import (
 	"github.com/aergoio/aergo/p2p/p2pmock"
 	"github.com/aergoio/aergo/types"
 	"github.com/golang/mock/gomock"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 )
 
 func TestListManagerImpl_Start(t *testing.T) {
@@ -218,3 +222,184 @@ func Test_blacklistManagerImpl_IsBanned2(t *testing.T) {
 		})
 	}
 }
+
+func TestListManagerImpl_TempBan(t *testing.T) {
+	conf := config.NewServerContext("", "").GetDefaultAuthConfig()
+	logger := log.NewLogger("p2p.list.test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	addr := "192.168.1.13"
+	id1 := types.RandomPeerID()
+	id2 := types.RandomPeerID()
+
+	tests := []struct {
+		name string
+		on   bool
+		role types.PeerRole
+		ban  time.Duration
+
+		want bool
+	}{
+		{"TNoList", false, types.PeerRole_Watcher, time.Minute, true},
+		{"TExpired", false, types.PeerRole_Watcher, -time.Second, false},
+		{"TInList", true, types.PeerRole_Watcher, time.Minute, true},
+		{"TProducer", true, types.PeerRole_Producer, time.Minute, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ent := []string{`{"address":"` + addr + `"}`}
+			cfg := &types.EnterpriseConfig{Key: enterprise.P2PWhite, On: tt.on, Values: ent}
+			mockCA := p2pmock.NewMockChainAccessor(ctrl)
+			mockCA.EXPECT().GetEnterpriseConfig(enterprise.P2PWhite).Return(cfg, nil)
+			mockPRM := p2pmock.NewMockPeerRoleManager(ctrl)
+			mockPRM.EXPECT().GetRole(gomock.Any()).Return(tt.role).AnyTimes()
+
+			b := NewListManager(conf, "", mockCA, mockPRM, logger, false).(*listManagerImpl)
+			b.Start()
+			b.AddTempBan(id1, time.Now().Add(tt.ban))
+
+			if got, _ := b.TempBanned(id1); got != tt.want {
+				t.Errorf("listManagerImpl.TempBanned() = %v, want %v", got, tt.want)
+			}
+			if got, _ := b.IsBanned(addr, id1); got != tt.want {
+				t.Errorf("listManagerImpl.IsBanned() = %v, want %v", got, tt.want)
+			}
+			// other peer is not affected
+			if got, _ := b.TempBanned(id2); got {
+				t.Errorf("listManagerImpl.TempBanned() of other peer = %v, want %v", got, false)
+			}
+			if !tt.want && len(b.tempBans) > 0 {
+				t.Errorf("expired ban is not removed")
+			}
+		})
+	}
+}
+
+func TestListManagerImpl_Blacklist(t *testing.T) {
+	conf := config.NewServerContext("", "").GetDefaultAuthConfig()
+	logger := log.NewLogger("p2p.list.test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authDir, err := ioutil.TempDir("", "p2plist")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(authDir)
+
+	id1 := types.RandomPeerID()
+	id2 := types.RandomPeerID()
+	IDOnly := `{"peerid":"` + types.IDB58Encode(id1) + `"}`
+	AddrRange := `{"cidr":"122.1.3.4/24"}`
+	// same range with AddrRange, but different literal
+	AddrRange2 := `{"cidr":"122.1.3.0/24"}`
+
+	tests := []struct {
+		name   string
+		add    []string
+		remove []string
+
+		addr     string
+		pid      types.PeerID
+		wantSize int
+		want     bool
+	}{
+		{"TEmpty", nil, nil, "122.1.3.5", id1, 0, false},
+		{"TID", []string{IDOnly}, nil, "8.8.8.8", id1, 1, true},
+		{"TIDOther", []string{IDOnly}, nil, "8.8.8.8", id2, 1, false},
+		{"TRange", []string{IDOnly, AddrRange}, nil, "122.1.3.5", id2, 2, true},
+		{"TDuplicated", []string{AddrRange, AddrRange2}, nil, "122.1.3.5", id2, 1, true},
+		{"TRemoved", []string{IDOnly, AddrRange}, []string{AddrRange2}, "122.1.3.5", id2, 1, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			os.Remove(filepath.Join(authDir, localListFile))
+			cfg := &types.EnterpriseConfig{Key: enterprise.P2PWhite, On: false}
+			mockCA := p2pmock.NewMockChainAccessor(ctrl)
+			mockCA.EXPECT().GetEnterpriseConfig(enterprise.P2PWhite).Return(cfg, nil).Times(2)
+
+			b := NewListManager(conf, authDir, mockCA, nil, logger, false).(*listManagerImpl)
+			b.Start()
+			if err := b.ChangeBlacklist(tt.add, nil); err != nil {
+				t.Fatalf("ChangeBlacklist() add err %v", err)
+			}
+			if err := b.ChangeBlacklist(nil, tt.remove); err != nil {
+				t.Fatalf("ChangeBlacklist() remove err %v", err)
+			}
+			if got := b.Blacklisted(tt.addr, tt.pid); got != tt.want {
+				t.Errorf("listManagerImpl.Blacklisted() = %v, want %v", got, tt.want)
+			}
+			if got, _ := b.IsBanned(tt.addr, tt.pid); got != tt.want {
+				t.Errorf("listManagerImpl.IsBanned() = %v, want %v", got, tt.want)
+			}
+
+			// blacklist is loaded again after restart
+			b2 := NewListManager(conf, authDir, mockCA, nil, logger, false).(*listManagerImpl)
+			b2.Start()
+			if got := b2.ListBlacklist(); len(got) != tt.wantSize {
+				t.Errorf("listManagerImpl.ListBlacklist() = %v, want size %v", got, tt.wantSize)
+			}
+			if got := b2.Blacklisted(tt.addr, tt.pid); got != tt.want {
+				t.Errorf("listManagerImpl.Blacklisted() after restart = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	b := NewListManager(conf, "", nil, nil, logger, true)
+	if err := b.ChangeBlacklist([]string{"wrong entry"}, nil); err == nil {
+		t.Errorf("ChangeBlacklist() with invalid entry succeeded")
+	}
+}
+
+func TestListManagerImpl_ChangeBlacklistFail(t *testing.T) {
+	conf := config.NewServerContext("", "").GetDefaultAuthConfig()
+	logger := log.NewLogger("p2p.list.test")
+
+	authDir, err := ioutil.TempDir("", "p2plist")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(authDir)
+
+	IDOnly := `{"peerid":"` + types.IDB58Encode(types.RandomPeerID()) + `"}`
+	AddrRange := `{"cidr":"122.1.3.4/24"}`
+	Other := `{"address":"192.168.1.13"}`
+	tests := []struct {
+		name   string
+		add    []string
+		remove []string
+		noDir  bool
+	}{
+		{"TInvalidAdd", []string{Other, "wrong entry"}, nil, false},
+		{"TInvalidRemove", []string{Other}, []string{"wrong entry"}, false},
+		{"TRemoveNotExist", []string{Other}, []string{`{"address":"10.0.0.1"}`}, false},
+		{"TSaveFail", []string{Other}, []string{IDOnly}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			os.Remove(filepath.Join(authDir, localListFile))
+			b := NewListManager(conf, authDir, nil, nil, logger, false).(*listManagerImpl)
+			if err := b.ChangeBlacklist([]string{IDOnly, AddrRange}, nil); err != nil {
+				t.Fatalf("ChangeBlacklist() err %v", err)
+			}
+			if tt.noDir {
+				b.authDir = filepath.Join(authDir, "notexist")
+			}
+			if err := b.ChangeBlacklist(tt.add, tt.remove); err == nil {
+				t.Fatalf("ChangeBlacklist() succeeded, want error")
+			}
+			// nothing is changed in both memory and file
+			if got := b.ListBlacklist(); len(got) != 2 {
+				t.Errorf("ListBlacklist() = %v, want size 2", got)
+			}
+			b.authDir = authDir
+			b.loadBlacklist()
+			if got := b.ListBlacklist(); len(got) != 2 {
+				t.Errorf("ListBlacklist() after reload = %v, want size 2", got)
+			}
+		})
+	}
+}
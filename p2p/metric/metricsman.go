@@ -9,6 +9,8 @@ import (
 	"bytes"
 	"fmt"
 	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/internal/loglevel"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
 	"github.com/aergoio/aergo/p2p/p2putil"
 	"github.com/aergoio/aergo/types"
 	"sync"
@@ -43,12 +45,20 @@ type metricsManager struct {
 
 	deadTotalIn  int64
 	deadTotalOut int64
+
+	// compressed payload sizes of removed peers
+	deadRawIn, deadCompressedIn   int64
+	deadRawOut, deadCompressedOut int64
+
+	// throttled messages of removed peers
+	deadThrottled [p2pcommon.RateClassCount]int64
+	deadExceeded  int64
 }
 
 var _ MetricsManager = (*metricsManager)(nil)
 
 func NewMetricManager(interval int) *metricsManager {
-	mm := &metricsManager{logger: log.NewLogger("p2p"), metricsMap: make(map[types.PeerID]*PeerMetric), interval: interval, startTime: time.Now()}
+	mm := &metricsManager{logger: loglevel.NewLogger("p2p").Logger, metricsMap: make(map[types.PeerID]*PeerMetric), interval: interval, startTime: time.Now()}
 
 	return mm
 }
@@ -97,6 +107,17 @@ func (mm *metricsManager) Remove(pid types.PeerID, manNum uint32) *PeerMetric {
 		}
 		atomic.AddInt64(&mm.deadTotalIn, metric.totalIn)
 		atomic.AddInt64(&mm.deadTotalOut, metric.totalOut)
+		rawIn, compressedIn := metric.CompressedIn()
+		rawOut, compressedOut := metric.CompressedOut()
+		atomic.AddInt64(&mm.deadRawIn, rawIn)
+		atomic.AddInt64(&mm.deadCompressedIn, compressedIn)
+		atomic.AddInt64(&mm.deadRawOut, rawOut)
+		atomic.AddInt64(&mm.deadCompressedOut, compressedOut)
+		throttled, exceeded := metric.Throttled()
+		for i, cnt := range throttled {
+			atomic.AddInt64(&mm.deadThrottled[i], cnt)
+		}
+		atomic.AddInt64(&mm.deadExceeded, exceeded)
 		delete(mm.metricsMap, pid)
 		return metric
 	}
@@ -125,6 +146,13 @@ func (mm *metricsManager) Summary() map[string]interface{} {
 	sum := make(map[string]interface{})
 	sum["since"] = mm.startTime
 	var totalIn, totalOut int64
+	rawIn, compressedIn := atomic.LoadInt64(&mm.deadRawIn), atomic.LoadInt64(&mm.deadCompressedIn)
+	rawOut, compressedOut := atomic.LoadInt64(&mm.deadRawOut), atomic.LoadInt64(&mm.deadCompressedOut)
+	var throttled [p2pcommon.RateClassCount]int64
+	for i := range throttled {
+		throttled[i] = atomic.LoadInt64(&mm.deadThrottled[i])
+	}
+	exceeded := atomic.LoadInt64(&mm.deadExceeded)
 	if len(mm.Metrics()) > 0 {
 		var cnt = 0
 		//var inAps, inLoad, outAps, outLoad int64
@@ -132,12 +160,29 @@ func (mm *metricsManager) Summary() map[string]interface{} {
 			cnt++
 			totalIn += met.totalIn
 			totalOut += met.totalOut
+			r, c := met.CompressedIn()
+			rawIn, compressedIn = rawIn+r, compressedIn+c
+			r, c = met.CompressedOut()
+			rawOut, compressedOut = rawOut+r, compressedOut+c
+			t, e := met.Throttled()
+			for i, cnt := range t {
+				throttled[i] += cnt
+			}
+			exceeded += e
 		}
 	}
 	totalIn += atomic.LoadInt64(&mm.deadTotalIn)
 	totalOut += atomic.LoadInt64(&mm.deadTotalOut)
 	sum["in"] = totalIn
 	sum["out"] = totalOut
+	// raw is the size before compression, and compressed is the size actually transferred
+	sum["compression"] = map[string]int64{"in_raw": rawIn, "in_compressed": compressedIn, "out_raw": rawOut, "out_compressed": compressedOut}
+	// incoming messages delayed by rate limits. exceeded is the number of delays by limits of each peer
+	throttledSum := map[string]int64{"exceeded": exceeded}
+	for i, cnt := range throttled {
+		throttledSum[p2pcommon.RateClass(i).String()] = cnt
+	}
+	sum["throttled"] = throttledSum
 	return sum
 }
 
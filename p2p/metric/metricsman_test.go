@@ -79,3 +79,47 @@ func TestMetricsManager_Size(t *testing.T) {
 		})
 	}
 }
+
+func TestMetricsManager_Compression(t *testing.T) {
+	pid, _ := types.IDB58Decode("16Uiu2HAmFqptXPfcdaCdwipB2fhHATgKGVFVPehDAPZsDKSU7jRm")
+
+	mm := NewMetricManager(1)
+	peerMetric := mm.NewMetric(pid, 1)
+	peerMetric.OnCompressedWrite(p2pcommon.GetBlocksResponse, 4000, 1000)
+	peerMetric.OnCompressedRead(p2pcommon.GetBlocksResponse, 3000, 500)
+
+	summary := mm.Summary()["compression"].(map[string]int64)
+	assert.Equal(t, int64(3000), summary["in_raw"])
+	assert.Equal(t, int64(500), summary["in_compressed"])
+	assert.Equal(t, int64(4000), summary["out_raw"])
+	assert.Equal(t, int64(1000), summary["out_compressed"])
+
+	// totals of removed peer are kept
+	mm.Remove(pid, 1)
+	summary = mm.Summary()["compression"].(map[string]int64)
+	assert.Equal(t, int64(3000), summary["in_raw"])
+	assert.Equal(t, int64(1000), summary["out_compressed"])
+}
+
+func TestMetricsManager_Throttled(t *testing.T) {
+	pid, _ := types.IDB58Decode("16Uiu2HAmFqptXPfcdaCdwipB2fhHATgKGVFVPehDAPZsDKSU7jRm")
+
+	mm := NewMetricManager(1)
+	peerMetric := mm.NewMetric(pid, 1)
+	peerMetric.OnThrottled(p2pcommon.RateClassTx, time.Millisecond*100, true)
+	peerMetric.OnThrottled(p2pcommon.RateClassTx, time.Millisecond*100, false)
+	peerMetric.OnThrottled(p2pcommon.RateClassBlock, time.Millisecond*300, true)
+	assert.Equal(t, time.Millisecond*500, peerMetric.ThrottledWait())
+
+	summary := mm.Summary()["throttled"].(map[string]int64)
+	assert.Equal(t, int64(2), summary["tx"])
+	assert.Equal(t, int64(1), summary["block"])
+	assert.Equal(t, int64(0), summary["address"])
+	assert.Equal(t, int64(2), summary["exceeded"])
+
+	// counts of removed peer are kept
+	mm.Remove(pid, 1)
+	summary = mm.Summary()["throttled"].(map[string]int64)
+	assert.Equal(t, int64(2), summary["tx"])
+	assert.Equal(t, int64(2), summary["exceeded"])
+}
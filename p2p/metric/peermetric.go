@@ -21,11 +21,25 @@ type PeerMetric struct {
 	totalIn  int64
 	totalOut int64
 
+	// sizes of compressed payloads, before and after compression
+	rawIn         int64
+	compressedIn  int64
+	rawOut        int64
+	compressedOut int64
+
+	// incoming messages delayed by rate limits, by rate class
+	throttled [p2pcommon.RateClassCount]int64
+	// the number of delays caused by the limits of the peer itself, not by global limits
+	exceeded int64
+	// total delayed time in nanoseconds
+	throttledWait int64
+
 	InMetric  DataMetric
 	OutMetric DataMetric
 }
 
 var _ p2pcommon.MsgIOListener = (*PeerMetric)(nil)
+var _ p2pcommon.CompressionListener = (*PeerMetric)(nil)
 
 func (m *PeerMetric) OnRead(protocol p2pcommon.SubProtocol, read int) {
 	atomic.AddInt64(&m.totalIn, int64(read))
@@ -37,6 +51,42 @@ func (m *PeerMetric) OnWrite(protocol p2pcommon.SubProtocol, write int) {
 	m.OutMetric.AddBytes(write)
 }
 
+func (m *PeerMetric) OnCompressedRead(protocol p2pcommon.SubProtocol, raw, compressed int) {
+	atomic.AddInt64(&m.rawIn, int64(raw))
+	atomic.AddInt64(&m.compressedIn, int64(compressed))
+}
+
+func (m *PeerMetric) OnCompressedWrite(protocol p2pcommon.SubProtocol, raw, compressed int) {
+	atomic.AddInt64(&m.rawOut, int64(raw))
+	atomic.AddInt64(&m.compressedOut, int64(compressed))
+}
+
+// OnThrottled is called when reading of incoming message is delayed by rate limits. exceeded is true if the limit
+// of the peer itself is exceeded, and false if only the global limit is.
+func (m *PeerMetric) OnThrottled(class p2pcommon.RateClass, wait time.Duration, exceeded bool) {
+	if class >= 0 && class < p2pcommon.RateClassCount {
+		atomic.AddInt64(&m.throttled[class], 1)
+	}
+	atomic.AddInt64(&m.throttledWait, int64(wait))
+	if exceeded {
+		atomic.AddInt64(&m.exceeded, 1)
+	}
+}
+
+// Throttled returns the number of delayed messages by rate class, and the number of delays caused by the peer's own
+// limits.
+func (m *PeerMetric) Throttled() (byClass [p2pcommon.RateClassCount]int64, exceeded int64) {
+	for i := range byClass {
+		byClass[i] = atomic.LoadInt64(&m.throttled[i])
+	}
+	return byClass, atomic.LoadInt64(&m.exceeded)
+}
+
+// ThrottledWait returns total time by which reading of incoming messages was delayed.
+func (m *PeerMetric) ThrottledWait() time.Duration {
+	return time.Duration(atomic.LoadInt64(&m.throttledWait))
+}
+
 func (m *PeerMetric) TotalIn() int64 {
 	return atomic.LoadInt64(&m.totalIn)
 }
@@ -45,6 +95,16 @@ func (m *PeerMetric) TotalOut() int64 {
 	return atomic.LoadInt64(&m.totalOut)
 }
 
+// CompressedIn returns total sizes of received payloads which were compressed, before and after decompression.
+func (m *PeerMetric) CompressedIn() (raw int64, compressed int64) {
+	return atomic.LoadInt64(&m.rawIn), atomic.LoadInt64(&m.compressedIn)
+}
+
+// CompressedOut returns total sizes of sent payloads which were compressed, before and after compression.
+func (m *PeerMetric) CompressedOut() (raw int64, compressed int64) {
+	return atomic.LoadInt64(&m.rawOut), atomic.LoadInt64(&m.compressedOut)
+}
+
 // Deprecated
 func (m *PeerMetric) InputAdded(added int) {
 	atomic.AddInt64(&m.totalIn, int64(added))
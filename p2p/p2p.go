@@ -19,13 +19,13 @@ import (
 
 	"github.com/aergoio/aergo/consensus"
 	"github.com/aergoio/aergo/internal/network"
+	"github.com/aergoio/aergo/internal/loglevel"
 	"github.com/aergoio/aergo/p2p/metric"
 	"github.com/aergoio/aergo/p2p/p2pcommon"
 	"github.com/aergoio/aergo/p2p/p2putil"
 	"github.com/aergoio/aergo/p2p/subproto"
 
 	"github.com/aergoio/aergo-actor/actor"
-	"github.com/aergoio/aergo-lib/log"
 	"github.com/aergoio/aergo/chain"
 	"github.com/aergoio/aergo/config"
 	"github.com/aergoio/aergo/message"
@@ -57,6 +57,8 @@ type P2P struct {
 	prm    p2pcommon.PeerRoleManager
 	lm     p2pcommon.ListManager
 	cm     p2pcommon.CertificateManager
+	rep    p2pcommon.ReputationManager
+	rl     *rateLimiter
 	mutex sync.Mutex
 
 	// inited between construction and start
@@ -71,7 +73,7 @@ var (
 // NewP2P create a new ActorService for p2p
 func NewP2P(cfg *config.Config, chainSvc *chain.ChainService) *P2P {
 	p2psvc := &P2P{cfg: cfg}
-	p2psvc.BaseComponent = component.NewBaseComponent(message.P2PSvc, p2psvc, log.NewLogger("p2p"))
+	p2psvc.BaseComponent = component.NewBaseComponent(message.P2PSvc, p2psvc, loglevel.NewLogger("p2p").Logger)
 	p2psvc.initP2P(chainSvc)
 	return p2psvc
 }
@@ -111,6 +113,7 @@ func (p2ps *P2P) initP2P(chainSvc *chain.ChainService) {
 	peerMan := NewPeerManager(p2ps, p2ps, p2ps, p2ps, netTransport, metricMan, lm, p2ps.Logger, cfg, p2ps.useRaft)
 	syncMan := newSyncManager(p2ps, peerMan, p2ps.Logger)
 	versionMan := newDefaultVersionManager(p2ps, p2ps, peerMan, p2ps.ca, p2ps.Logger, p2ps.genesisChainID)
+	repMan := newReputationManager(peerMan, lm, p2ps.Logger)
 
 	// connect managers each other
 	peerMan.AddPeerEventListener(p2ps.cm)
@@ -125,6 +128,8 @@ func (p2ps *P2P) initP2P(chainSvc *chain.ChainService) {
 	//p2ps.rm = reconMan
 	p2ps.mm = metricMan
 	p2ps.lm = lm
+	p2ps.rep = repMan
+	p2ps.rl = newRateLimiter(cfg.P2P)
 
 	p2ps.mutex.Unlock()
 }
@@ -216,6 +221,7 @@ func (p2ps *P2P) Statistics() *map[string]interface{} {
 	stmap["whitelist"] = wlSummary["whitelist"]
 	stmap["whitelist_on"] = wlSummary["whitelist_on"]
 	stmap["syncman"] = p2ps.sm.Summary()
+	stmap["reputation"] = p2ps.rep.Summary()
 
 	return &stmap
 }
@@ -273,7 +279,24 @@ func (p2ps *P2P) Receive(context actor.Context) {
 		context.Respond(p2ps.selfMeta)
 	case *message.GetPeers:
 		peers := p2ps.pm.GetPeerAddresses(msg.NoHidden, msg.ShowSelf)
+		for _, pi := range peers {
+			if !pi.Self {
+				pi.Reputation = p2ps.rep.Score(types.PeerID(pi.Addr.PeerID))
+			}
+		}
 		context.Respond(&message.GetPeersRsp{Peers: peers})
+	case *message.PenalizePeer:
+		p2ps.rep.Penalize(msg.ToWhom, msg.Reason)
+	case *message.ChangeDesignatedPeers:
+		p2ps.ChangeDesignatedPeers(context, msg)
+	case *message.DisconnectPeer:
+		p2ps.DisconnectPeer(context, msg)
+	case *message.ChangeBlacklist:
+		p2ps.ChangeBlacklist(context, msg)
+	case *message.GetBlacklist:
+		context.Respond(&message.GetBlacklistRsp{Entries: p2ps.lm.ListBlacklist()})
+	case *message.GetWaitingPeers:
+		p2ps.GetWaitingPeers(context, msg)
 	case *message.GetSyncAncestor:
 		p2ps.GetSyncAncestor(context, msg)
 	case *message.MapQueryMsg:
@@ -422,6 +445,10 @@ func (p2ps *P2P) insertHandlers(peer p2pcommon.RemotePeer) {
 	peer.AddMessageHandler(p2pcommon.GetHashesResponse, subproto.NewGetHashesRespHandler(p2ps.pm, peer, logger, p2ps))
 	peer.AddMessageHandler(p2pcommon.GetHashByNoRequest, subproto.NewGetHashByNoReqHandler(p2ps.pm, peer, logger, p2ps))
 	peer.AddMessageHandler(p2pcommon.GetHashByNoResponse, subproto.NewGetHashByNoRespHandler(p2ps.pm, peer, logger, p2ps))
+	peer.AddMessageHandler(p2pcommon.GetCompactBlockRequest, subproto.NewCompactBlockReqHandler(p2ps.pm, peer, logger, p2ps))
+	peer.AddMessageHandler(p2pcommon.GetCompactBlockResponse, subproto.NewCompactBlockRespHandler(p2ps.pm, peer, logger, p2ps))
+	peer.AddMessageHandler(p2pcommon.GetBlockTxsRequest, subproto.NewBlockTxsReqHandler(p2ps.pm, peer, logger, p2ps))
+	peer.AddMessageHandler(p2pcommon.GetBlockTxsResponse, subproto.NewBlockTxsRespHandler(p2ps.pm, peer, logger, p2ps))
 
 	// TxHandlers
 	peer.AddMessageHandler(p2pcommon.GetTXsRequest, subproto.WithTimeLog(subproto.NewTxReqHandler(p2ps.pm, p2ps.sm, peer, logger, p2ps), p2ps.Logger, zerolog.DebugLevel))
@@ -468,7 +495,9 @@ func (p2ps *P2P) CreateRemotePeer(remoteInfo p2pcommon.RemoteInfo, seq uint32, r
 	}
 
 	newPeer := newRemotePeer(remoteInfo, seq, p2ps.pm, p2ps, p2ps.Logger, p2ps.mf, p2ps.signer, rw)
-	rw.AddIOListener(p2ps.mm.NewMetric(newPeer.ID(), newPeer.ManageNumber()))
+	newPeer.metric = p2ps.mm.NewMetric(newPeer.ID(), newPeer.ManageNumber())
+	newPeer.limiter = p2ps.rl.newPeerLimiter()
+	rw.AddIOListener(newPeer.metric)
 
 	// insert Handlers
 	p2ps.insertHandlers(newPeer)
@@ -0,0 +1,56 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2pcommon
+
+// Compression is the codec to compress payload of p2p message. The value is written in the highest byte of
+// subprotocol field of message header, so it must not be changed once released.
+type Compression byte
+
+const (
+	CompressionNone Compression = iota
+	CompressionSnappy
+)
+
+var compressionNames = map[Compression]string{
+	CompressionNone:   "none",
+	CompressionSnappy: "snappy",
+}
+
+func (c Compression) String() string {
+	if name, exist := compressionNames[c]; exist {
+		return name
+	}
+	return "unknown"
+}
+
+// ParseCompression returns the compression of name, and false if name is unknown.
+func ParseCompression(name string) (Compression, bool) {
+	for c, n := range compressionNames {
+		if n == name {
+			return c, true
+		}
+	}
+	return CompressionNone, false
+}
+
+// SupportedCompressions is list of compression codecs this aergosvr supports. The first is the most preferred one.
+var SupportedCompressions = []Compression{CompressionSnappy}
+
+// CompressThreshold is the minimum payload size to be compressed. Small payloads are sent as is, since the
+// compression cost is bigger than the gain.
+const CompressThreshold = 1024
+
+// Compressible is implemented by MsgReadWriter which can compress payloads of messages to write.
+// Compressed payloads are always readable regardless of it.
+type Compressible interface {
+	EnableCompression(c Compression)
+}
+
+// CompressionListener is optional interface of MsgIOListener, to listen the sizes of payloads before and after compression.
+type CompressionListener interface {
+	OnCompressedRead(protocol SubProtocol, raw, compressed int)
+	OnCompressedWrite(protocol SubProtocol, raw, compressed int)
+}
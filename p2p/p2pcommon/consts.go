@@ -51,11 +51,12 @@ const (
 	P2PVersion033     P2PVersion = 0x00000303 // support hardfork (chainid is changed)
 
 	P2PVersion200     P2PVersion = 0x00020000 // following aergo version. support peer role and multiple addresses
+	P2PVersion210     P2PVersion = 0x00020100 // support compact block relay
 )
 
 // AcceptedInboundVersions is list of versions this aergosvr supports. The first is the best recommended version.
-var AcceptedInboundVersions = []P2PVersion{P2PVersion200, P2PVersion033, P2PVersion032, P2PVersion031}
-var AttemptingOutboundVersions = []P2PVersion{P2PVersion200, P2PVersion033, P2PVersion032, P2PVersion031}
+var AcceptedInboundVersions = []P2PVersion{P2PVersion210, P2PVersion200, P2PVersion033, P2PVersion032, P2PVersion031}
+var AttemptingOutboundVersions = []P2PVersion{P2PVersion210, P2PVersion200, P2PVersion033, P2PVersion032, P2PVersion031}
 var ExperimentalVersions = []P2PVersion{P2PVersion200}
 
 var MaxPayloadLength = types.MaxMessageSize()
@@ -14,7 +14,8 @@ import (
 )
 
 type HandshakeResult struct {
-	MsgRW MsgReadWriter
+	MsgRW   MsgReadWriter
+	Version P2PVersion
 
 	Meta          PeerMeta
 	BestBlockHash types.BlockID
@@ -36,6 +37,8 @@ type HSHandler interface {
 
 type VersionedManager interface {
 	FindBestP2PVersion(versions []P2PVersion) P2PVersion
+	// FindBestCompression returns the compression codec to use with remote peer which supports codecs of names.
+	FindBestCompression(names []string) Compression
 	GetVersionedHandshaker(version P2PVersion, peerID types.PeerID, rwc io.ReadWriteCloser) (VersionedHandshaker, error)
 
 	GetBestChainID() *types.ChainID
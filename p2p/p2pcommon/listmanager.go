@@ -17,6 +17,20 @@ type ListManager interface {
 
 	IsBanned(addr string, pid types.PeerID) (bool, time.Time)
 
+	// AddTempBan bans the peer until the time, regardless of white/blacklist.
+	AddTempBan(pid types.PeerID, until time.Time)
+	// TempBanned returns whether the peer is temporarily banned and when the ban expires.
+	TempBanned(pid types.PeerID) (bool, time.Time)
+
+	// ChangeBlacklist adds entries to blacklist and removes entries from it, and saves it to local file. Peers matched
+	// to an entry are banned until the entry is removed. Entries have the same format of whitelist entry, and
+	// blacklist is applied to outbound connections too. Nothing is changed if any entry is invalid, an entry to
+	// remove is not in blacklist or saving fails.
+	ChangeBlacklist(add, remove []string) error
+	ListBlacklist() []string
+	// Blacklisted returns whether the peer is matched to any entry of blacklist.
+	Blacklisted(addr string, pid types.PeerID) bool
+
 	// RefineList update white/blacklist
 	RefineList()
 	Summary() map[string]interface{}
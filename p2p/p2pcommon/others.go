@@ -32,6 +32,8 @@ type SyncManager interface {
 	HandleNewTxNotice(peer RemotePeer, hashes []types.TxID, data *types.NewTransactionsNotice)
 	HandleGetTxReq(peer RemotePeer, msgID MsgID, data *types.GetTransactionsRequest) error
 	RetryGetTx(peer RemotePeer, hashes [][]byte)
+	// RetryGetTxFromOthers requests txs, which the peer failed to send, to other peers that also noticed them.
+	RetryGetTxFromOthers(peer RemotePeer, hashes [][]byte)
 }
 
 //go:generate sh -c "mockgen github.com/aergoio/aergo/p2p/p2pcommon SyncManager,PeerAccessor | sed -e 's/^package mock_p2pcommon/package p2pmock/g' > ../p2pmock/mock_syncmanager.go"
@@ -45,4 +45,7 @@ type PeerManager interface {
 	RemoveDesignatedPeer(peerID types.PeerID)
 	ListDesignatedPeers() []PeerMeta
 
+	// GetWaitingPeers returns copies of peers which are waiting for (re)connection.
+	GetWaitingPeers() []WaitingPeer
+
 }
\ No newline at end of file
@@ -16,6 +16,7 @@ const (
 	WaitingPeerManagerInterval = time.Minute >> 2
 
 	PolarisQueryInterval = time.Minute * 10
+	DHTQueryInterval     = time.Minute * 2
 	PeerQueryInterval    = time.Hour
 	PeerFirstInterval    = time.Second * 4
 
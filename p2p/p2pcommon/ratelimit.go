@@ -0,0 +1,47 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2pcommon
+
+// RateClass is the group of subprotocols which share the same rate limit of incoming messages.
+type RateClass int
+
+const (
+	// RateClassOther is for control messages such as status, ping and raft, and notices of block producers.
+	// They are not limited.
+	RateClassOther RateClass = iota
+	RateClassTx
+	RateClassBlock
+	RateClassAddress
+
+	// RateClassCount is the number of rate classes, not a class itself.
+	RateClassCount
+)
+
+var rateClassNames = [RateClassCount]string{"other", "tx", "block", "address"}
+
+func (c RateClass) String() string {
+	if c < 0 || c >= RateClassCount {
+		return "unknown"
+	}
+	return rateClassNames[c]
+}
+
+// RateClassOf returns the rate class of subprotocol.
+func RateClassOf(protocol SubProtocol) RateClass {
+	switch protocol {
+	case GetTXsRequest, GetTXsResponse, NewTxNotice:
+		return RateClassTx
+	case GetBlocksRequest, GetBlocksResponse, GetBlockHeadersRequest, GetBlockHeadersResponse, NewBlockNotice,
+		GetAncestorRequest, GetAncestorResponse, GetHashesRequest, GetHashesResponse, GetHashByNoRequest,
+		GetHashByNoResponse, GetCompactBlockRequest, GetCompactBlockResponse,
+		GetBlockTxsRequest, GetBlockTxsResponse:
+		return RateClassBlock
+	case AddressesRequest, AddressesResponse:
+		return RateClassAddress
+	default:
+		return RateClassOther
+	}
+}
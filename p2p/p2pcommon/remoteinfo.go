@@ -39,4 +39,6 @@ type RemoteInfo struct {
 	AcceptedRole types.PeerRole
 	Certificates []*AgentCertificateV1
 	Zone         PeerZone
+	// Version is p2p version negotiated in handshake
+	Version P2PVersion
 }
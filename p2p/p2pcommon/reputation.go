@@ -0,0 +1,23 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+//go:generate mockgen -source=reputation.go -package=p2pmock -destination=../p2pmock/mock_reputation.go
+package p2pcommon
+
+import (
+	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/types"
+)
+
+// ReputationManager accumulates penalties of misbehaving remote peers. Penalties decay over time, and
+// the peer whose penalty score exceeds the threshold is disconnected and banned for a while.
+type ReputationManager interface {
+	// Penalize adds the penalty of reason to the peer
+	Penalize(pid types.PeerID, reason message.PenaltyReason)
+	// Score returns the current penalty score of the peer. higher is worse
+	Score(pid types.PeerID) int
+
+	Summary() map[string]interface{}
+}
@@ -10,7 +10,8 @@ const (
 	_SubProtocol_name_2 = "NewBlockNoticeGetAncestorRequestGetAncestorResponseGetHashesRequestGetHashesResponseGetHashByNoRequestGetHashByNoResponse"
 	_SubProtocol_name_3 = "GetTXsRequestGetTXsResponseNewTxNotice"
 	_SubProtocol_name_4 = "BlockProducedNotice"
-	_SubProtocol_name_5 = "GetClusterRequestGetClusterResponseRaftWrapperMessage"
+	_SubProtocol_name_5 = "GetCompactBlockRequestGetCompactBlockResponseGetBlockTxsRequestGetBlockTxsResponse"
+	_SubProtocol_name_6 = "GetClusterRequestGetClusterResponseRaftWrapperMessage"
 )
 
 var (
@@ -18,7 +19,8 @@ var (
 	_SubProtocol_index_1 = [...]uint8{0, 16, 33, 55, 78}
 	_SubProtocol_index_2 = [...]uint8{0, 14, 32, 51, 67, 84, 102, 121}
 	_SubProtocol_index_3 = [...]uint8{0, 13, 27, 38}
-	_SubProtocol_index_5 = [...]uint8{0, 17, 35, 53}
+	_SubProtocol_index_5 = [...]uint8{0, 22, 45, 63, 82}
+	_SubProtocol_index_6 = [...]uint8{0, 17, 35, 53}
 )
 
 func (i SubProtocol) String() string {
@@ -37,9 +39,12 @@ func (i SubProtocol) String() string {
 		return _SubProtocol_name_3[_SubProtocol_index_3[i]:_SubProtocol_index_3[i+1]]
 	case i == 48:
 		return _SubProtocol_name_4
+	case 64 <= i && i <= 67:
+		i -= 64
+		return _SubProtocol_name_5[_SubProtocol_index_5[i]:_SubProtocol_index_5[i+1]]
 	case 12545 <= i && i <= 12547:
 		i -= 12545
-		return _SubProtocol_name_5[_SubProtocol_index_5[i]:_SubProtocol_index_5[i+1]]
+		return _SubProtocol_name_6[_SubProtocol_index_6[i]:_SubProtocol_index_6[i+1]]
 	default:
 		return "SubProtocol(" + strconv.FormatInt(int64(i), 10) + ")"
 	}
@@ -46,6 +46,14 @@ const (
 	BlockProducedNotice SubProtocol = 0x030 + iota
 )
 
+// subprotocols for compact block relay, supported since p2p version 2.1.0
+const (
+	GetCompactBlockRequest SubProtocol = 0x040 + iota
+	GetCompactBlockResponse
+	GetBlockTxsRequest
+	GetBlockTxsResponse
+)
+
 const (
 	_ SubProtocol = 0x3100 + iota
 	GetClusterRequest
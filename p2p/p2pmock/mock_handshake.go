@@ -126,6 +126,20 @@ func (mr *MockVersionedManagerMockRecorder) FindBestP2PVersion(versions interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBestP2PVersion", reflect.TypeOf((*MockVersionedManager)(nil).FindBestP2PVersion), versions)
 }
 
+// FindBestCompression mocks base method
+func (m *MockVersionedManager) FindBestCompression(names []string) p2pcommon.Compression {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBestCompression", names)
+	ret0, _ := ret[0].(p2pcommon.Compression)
+	return ret0
+}
+
+// FindBestCompression indicates an expected call of FindBestCompression
+func (mr *MockVersionedManagerMockRecorder) FindBestCompression(names interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBestCompression", reflect.TypeOf((*MockVersionedManager)(nil).FindBestCompression), names)
+}
+
 // GetVersionedHandshaker mocks base method
 func (m *MockVersionedManager) GetVersionedHandshaker(version p2pcommon.P2PVersion, peerID types.PeerID, rwc io.ReadWriteCloser) (p2pcommon.VersionedHandshaker, error) {
 	m.ctrl.T.Helper()
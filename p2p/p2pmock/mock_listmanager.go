@@ -73,6 +73,75 @@ func (mr *MockListManagerMockRecorder) IsBanned(addr, pid interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBanned", reflect.TypeOf((*MockListManager)(nil).IsBanned), addr, pid)
 }
 
+// AddTempBan mocks base method
+func (m *MockListManager) AddTempBan(pid types.PeerID, until time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddTempBan", pid, until)
+}
+
+// AddTempBan indicates an expected call of AddTempBan
+func (mr *MockListManagerMockRecorder) AddTempBan(pid, until interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTempBan", reflect.TypeOf((*MockListManager)(nil).AddTempBan), pid, until)
+}
+
+// TempBanned mocks base method
+func (m *MockListManager) TempBanned(pid types.PeerID) (bool, time.Time) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TempBanned", pid)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(time.Time)
+	return ret0, ret1
+}
+
+// TempBanned indicates an expected call of TempBanned
+func (mr *MockListManagerMockRecorder) TempBanned(pid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TempBanned", reflect.TypeOf((*MockListManager)(nil).TempBanned), pid)
+}
+
+// ChangeBlacklist mocks base method
+func (m *MockListManager) ChangeBlacklist(add, remove []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeBlacklist", add, remove)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeBlacklist indicates an expected call of ChangeBlacklist
+func (mr *MockListManagerMockRecorder) ChangeBlacklist(add, remove interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeBlacklist", reflect.TypeOf((*MockListManager)(nil).ChangeBlacklist), add, remove)
+}
+
+// ListBlacklist mocks base method
+func (m *MockListManager) ListBlacklist() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBlacklist")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// ListBlacklist indicates an expected call of ListBlacklist
+func (mr *MockListManagerMockRecorder) ListBlacklist() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBlacklist", reflect.TypeOf((*MockListManager)(nil).ListBlacklist))
+}
+
+// Blacklisted mocks base method
+func (m *MockListManager) Blacklisted(addr string, pid types.PeerID) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Blacklisted", addr, pid)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Blacklisted indicates an expected call of Blacklisted
+func (mr *MockListManagerMockRecorder) Blacklisted(addr, pid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Blacklisted", reflect.TypeOf((*MockListManager)(nil).Blacklisted), addr, pid)
+}
+
 // RefineList mocks base method
 func (m *MockListManager) RefineList() {
 	m.ctrl.T.Helper()
@@ -273,3 +273,17 @@ func (mr *MockPeerManagerMockRecorder) ListDesignatedPeers() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDesignatedPeers", reflect.TypeOf((*MockPeerManager)(nil).ListDesignatedPeers))
 }
+
+// GetWaitingPeers mocks base method
+func (m *MockPeerManager) GetWaitingPeers() []p2pcommon.WaitingPeer {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWaitingPeers")
+	ret0, _ := ret[0].([]p2pcommon.WaitingPeer)
+	return ret0
+}
+
+// GetWaitingPeers indicates an expected call of GetWaitingPeers
+func (mr *MockPeerManagerMockRecorder) GetWaitingPeers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWaitingPeers", reflect.TypeOf((*MockPeerManager)(nil).GetWaitingPeers))
+}
@@ -121,6 +121,18 @@ func (mr *MockSyncManagerMockRecorder) RetryGetTx(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryGetTx", reflect.TypeOf((*MockSyncManager)(nil).RetryGetTx), arg0, arg1)
 }
 
+// RetryGetTxFromOthers mocks base method
+func (m *MockSyncManager) RetryGetTxFromOthers(arg0 p2pcommon.RemotePeer, arg1 [][]byte) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RetryGetTxFromOthers", arg0, arg1)
+}
+
+// RetryGetTxFromOthers indicates an expected call of RetryGetTxFromOthers
+func (mr *MockSyncManagerMockRecorder) RetryGetTxFromOthers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryGetTxFromOthers", reflect.TypeOf((*MockSyncManager)(nil).RetryGetTxFromOthers), arg0, arg1)
+}
+
 // Start mocks base method
 func (m *MockSyncManager) Start() {
 	m.ctrl.T.Helper()
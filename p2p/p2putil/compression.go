@@ -0,0 +1,45 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2putil
+
+import (
+	"fmt"
+
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/golang/snappy"
+)
+
+// CompressPayload compresses payload of p2p message with the codec
+func CompressPayload(c p2pcommon.Compression, payload []byte) ([]byte, error) {
+	switch c {
+	case p2pcommon.CompressionNone:
+		return payload, nil
+	case p2pcommon.CompressionSnappy:
+		return snappy.Encode(nil, payload), nil
+	default:
+		return nil, fmt.Errorf("unsupported compression %d", c)
+	}
+}
+
+// DecompressPayload decompresses payload of p2p message with the codec. It returns error without decompressing
+// if the decompressed size is bigger than maxLen.
+func DecompressPayload(c p2pcommon.Compression, payload []byte, maxLen uint32) ([]byte, error) {
+	switch c {
+	case p2pcommon.CompressionNone:
+		return payload, nil
+	case p2pcommon.CompressionSnappy:
+		size, err := snappy.DecodedLen(payload)
+		if err != nil {
+			return nil, err
+		}
+		if size < 0 || uint32(size) > maxLen {
+			return nil, fmt.Errorf("too big payload")
+		}
+		return snappy.Decode(nil, payload)
+	default:
+		return nil, fmt.Errorf("unsupported compression %d", c)
+	}
+}
@@ -0,0 +1,56 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2putil
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+)
+
+func TestCompressPayload(t *testing.T) {
+	repeated := bytes.Repeat([]byte("aergo block body "), 1000)
+	tests := []struct {
+		name    string
+		codec   p2pcommon.Compression
+		payload []byte
+		maxLen  uint32
+
+		wantCompErr   bool
+		wantDecompErr bool
+	}{
+		{"TNone", p2pcommon.CompressionNone, repeated, 1 << 20, false, false},
+		{"TSnappy", p2pcommon.CompressionSnappy, repeated, 1 << 20, false, false},
+		{"TSnappyEmpty", p2pcommon.CompressionSnappy, []byte{}, 1 << 20, false, false},
+		{"TTooBig", p2pcommon.CompressionSnappy, repeated, uint32(len(repeated) - 1), false, true},
+		{"TUnknown", p2pcommon.Compression(99), repeated, 1 << 20, true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compressed, err := CompressPayload(tt.codec, tt.payload)
+			if (err != nil) != tt.wantCompErr {
+				t.Fatalf("CompressPayload() error = %v, wantErr %v", err, tt.wantCompErr)
+			}
+			if err != nil {
+				compressed = tt.payload
+			}
+			got, err := DecompressPayload(tt.codec, compressed, tt.maxLen)
+			if (err != nil) != tt.wantDecompErr {
+				t.Fatalf("DecompressPayload() error = %v, wantErr %v", err, tt.wantDecompErr)
+			}
+			if err == nil && !bytes.Equal(got, tt.payload) {
+				t.Errorf("DecompressPayload() = %v bytes, want %v bytes", len(got), len(tt.payload))
+			}
+		})
+	}
+}
+
+func TestDecompressPayload_Malformed(t *testing.T) {
+	if _, err := DecompressPayload(p2pcommon.CompressionSnappy, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, 1<<20); err == nil {
+		t.Errorf("DecompressPayload() expected error for malformed input")
+	}
+}
@@ -0,0 +1,56 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2putil
+
+import (
+	"sync"
+	"time"
+)
+
+// TokenBucket is threadsafe token bucket to limit rate of events. Tokens are refilled by rate per second up to burst.
+// Unlike usual token bucket, taking tokens always succeeds and makes the bucket into debt if tokens are not enough,
+// so the bigger request than burst is also allowed after the proper delay.
+type TokenBucket struct {
+	mutex  sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucket creates full bucket. It returns nil, which means unlimited bucket, if rate is not positive.
+// burst is set to rate if it is not positive.
+func NewTokenBucket(rate, burst float64) *TokenBucket {
+	if rate <= 0 {
+		return nil
+	}
+	if burst <= 0 {
+		burst = rate
+	}
+	return &TokenBucket{rate: rate, burst: burst, tokens: burst, last: time.Now()}
+}
+
+// Take takes n tokens at time now and returns how long caller should wait until the tokens are paid off.
+// It returns 0 if there are enough tokens or the bucket is nil.
+func (b *TokenBucket) Take(n float64, now time.Time) time.Duration {
+	if b == nil {
+		return 0
+	}
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += elapsed.Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+		b.last = now
+	}
+	b.tokens -= n
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
@@ -0,0 +1,45 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2putil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTokenBucket_Take(t *testing.T) {
+	start := time.Now()
+	tests := []struct {
+		name  string
+		rate  float64
+		burst float64
+		takes []float64
+		after []time.Duration
+
+		want []time.Duration
+	}{
+		{"TUnlimited", 0, 0, []float64{1000, 1000}, []time.Duration{0, 0}, []time.Duration{0, 0}},
+		{"TInBurst", 10, 0, []float64{5, 5}, []time.Duration{0, 0}, []time.Duration{0, 0}},
+		{"TExceed", 10, 0, []float64{10, 5}, []time.Duration{0, 0}, []time.Duration{0, time.Millisecond * 500}},
+		{"TRefill", 10, 0, []float64{10, 5}, []time.Duration{0, time.Millisecond * 500}, []time.Duration{0, 0}},
+		{"TRefillMaxBurst", 10, 2, []float64{2, 5}, []time.Duration{0, time.Second * 10}, []time.Duration{0, time.Millisecond * 300}},
+		{"TBiggerThanBurst", 100, 0, []float64{300}, []time.Duration{0}, []time.Duration{time.Second * 2}},
+		{"TDebt", 10, 0, []float64{20, 10}, []time.Duration{0, time.Second}, []time.Duration{time.Second, time.Second}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewTokenBucket(tt.rate, tt.burst)
+			if b != nil {
+				b.last = start
+			}
+			for i, n := range tt.takes {
+				if got := b.Take(n, start.Add(tt.after[i])); got != tt.want[i] {
+					t.Errorf("Take() #%d = %v, want %v", i, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
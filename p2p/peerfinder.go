@@ -18,14 +18,14 @@ const (
 	macConcurrentQueryCount = 4
 )
 
-func NewPeerFinder(logger *log.Logger, pm *peerManager, actorService p2pcommon.ActorService, maxCap int, useDiscover, usePolaris bool) p2pcommon.PeerFinder {
+func NewPeerFinder(logger *log.Logger, pm *peerManager, actorService p2pcommon.ActorService, maxCap int, useDiscover, usePolaris bool, dht *dhtDiscovery) p2pcommon.PeerFinder {
 	var pf p2pcommon.PeerFinder
 	if !useDiscover {
 		logger.Info().Msg("peer discover option is disabled, so select static peer finder.")
 		pf = &staticPeerFinder{pm:pm, logger:logger}
 	} else {
-		logger.Info().Bool("usePolaris",usePolaris).Msg("peer discover option is enabled, so select dynamic peer finder.")
-		dp := &dynamicPeerFinder{logger: logger, pm: pm, actorService: actorService, maxCap: maxCap, usePolaris:usePolaris}
+		logger.Info().Bool("usePolaris",usePolaris).Bool("useDHT", dht != nil).Msg("peer discover option is enabled, so select dynamic peer finder.")
+		dp := &dynamicPeerFinder{logger: logger, pm: pm, actorService: actorService, maxCap: maxCap, usePolaris:usePolaris, dht: dht}
 		dp.qStats = make(map[types.PeerID]*queryStat)
 		pf = dp
 	}
@@ -59,12 +59,15 @@ type dynamicPeerFinder struct {
 	pm           *peerManager
 	actorService p2pcommon.ActorService
 	usePolaris   bool
+	// dht is nil if DHT discovery is not used
+	dht *dhtDiscovery
 
 	// qStats are logs of query. all connected peers must exist queryStat.
 	qStats map[types.PeerID]*queryStat
 	maxCap int
 
 	polarisTurn time.Time
+	dhtTurn     time.Time
 }
 
 var _ p2pcommon.PeerFinder = (*dynamicPeerFinder)(nil)
@@ -95,6 +98,12 @@ func (dp *dynamicPeerFinder) CheckAndFill() {
 		dp.logger.Debug().Time("next_turn", dp.polarisTurn).Msg("querying to polaris")
 		dp.actorService.SendRequest(message.P2PSvc, &message.MapQueryMsg{Count: MaxAddrListSizePolaris})
 	}
+	// lookup in DHT
+	if dp.dht != nil && now.After(dp.dhtTurn) {
+		dp.dhtTurn = now.Add(p2pcommon.DHTQueryInterval)
+		dp.logger.Debug().Time("next_turn", dp.dhtTurn).Msg("finding peers in DHT")
+		dp.dht.FindPeers(toConnCount)
+	}
 	// query to peers
 	queried := 0
 	for _, stat := range dp.qStats {
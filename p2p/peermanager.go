@@ -46,6 +46,8 @@ type peerManager struct {
 
 	peerFinder p2pcommon.PeerFinder
 	wpManager  p2pcommon.WaitingPeerManager
+	// dht is nil if DHT discovery is disabled
+	dht *dhtDiscovery
 
 	mutex        *sync.Mutex
 	manageNumber uint32
@@ -72,7 +74,8 @@ type peerManager struct {
 
 	eventListeners []p2pcommon.PeerEventListener
 
-	// designatedPeers and hiddenPeerSet is set in construction time once and will not be changed
+	// hiddenPeerSet is set in construction time once and will not be changed. designatedPeers can be changed in runtime
+	// by raft membership change or admin rpc
 	designatedPeers map[types.PeerID]p2pcommon.PeerMeta
 	hiddenPeerSet   map[types.PeerID]bool
 
@@ -153,7 +156,10 @@ func (pm *peerManager) init() {
 		pm.hiddenPeerSet[pid] = true
 	}
 
-	pm.peerFinder = NewPeerFinder(pm.logger, pm, pm.actorService, pm.conf.NPPeerPool, pm.conf.NPDiscoverPeers, pm.conf.NPUsePolaris)
+	if pm.conf.NPDiscoverPeers && pm.conf.NPUseDHT {
+		pm.initDHTDiscovery()
+	}
+	pm.peerFinder = NewPeerFinder(pm.logger, pm, pm.actorService, pm.conf.NPPeerPool, pm.conf.NPDiscoverPeers, pm.conf.NPUsePolaris, pm.dht)
 	pm.wpManager = NewWaitingPeerManager(pm.logger, pm.is, pm, pm.lm, pm.conf.NPPeerPool, pm.conf.NPDiscoverPeers)
 	pm.AddPeerEventListener(pm.peerFinder)
 	pm.AddPeerEventListener(pm.wpManager)
@@ -174,6 +180,12 @@ func (pm *peerManager) AddPeerEventListener(l p2pcommon.PeerEventListener) {
 func (pm *peerManager) Start() error {
 	// connect other sub modules
 	pm.cm = pm.is.CertificateManager()
+	if pm.dht != nil {
+		if err := pm.dht.Start(); err != nil {
+			pm.logger.Warn().Err(err).Msg("Failed to start DHT peer discovery")
+			pm.dht = nil
+		}
+	}
 	go pm.runManagePeers()
 
 	return nil
@@ -209,6 +221,29 @@ func (pm *peerManager) initDesignatedPeerList() {
 	}
 }
 
+// initDHTDiscovery creates DHT discovery with bootstrap peers in config, or designated peers if they are not set.
+func (pm *peerManager) initDHTDiscovery() {
+	bootstraps := make([]p2pcommon.PeerMeta, 0, len(pm.conf.NPBootstrapPeers))
+	for _, addrStr := range pm.conf.NPBootstrapPeers {
+		meta, err := p2putil.FromMultiAddrString(addrStr)
+		if err != nil {
+			pm.logger.Warn().Err(err).Str("str", addrStr).Msg("invalid NPBootstrapPeers address")
+			continue
+		}
+		bootstraps = append(bootstraps, meta)
+	}
+	if len(bootstraps) == 0 {
+		for _, meta := range pm.designatedPeers {
+			bootstraps = append(bootstraps, meta)
+		}
+	}
+	chainID, err := pm.is.GetChainAccessor().GetGenesisInfo().ChainID()
+	if err != nil {
+		panic("genesis block is not set properly: " + err.Error())
+	}
+	pm.dht = newDHTDiscovery(pm.logger, pm.nt, pm, p2pkey.NodeID(), chainID, bootstraps, pm.conf.NPExposeSelf)
+}
+
 func (pm *peerManager) runManagePeers() {
 
 	pm.logger.Info().Str("p2p_proto", p2putil.ProtocolIDsToString([]protocol.ID{p2pcommon.P2PSubAddr})).Msg("Starting p2p listening")
@@ -291,6 +326,9 @@ MANLOOP:
 	}
 	// guaranty no new peer connection will be made
 	pm.nt.RemoveStreamHandler(p2pcommon.P2PSubAddr)
+	if pm.dht != nil {
+		pm.dht.Stop()
+	}
 
 	pm.logger.Info().Msg("Finishing peerManager")
 
@@ -497,7 +535,7 @@ func (pm *peerManager) GetPeerAddresses(noHidden bool, showSelf bool) []*message
 		lastStatus := aPeer.LastStatus()
 		rCerts, _ := p2putil.ConvertCertsToProto(aPeer.RemoteInfo().Certificates)
 		pi := &message.PeerInfo{
-			&addr, rCerts, aPeer.AcceptedRole(), meta.Version, ri.Hidden, lastStatus.CheckTime, lastStatus.BlockHash, lastStatus.BlockNumber, aPeer.State(), false}
+			&addr, rCerts, aPeer.AcceptedRole(), meta.Version, ri.Hidden, lastStatus.CheckTime, lastStatus.BlockHash, lastStatus.BlockNumber, aPeer.State(), false, 0}
 		peers = append(peers, pi)
 	}
 	return peers
@@ -590,6 +628,9 @@ func (pm *peerManager) AddDesignatedPeer(meta p2pcommon.PeerMeta) {
 	finished := make(chan interface{})
 	pm.taskChannel <- func() {
 		pm.designatedPeers[meta.ID] = meta
+		if wp, exist := pm.waitingPeers[meta.ID]; exist {
+			wp.Designated = true
+		}
 		finished <- struct{}{}
 	}
 	<-finished
@@ -599,6 +640,10 @@ func (pm *peerManager) RemoveDesignatedPeer(peerID types.PeerID) {
 	finished := make(chan interface{})
 	pm.taskChannel <- func() {
 		delete(pm.designatedPeers, peerID)
+		// stop retrying connection to the peer.
+		if wp, exist := pm.waitingPeers[peerID]; exist && wp.Designated {
+			delete(pm.waitingPeers, peerID)
+		}
 		finished <- struct{}{}
 	}
 	<-finished
@@ -616,5 +661,17 @@ func (pm *peerManager) ListDesignatedPeers() []p2pcommon.PeerMeta {
 	return <-retChan
 }
 
+func (pm *peerManager) GetWaitingPeers() []p2pcommon.WaitingPeer {
+	retChan := make(chan []p2pcommon.WaitingPeer)
+	pm.taskChannel <- func() {
+		arr := make([]p2pcommon.WaitingPeer, 0, len(pm.waitingPeers))
+		for _, wp := range pm.waitingPeers {
+			arr = append(arr, *wp)
+		}
+		retChan <- arr
+	}
+	return <-retChan
+}
+
 // pmTask should not consume lots of time to process.
 type pmTask func()
@@ -0,0 +1,101 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2p
+
+import (
+	"time"
+
+	"github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/p2putil"
+)
+
+const (
+	// a peer is penalized if its own rate limits are exceeded more than rateExceedCount times in rateExceedWindow
+	rateExceedWindow = time.Minute
+	rateExceedCount  = 100
+)
+
+// rateLimit is a pair of limits on messages and bytes per second. nil bucket means unlimited.
+type rateLimit struct {
+	msgs  *p2putil.TokenBucket
+	bytes *p2putil.TokenBucket
+}
+
+func newRateLimit(msgRate, byteRate int) rateLimit {
+	return rateLimit{msgs: p2putil.NewTokenBucket(float64(msgRate), 0), bytes: p2putil.NewTokenBucket(float64(byteRate), 0)}
+}
+
+// take consumes a message of size bytes and returns the longer wait of both limits
+func (l rateLimit) take(size int, now time.Time) time.Duration {
+	msgWait := l.msgs.Take(1, now)
+	byteWait := l.bytes.Take(float64(size), now)
+	if msgWait > byteWait {
+		return msgWait
+	}
+	return byteWait
+}
+
+// rateLimiter has configured limits of incoming messages, and global limits which are shared by all peers.
+type rateLimiter struct {
+	peerMsgRates  [p2pcommon.RateClassCount]int
+	peerByteRates [p2pcommon.RateClassCount]int
+	global        [p2pcommon.RateClassCount]rateLimit
+}
+
+func newRateLimiter(conf *config.P2PConfig) *rateLimiter {
+	rl := &rateLimiter{}
+	rl.peerMsgRates[p2pcommon.RateClassTx], rl.peerByteRates[p2pcommon.RateClassTx] = conf.NPPeerTxMsgRate, conf.NPPeerTxByteRate
+	rl.peerMsgRates[p2pcommon.RateClassBlock], rl.peerByteRates[p2pcommon.RateClassBlock] = conf.NPPeerBlockMsgRate, conf.NPPeerBlockByteRate
+	rl.peerMsgRates[p2pcommon.RateClassAddress], rl.peerByteRates[p2pcommon.RateClassAddress] = conf.NPPeerAddrMsgRate, conf.NPPeerAddrByteRate
+	rl.global[p2pcommon.RateClassTx] = newRateLimit(conf.NPGlobalTxMsgRate, conf.NPGlobalTxByteRate)
+	rl.global[p2pcommon.RateClassBlock] = newRateLimit(conf.NPGlobalBlockMsgRate, conf.NPGlobalBlockByteRate)
+	rl.global[p2pcommon.RateClassAddress] = newRateLimit(conf.NPGlobalAddrMsgRate, conf.NPGlobalAddrByteRate)
+	return rl
+}
+
+// newPeerLimiter creates limiter for a newly connected peer.
+func (rl *rateLimiter) newPeerLimiter() *peerRateLimiter {
+	pl := &peerRateLimiter{global: rl}
+	for i := range pl.limits {
+		pl.limits[i] = newRateLimit(rl.peerMsgRates[i], rl.peerByteRates[i])
+	}
+	return pl
+}
+
+// peerRateLimiter limits incoming messages of a remote peer. It is not threadsafe and must be used only in the read
+// goroutine of the peer.
+type peerRateLimiter struct {
+	global *rateLimiter
+	limits [p2pcommon.RateClassCount]rateLimit
+
+	exceedStart time.Time
+	exceedCnt   int
+}
+
+// take consumes a message and returns how long reading of next message should be delayed. exceeded is true if the
+// limit of the peer itself is exceeded, and penalize is true if the peer exceeds its limits persistently.
+// The global limit is charged only by messages within the limit of the peer, so an abusive peer does not use up
+// the budget shared with others.
+func (pl *peerRateLimiter) take(class p2pcommon.RateClass, size int, now time.Time) (wait time.Duration, exceeded bool, penalize bool) {
+	wait = pl.limits[class].take(size, now)
+	if wait <= 0 {
+		return pl.global.global[class].take(size, now), false, false
+	}
+
+	if now.Sub(pl.exceedStart) > rateExceedWindow {
+		pl.exceedStart = now
+		pl.exceedCnt = 0
+	}
+	pl.exceedCnt++
+	if pl.exceedCnt > rateExceedCount {
+		// start new window not to penalize again for the same violations
+		pl.exceedStart = now
+		pl.exceedCnt = 0
+		penalize = true
+	}
+	return wait, true, penalize
+}
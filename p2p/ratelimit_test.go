@@ -0,0 +1,104 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+)
+
+func TestPeerRateLimiter_take(t *testing.T) {
+	conf := &config.P2PConfig{NPPeerTxMsgRate: 10, NPPeerBlockByteRate: 1000, NPGlobalAddrMsgRate: 2}
+	tests := []struct {
+		name  string
+		class p2pcommon.RateClass
+		size  int
+		cnt   int
+
+		wantWait     bool
+		wantExceeded bool
+	}{
+		{"TInLimit", p2pcommon.RateClassTx, 100, 10, false, false},
+		{"TTxExceed", p2pcommon.RateClassTx, 100, 11, true, true},
+		{"TBlockBytes", p2pcommon.RateClassBlock, 600, 2, true, true},
+		{"TGlobalOnly", p2pcommon.RateClassAddress, 10, 3, true, false},
+		{"TUnlimited", p2pcommon.RateClassOther, 100000, 1000, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pl := newRateLimiter(conf).newPeerLimiter()
+			now := time.Now()
+			var wait time.Duration
+			var exceeded, penalize bool
+			for i := 0; i < tt.cnt; i++ {
+				wait, exceeded, penalize = pl.take(tt.class, tt.size, now)
+			}
+			if (wait > 0) != tt.wantWait {
+				t.Errorf("take() wait = %v, want wait %v", wait, tt.wantWait)
+			}
+			if exceeded != tt.wantExceeded {
+				t.Errorf("take() exceeded = %v, want %v", exceeded, tt.wantExceeded)
+			}
+			if penalize {
+				t.Errorf("take() penalize = true, want false")
+			}
+		})
+	}
+}
+
+func TestPeerRateLimiter_penalize(t *testing.T) {
+	rl := newRateLimiter(&config.P2PConfig{NPPeerTxMsgRate: 1})
+	pl, other := rl.newPeerLimiter(), rl.newPeerLimiter()
+	now := time.Now()
+	penalized := 0
+	// first message is in burst, and the others exceed the limit
+	for i := 0; i < rateExceedCount*2+1; i++ {
+		if _, _, penalize := pl.take(p2pcommon.RateClassTx, 10, now); penalize {
+			penalized++
+		}
+	}
+	if penalized != 1 {
+		t.Errorf("penalized %v times, want 1", penalized)
+	}
+	// the limits of peer are not shared with other peers
+	if wait, _, _ := other.take(p2pcommon.RateClassTx, 10, now); wait > 0 {
+		t.Errorf("other peer wait = %v, want 0", wait)
+	}
+
+	// exceeding is forgotten after the window
+	pl = rl.newPeerLimiter()
+	penalized = 0
+	for i := 0; i < rateExceedCount*2+1; i++ {
+		if i == rateExceedCount {
+			now = now.Add(rateExceedWindow * 2)
+		}
+		if _, _, penalize := pl.take(p2pcommon.RateClassTx, 10, now); penalize {
+			penalized++
+		}
+	}
+	if penalized != 0 {
+		t.Errorf("penalized %v times after window, want 0", penalized)
+	}
+}
+
+func TestPeerRateLimiter_global(t *testing.T) {
+	rl := newRateLimiter(&config.P2PConfig{NPPeerTxMsgRate: 1, NPGlobalTxMsgRate: 3})
+	pl, other := rl.newPeerLimiter(), rl.newPeerLimiter()
+	now := time.Now()
+	// messages exceeding the limit of peer are not charged to the global limit
+	for i := 0; i < 10; i++ {
+		pl.take(p2pcommon.RateClassTx, 10, now)
+	}
+	for i := 0; i < 2; i++ {
+		if wait, exceeded, _ := other.take(p2pcommon.RateClassTx, 10, now); wait > 0 || exceeded {
+			t.Errorf("other peer wait = %v, exceeded %v, want no wait", wait, exceeded)
+		}
+		now = now.Add(time.Second)
+	}
+}
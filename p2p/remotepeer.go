@@ -18,6 +18,7 @@ import (
 	lru "github.com/hashicorp/golang-lru"
 
 	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/message"
 	"github.com/aergoio/aergo/p2p/p2putil"
 	"github.com/aergoio/aergo/types"
 )
@@ -51,6 +52,8 @@ type remotePeerImpl struct {
 	mf         p2pcommon.MoFactory
 	signer     p2pcommon.MsgSigner
 	metric     *metric.PeerMetric
+	// limiter delays reading of incoming messages which exceed rate limits. nil means unlimited.
+	limiter *peerRateLimiter
 
 	certChan chan *p2pcommon.AgentCertificateV1
 	stopChan chan struct{}
@@ -107,7 +110,7 @@ func newRemotePeer(remote p2pcommon.RemoteInfo, manageNum uint32, pm p2pcommon.P
 
 		txQueueLock:         &sync.Mutex{},
 		txNoticeQueue:       p2putil.NewPressableQueue(DefaultPeerTxQueueSize),
-		maxTxNoticeHashSize: DefaultPeerTxQueueSize,
+		maxTxNoticeHashSize: DefaultPeerTxNoticeBatchSize,
 		taskChannel: make(chan p2pcommon.PeerTask, 1),
 	}
 	rPeer.writeBuf = make(chan p2pcommon.MsgOrder, writeMsgBufferSize)
@@ -273,6 +276,9 @@ func (p *remotePeerImpl) runRead() {
 			p.Stop()
 			return
 		}
+		if p.limiter != nil && !p.throttle(msg) {
+			return
+		}
 		if err = p.handleMsg(msg); err != nil {
 			// TODO set different log level by case (i.e. it can be expected if peer is disconnecting )
 			p.logger.Warn().Str(p2putil.LogPeerName, p.Name()).Err(err).Msg("Failed to handle message")
@@ -282,6 +288,35 @@ func (p *remotePeerImpl) runRead() {
 	}
 }
 
+// throttle delays handling of the message and reading of next ones if the message exceeds rate limits, so the remote
+// peer is slowed down by backpressure of the connection. It returns false if the peer is stopped while waiting.
+// Responses to requests of local peer are not limited, since their rate is decided by the local peer.
+func (p *remotePeerImpl) throttle(msg p2pcommon.Message) bool {
+	if p.isRequested(msg.OriginalID()) {
+		return true
+	}
+	class := p2pcommon.RateClassOf(msg.Subprotocol())
+	wait, exceeded, penalize := p.limiter.take(class, len(msg.Payload()), time.Now())
+	if wait <= 0 {
+		return true
+	}
+	if p.metric != nil {
+		p.metric.OnThrottled(class, wait, exceeded)
+	}
+	if penalize {
+		p.logger.Info().Str(p2putil.LogPeerName, p.Name()).Str("class", class.String()).Msg("remote peer exceeds rate limits persistently")
+		penalizePeer(p.actor, p.ID(), message.PenaltyRateExceeded)
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-p.closeWrite:
+		return false
+	}
+}
+
 func (p *remotePeerImpl) handleMsg(msg p2pcommon.Message) (err error) {
 	subProto := msg.Subprotocol()
 	defer func() {
@@ -390,6 +425,14 @@ func (p *remotePeerImpl) PushTxsNotice(txHashes []types.TxID) {
 	p.txQueueLock.Lock()
 	defer p.txQueueLock.Unlock()
 	for _, hash := range txHashes {
+		// skip tx that the remote peer already knows, i.e. it noticed or requested the tx, or it was noticed to it.
+		if p.txHashCache.Contains(hash) {
+			continue
+		}
+		// notices are sent immediately when enough hashes are collected, or by ticker within txNoticeInterval
+		if p.txNoticeQueue.Size() >= p.maxTxNoticeHashSize {
+			p.sendTxNotices()
+		}
 		if !p.txNoticeQueue.Offer(hash) {
 			p.sendTxNotices()
 			// this Offer is always succeeded by invariant
@@ -409,6 +452,17 @@ func (p *remotePeerImpl) ConsumeRequest(msgID p2pcommon.MsgID) p2pcommon.MsgOrde
 	return nil
 }
 
+// isRequested returns whether the message of originalID is a response to request which is sent to this peer.
+func (p *remotePeerImpl) isRequested(originalID p2pcommon.MsgID) bool {
+	if originalID == p2pcommon.EmptyID {
+		return false
+	}
+	p.reqMutex.Lock()
+	defer p.reqMutex.Unlock()
+	_, found := p.requests[originalID]
+	return found
+}
+
 // requestIDNotFoundReceiver is to handle response msg which the original message is not identified
 func (p *remotePeerImpl) requestIDNotFoundReceiver(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) bool {
 	return true
@@ -456,7 +510,11 @@ func (p *remotePeerImpl) sendTxNotices() {
 		}
 		hashes := make([][]byte, 0, p.txNoticeQueue.Size())
 		skippedTxIDs := make([]types.TxID, 0)
-		for element := p.txNoticeQueue.Poll(); element != nil; element = p.txNoticeQueue.Poll() {
+		for len(hashes) < p.maxTxNoticeHashSize {
+			element := p.txNoticeQueue.Poll()
+			if element == nil {
+				break
+			}
 			hash := element.(types.TxID)
 			if p.txHashCache.Contains(hash) {
 				skippedTxIDs = append(skippedTxIDs, hash)
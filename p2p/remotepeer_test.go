@@ -455,14 +455,17 @@ func TestRemotePeerImpl_pushTxsNotice(t *testing.T) {
 	tests := []struct {
 		name       string
 		in         []types.TxID
+		known      int
 		expectSend int
 	}{
 		// 1. single tx
-		{"TSingle", sampleHashes[:1], 0},
+		{"TSingle", sampleHashes[:1], 0, 0},
 		// 2, multiple tx less than capacity
-		{"TSmall", sampleHashes[:maxTxHashSize], 0},
+		{"TSmall", sampleHashes[:maxTxHashSize], 0, 0},
 		// 3. multiple tx more than capacity. last one is not sent but just queued.
-		{"TLarge", sampleHashes[:maxTxHashSize*3+1], 3},
+		{"TLarge", sampleHashes[:maxTxHashSize*3+1], 0, 3},
+		// 4. txs that remote peer already knows are not queued.
+		{"TKnown", sampleHashes[:maxTxHashSize*3+1], maxTxHashSize * 2, 1},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -481,6 +484,7 @@ func TestRemotePeerImpl_pushTxsNotice(t *testing.T) {
 			p := newRemotePeer(sampleRemote, 0, mockPeerManager, nil, logger, mockMF, mockSigner, nil)
 			p.txNoticeQueue = p2putil.NewPressableQueue(maxTxHashSize)
 			p.maxTxNoticeHashSize = maxTxHashSize
+			p.UpdateTxCache(test.in[:test.known])
 
 			p.PushTxsNotice(test.in)
 		})
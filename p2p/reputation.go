@@ -0,0 +1,156 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2p
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/p2putil"
+	"github.com/aergoio/aergo/types"
+)
+
+const (
+	// ReputationBanThreshold is the penalty score to disconnect and ban the remote peer
+	ReputationBanThreshold = 100.0
+	// ReputationHalfLife is the duration in which the penalty score of peer becomes half
+	ReputationHalfLife = time.Minute * 10
+	// ReputationBanDuration is how long the peer with bad reputation is banned
+	ReputationBanDuration = time.Minute * 30
+
+	// scores lower than this are forgotten
+	reputationForgetScore = 1.0
+)
+
+// penaltyScores are the score added to peer for each reason
+var penaltyScores = map[message.PenaltyReason]float64{
+	message.PenaltyFailResponse:    5,
+	message.PenaltyInvalidResponse: 20,
+	message.PenaltyTimeout:         10,
+	message.PenaltyInvalidBlock:    50,
+	message.PenaltyRateExceeded:    20,
+}
+
+type peerScore struct {
+	score   float64
+	updated time.Time
+}
+
+// decay reduces score by the time elapsed from the last update
+func (ps *peerScore) decay(now time.Time) {
+	elapsed := now.Sub(ps.updated)
+	if elapsed <= 0 {
+		return
+	}
+	ps.score *= math.Exp2(-float64(elapsed) / float64(ReputationHalfLife))
+	ps.updated = now
+}
+
+type reputationManager struct {
+	logger *log.Logger
+	pm     p2pcommon.PeerManager
+	lm     p2pcommon.ListManager
+
+	mutex  sync.Mutex
+	scores map[types.PeerID]*peerScore
+	banCnt int
+}
+
+func newReputationManager(pm p2pcommon.PeerManager, lm p2pcommon.ListManager, logger *log.Logger) p2pcommon.ReputationManager {
+	return &reputationManager{logger: logger, pm: pm, lm: lm, scores: make(map[types.PeerID]*peerScore)}
+}
+
+func (rm *reputationManager) Penalize(pid types.PeerID, reason message.PenaltyReason) {
+	now := time.Now()
+	rm.mutex.Lock()
+	rm.pruneScores(now)
+	ps, exist := rm.scores[pid]
+	if !exist {
+		ps = &peerScore{updated: now}
+		rm.scores[pid] = ps
+	}
+	ps.decay(now)
+	ps.score += penaltyScores[reason]
+	score := ps.score
+	toBan := math.Round(score) >= ReputationBanThreshold
+	if toBan {
+		// peer starts again with clean score after the ban is expired
+		delete(rm.scores, pid)
+		rm.banCnt++
+	}
+	rm.mutex.Unlock()
+
+	rm.logger.Debug().Str(p2putil.LogPeerID, p2putil.ShortForm(pid)).Str("reason", reason.String()).Float64("score", score).Msg("peer is penalized")
+	if toBan {
+		rm.ban(pid, now.Add(ReputationBanDuration))
+	}
+}
+
+// ban disconnects the peer and bans it until the time.
+func (rm *reputationManager) ban(pid types.PeerID, until time.Time) {
+	peer, connected := rm.pm.GetPeer(pid)
+	// bps are not banned for the same reason as whitelist, and neither are designated peers
+	if connected && peer.AcceptedRole() == types.PeerRole_Producer {
+		rm.logger.Warn().Str(p2putil.LogPeerName, peer.Name()).Msg("producer peer has bad reputation, but not banned")
+		return
+	}
+	if connected && peer.RemoteInfo().Designated {
+		rm.logger.Warn().Str(p2putil.LogPeerName, peer.Name()).Msg("designated peer has bad reputation, but not banned")
+		return
+	}
+	rm.logger.Info().Str(p2putil.LogPeerID, p2putil.ShortForm(pid)).Time("until", until).Msg("banning peer by bad reputation")
+	rm.lm.AddTempBan(pid, until)
+	if connected {
+		peer.Stop()
+	}
+}
+
+func (rm *reputationManager) Score(pid types.PeerID) int {
+	rm.mutex.Lock()
+	defer rm.mutex.Unlock()
+	ps, exist := rm.scores[pid]
+	if !exist {
+		return 0
+	}
+	ps.decay(time.Now())
+	return int(math.Round(ps.score))
+}
+
+// pruneScores removes scores that became small enough. It must be called inside mutex
+func (rm *reputationManager) pruneScores(now time.Time) {
+	for pid, ps := range rm.scores {
+		ps.decay(now)
+		if ps.score < reputationForgetScore {
+			delete(rm.scores, pid)
+		}
+	}
+}
+
+func (rm *reputationManager) Summary() map[string]interface{} {
+	rm.mutex.Lock()
+	defer rm.mutex.Unlock()
+	sum := make(map[string]interface{})
+	sum["penalized"] = len(rm.scores)
+	sum["banned"] = rm.banCnt
+	return sum
+}
+
+// penalizePeer reports the misbehavior of remote peer to p2p actor.
+func penalizePeer(actor p2pcommon.ActorService, pid types.PeerID, reason message.PenaltyReason) {
+	actor.TellRequest(message.P2PSvc, &message.PenalizePeer{ToWhom: pid, Reason: reason})
+}
+
+// penaltyReasonOf returns the penalty reason for the error of receivers
+func penaltyReasonOf(err error) message.PenaltyReason {
+	if err == message.RemotePeerFailError {
+		return message.PenaltyFailResponse
+	}
+	return message.PenaltyInvalidResponse
+}
@@ -0,0 +1,101 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2p
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/p2pmock"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/mock/gomock"
+)
+
+func TestReputationManager_Penalize(t *testing.T) {
+	logger := log.NewLogger("test.p2p")
+	pid := types.RandomPeerID()
+
+	tests := []struct {
+		name       string
+		reasons    []message.PenaltyReason
+		connected  bool
+		role       types.PeerRole
+		designated bool
+
+		wantBan   bool
+		wantStop  bool
+		wantScore int
+	}{
+		{"TSingle", []message.PenaltyReason{message.PenaltyFailResponse}, true, types.PeerRole_Watcher, false, false, false, 5},
+		{"TAccum", []message.PenaltyReason{message.PenaltyTimeout, message.PenaltyInvalidResponse, message.PenaltyInvalidBlock}, true, types.PeerRole_Watcher, false, false, false, 80},
+		{"TBan", []message.PenaltyReason{message.PenaltyInvalidBlock, message.PenaltyInvalidBlock}, true, types.PeerRole_Watcher, false, true, true, 0},
+		{"TBanNotConn", []message.PenaltyReason{message.PenaltyInvalidBlock, message.PenaltyInvalidBlock}, false, types.PeerRole_Watcher, false, true, false, 0},
+		// bp is not banned
+		{"TProducer", []message.PenaltyReason{message.PenaltyInvalidBlock, message.PenaltyInvalidBlock}, true, types.PeerRole_Producer, false, false, false, 0},
+		// designated peer is not banned either
+		{"TDesignated", []message.PenaltyReason{message.PenaltyInvalidBlock, message.PenaltyInvalidBlock}, true, types.PeerRole_Watcher, true, false, false, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPM := p2pmock.NewMockPeerManager(ctrl)
+			mockLM := p2pmock.NewMockListManager(ctrl)
+			mockPeer := p2pmock.NewMockRemotePeer(ctrl)
+			mockPeer.EXPECT().Name().Return(pid.String()).AnyTimes()
+			mockPeer.EXPECT().AcceptedRole().Return(tt.role).AnyTimes()
+			mockPeer.EXPECT().RemoteInfo().Return(p2pcommon.RemoteInfo{Designated: tt.designated}).AnyTimes()
+			mockPM.EXPECT().GetPeer(pid).Return(mockPeer, tt.connected).AnyTimes()
+
+			banCnt, stopCnt := 0, 0
+			if tt.wantBan {
+				banCnt = 1
+			}
+			if tt.wantStop {
+				stopCnt = 1
+			}
+			mockLM.EXPECT().AddTempBan(pid, gomock.Any()).Times(banCnt)
+			mockPeer.EXPECT().Stop().Times(stopCnt)
+
+			rm := newReputationManager(mockPM, mockLM, logger)
+			for _, r := range tt.reasons {
+				rm.Penalize(pid, r)
+			}
+			if got := rm.Score(pid); got != tt.wantScore {
+				t.Errorf("Score() = %v, want %v", got, tt.wantScore)
+			}
+		})
+	}
+}
+
+func TestPeerScore_decay(t *testing.T) {
+	base := time.Now()
+	tests := []struct {
+		name    string
+		elapsed time.Duration
+
+		want float64
+	}{
+		{"TNone", 0, 100},
+		{"TPast", -time.Minute, 100},
+		{"THalf", ReputationHalfLife, 50},
+		{"TQuarter", ReputationHalfLife * 2, 25},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ps := &peerScore{score: 100, updated: base}
+			ps.decay(base.Add(tt.elapsed))
+			if math.Abs(ps.score-tt.want) > 0.0001 {
+				t.Errorf("decay() = %v, want %v", ps.score, tt.want)
+			}
+		})
+	}
+}
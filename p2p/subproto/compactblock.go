@@ -0,0 +1,150 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package subproto
+
+import (
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/p2putil"
+	"github.com/aergoio/aergo/types"
+)
+
+type compactBlockRequestHandler struct {
+	BaseMsgHandler
+}
+
+var _ p2pcommon.MessageHandler = (*compactBlockRequestHandler)(nil)
+
+type compactBlockResponseHandler struct {
+	BaseMsgHandler
+}
+
+var _ p2pcommon.MessageHandler = (*compactBlockResponseHandler)(nil)
+
+type blockTxsRequestHandler struct {
+	BaseMsgHandler
+}
+
+var _ p2pcommon.MessageHandler = (*blockTxsRequestHandler)(nil)
+
+type blockTxsResponseHandler struct {
+	BaseMsgHandler
+}
+
+var _ p2pcommon.MessageHandler = (*blockTxsResponseHandler)(nil)
+
+// NewCompactBlockReqHandler creates handler for GetCompactBlockRequest
+func NewCompactBlockReqHandler(pm p2pcommon.PeerManager, peer p2pcommon.RemotePeer, logger *log.Logger, actor p2pcommon.ActorService) *compactBlockRequestHandler {
+	bh := &compactBlockRequestHandler{BaseMsgHandler{protocol: p2pcommon.GetCompactBlockRequest, pm: pm, peer: peer, actor: actor, logger: logger}}
+	return bh
+}
+
+func (bh *compactBlockRequestHandler) ParsePayload(rawbytes []byte) (p2pcommon.MessageBody, error) {
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.GetCompactBlockRequest{})
+}
+
+func (bh *compactBlockRequestHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) {
+	remotePeer := bh.peer
+	data := msgBody.(*types.GetCompactBlockRequest)
+	p2putil.DebugLogReceive(bh.logger, bh.protocol, msg.ID().String(), remotePeer, data)
+
+	resp := &types.GetCompactBlockResponse{Status: types.ResultStatus_OK}
+	block, err := bh.actor.GetChainAccessor().GetBlock(data.BlockHash)
+	if err != nil {
+		bh.logger.Warn().Err(err).Str(p2putil.LogBlkHash, enc.ToString(data.BlockHash)).Str(p2putil.LogMsgID, msg.ID().String()).Msg("failed to get block while processing getCompactBlock")
+		resp.Status = types.ResultStatus_INTERNAL
+	} else if block == nil {
+		resp.Status = types.ResultStatus_NOT_FOUND
+	} else {
+		txs := block.GetBody().GetTxs()
+		resp.Header = block.GetHeader()
+		resp.ShortTxIDs = make([][]byte, len(txs))
+		for i, tx := range txs {
+			resp.ShortTxIDs[i] = types.ShortTxID(data.BlockHash, tx.GetHash())
+		}
+	}
+	remotePeer.SendMessage(remotePeer.MF().NewMsgResponseOrder(msg.ID(), p2pcommon.GetCompactBlockResponse, resp))
+}
+
+// NewCompactBlockRespHandler creates handler for GetCompactBlockResponse
+func NewCompactBlockRespHandler(pm p2pcommon.PeerManager, peer p2pcommon.RemotePeer, logger *log.Logger, actor p2pcommon.ActorService) *compactBlockResponseHandler {
+	bh := &compactBlockResponseHandler{BaseMsgHandler{protocol: p2pcommon.GetCompactBlockResponse, pm: pm, peer: peer, actor: actor, logger: logger}}
+	return bh
+}
+
+func (bh *compactBlockResponseHandler) ParsePayload(rawbytes []byte) (p2pcommon.MessageBody, error) {
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.GetCompactBlockResponse{})
+}
+
+func (bh *compactBlockResponseHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) {
+	remotePeer := bh.peer
+	data := msgBody.(*types.GetCompactBlockResponse)
+	p2putil.DebugLogReceiveResponse(bh.logger, bh.protocol, msg.ID().String(), msg.OriginalID().String(), remotePeer, data)
+
+	if !remotePeer.GetReceiver(msg.OriginalID())(msg, data) {
+		remotePeer.ConsumeRequest(msg.OriginalID())
+		bh.logger.Debug().Str(p2putil.LogMsgID, msg.ID().String()).Str(p2putil.LogOrgReqID, msg.OriginalID().String()).Msg("unknown getCompactBlock response")
+	}
+}
+
+// NewBlockTxsReqHandler creates handler for GetBlockTxsRequest
+func NewBlockTxsReqHandler(pm p2pcommon.PeerManager, peer p2pcommon.RemotePeer, logger *log.Logger, actor p2pcommon.ActorService) *blockTxsRequestHandler {
+	bh := &blockTxsRequestHandler{BaseMsgHandler{protocol: p2pcommon.GetBlockTxsRequest, pm: pm, peer: peer, actor: actor, logger: logger}}
+	return bh
+}
+
+func (bh *blockTxsRequestHandler) ParsePayload(rawbytes []byte) (p2pcommon.MessageBody, error) {
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.GetBlockTxsRequest{})
+}
+
+func (bh *blockTxsRequestHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) {
+	remotePeer := bh.peer
+	data := msgBody.(*types.GetBlockTxsRequest)
+	p2putil.DebugLogReceive(bh.logger, bh.protocol, msg.ID().String(), remotePeer, data)
+
+	resp := &types.GetBlockTxsResponse{Status: types.ResultStatus_OK}
+	block, err := bh.actor.GetChainAccessor().GetBlock(data.BlockHash)
+	if err != nil {
+		bh.logger.Warn().Err(err).Str(p2putil.LogBlkHash, enc.ToString(data.BlockHash)).Str(p2putil.LogMsgID, msg.ID().String()).Msg("failed to get block while processing getBlockTxs")
+		resp.Status = types.ResultStatus_INTERNAL
+	} else if block == nil {
+		resp.Status = types.ResultStatus_NOT_FOUND
+	} else {
+		txs := block.GetBody().GetTxs()
+		resp.Txs = make([]*types.Tx, 0, len(data.Indexes))
+		for _, idx := range data.Indexes {
+			if int(idx) >= len(txs) {
+				resp.Status = types.ResultStatus_INVALID_ARGUMENT
+				resp.Txs = nil
+				break
+			}
+			resp.Txs = append(resp.Txs, txs[idx])
+		}
+	}
+	remotePeer.SendMessage(remotePeer.MF().NewMsgResponseOrder(msg.ID(), p2pcommon.GetBlockTxsResponse, resp))
+}
+
+// NewBlockTxsRespHandler creates handler for GetBlockTxsResponse
+func NewBlockTxsRespHandler(pm p2pcommon.PeerManager, peer p2pcommon.RemotePeer, logger *log.Logger, actor p2pcommon.ActorService) *blockTxsResponseHandler {
+	bh := &blockTxsResponseHandler{BaseMsgHandler{protocol: p2pcommon.GetBlockTxsResponse, pm: pm, peer: peer, actor: actor, logger: logger}}
+	return bh
+}
+
+func (bh *blockTxsResponseHandler) ParsePayload(rawbytes []byte) (p2pcommon.MessageBody, error) {
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.GetBlockTxsResponse{})
+}
+
+func (bh *blockTxsResponseHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) {
+	remotePeer := bh.peer
+	data := msgBody.(*types.GetBlockTxsResponse)
+	p2putil.DebugLogReceiveResponse(bh.logger, bh.protocol, msg.ID().String(), msg.OriginalID().String(), remotePeer, data)
+
+	if !remotePeer.GetReceiver(msg.OriginalID())(msg, data) {
+		remotePeer.ConsumeRequest(msg.OriginalID())
+		bh.logger.Debug().Str(p2putil.LogMsgID, msg.ID().String()).Str(p2putil.LogOrgReqID, msg.OriginalID().String()).Msg("unknown getBlockTxs response")
+	}
+}
@@ -0,0 +1,125 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package subproto
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/p2pmock"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/mock/gomock"
+)
+
+func TestCompactBlockRequestHandler_Handle(t *testing.T) {
+	logger := log.NewLogger("test.subproto")
+	txs := []*types.Tx{{Hash: []byte("tx1")}, {Hash: []byte("tx2")}, {Hash: []byte("tx3")}}
+	sampleBlock := &types.Block{Hash: []byte("block"), Header: &types.BlockHeader{BlockNo: 10}, Body: &types.BlockBody{Txs: txs}}
+
+	tests := []struct {
+		name  string
+		block *types.Block
+		err   error
+
+		wantStatus types.ResultStatus
+		wantHashes int
+	}{
+		{"TFound", sampleBlock, nil, types.ResultStatus_OK, 3},
+		{"TNotFound", nil, nil, types.ResultStatus_NOT_FOUND, 0},
+		{"TError", nil, errors.New("db error"), types.ResultStatus_INTERNAL, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPM := p2pmock.NewMockPeerManager(ctrl)
+			mockPeer := p2pmock.NewMockRemotePeer(ctrl)
+			mockActor := p2pmock.NewMockActorService(ctrl)
+			mockCA := p2pmock.NewMockChainAccessor(ctrl)
+			mockMF := &testDoubleMOFactory{}
+			mockPeer.EXPECT().MF().Return(mockMF).AnyTimes()
+			mockPeer.EXPECT().Name().Return("16..aadecf@1").AnyTimes()
+			mockPeer.EXPECT().SendMessage(gomock.Any()).Times(1)
+			mockActor.EXPECT().GetChainAccessor().Return(mockCA)
+			mockCA.EXPECT().GetBlock(gomock.Any()).Return(tt.block, tt.err)
+
+			h := NewCompactBlockReqHandler(mockPM, mockPeer, logger, mockActor)
+			msg := p2pcommon.NewSimpleMsgVal(p2pcommon.GetCompactBlockRequest, p2pcommon.NewMsgID())
+			h.Handle(msg, &types.GetCompactBlockRequest{BlockHash: []byte("block")})
+
+			if mockMF.lastStatus != tt.wantStatus {
+				t.Errorf("status %v, want %v", mockMF.lastStatus, tt.wantStatus)
+			}
+			resp := mockMF.lastResp.(*types.GetCompactBlockResponse)
+			if len(resp.ShortTxIDs) != tt.wantHashes {
+				t.Errorf("short tx ids count %v, want %v", len(resp.ShortTxIDs), tt.wantHashes)
+			}
+			for i, id := range resp.ShortTxIDs {
+				if !bytes.Equal(id, types.ShortTxID([]byte("block"), txs[i].Hash)) {
+					t.Errorf("short tx id of tx %v is %v", i, id)
+				}
+			}
+		})
+	}
+}
+
+func TestBlockTxsRequestHandler_Handle(t *testing.T) {
+	logger := log.NewLogger("test.subproto")
+	txs := []*types.Tx{{Hash: []byte("tx1")}, {Hash: []byte("tx2")}, {Hash: []byte("tx3")}}
+	sampleBlock := &types.Block{Hash: []byte("block"), Header: &types.BlockHeader{BlockNo: 10}, Body: &types.BlockBody{Txs: txs}}
+
+	tests := []struct {
+		name    string
+		block   *types.Block
+		indexes []uint32
+
+		wantStatus types.ResultStatus
+		wantTxs    []*types.Tx
+	}{
+		{"TSingle", sampleBlock, []uint32{1}, types.ResultStatus_OK, txs[1:2]},
+		{"TMulti", sampleBlock, []uint32{0, 2}, types.ResultStatus_OK, []*types.Tx{txs[0], txs[2]}},
+		{"TOutOfRange", sampleBlock, []uint32{0, 3}, types.ResultStatus_INVALID_ARGUMENT, nil},
+		{"TNotFound", nil, []uint32{0}, types.ResultStatus_NOT_FOUND, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPM := p2pmock.NewMockPeerManager(ctrl)
+			mockPeer := p2pmock.NewMockRemotePeer(ctrl)
+			mockActor := p2pmock.NewMockActorService(ctrl)
+			mockCA := p2pmock.NewMockChainAccessor(ctrl)
+			mockMF := &testDoubleMOFactory{}
+			mockPeer.EXPECT().MF().Return(mockMF).AnyTimes()
+			mockPeer.EXPECT().Name().Return("16..aadecf@1").AnyTimes()
+			mockPeer.EXPECT().SendMessage(gomock.Any()).Times(1)
+			mockActor.EXPECT().GetChainAccessor().Return(mockCA)
+			mockCA.EXPECT().GetBlock(gomock.Any()).Return(tt.block, nil)
+
+			h := NewBlockTxsReqHandler(mockPM, mockPeer, logger, mockActor)
+			msg := p2pcommon.NewSimpleMsgVal(p2pcommon.GetBlockTxsRequest, p2pcommon.NewMsgID())
+			h.Handle(msg, &types.GetBlockTxsRequest{BlockHash: []byte("block"), Indexes: tt.indexes})
+
+			if mockMF.lastStatus != tt.wantStatus {
+				t.Errorf("status %v, want %v", mockMF.lastStatus, tt.wantStatus)
+			}
+			resp := mockMF.lastResp.(*types.GetBlockTxsResponse)
+			if len(resp.Txs) != len(tt.wantTxs) {
+				t.Fatalf("txs count %v, want %v", len(resp.Txs), len(tt.wantTxs))
+			}
+			for i, tx := range resp.Txs {
+				if tx != tt.wantTxs[i] {
+					t.Errorf("tx[%d] %v, want %v", i, tx, tt.wantTxs[i])
+				}
+			}
+		})
+	}
+}
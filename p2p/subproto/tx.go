@@ -51,6 +51,15 @@ func (th *txRequestHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.Mess
 	body := msgBody.(*types.GetTransactionsRequest)
 	p2putil.DebugLogReceive(th.logger, th.protocol, msg.ID().String(), remotePeer, body)
 
+	// remote peer knows requested txs, and it will have them soon, so they need not be noticed to that peer.
+	reqIDs := make([]types.TxID, 0, len(body.Hashes))
+	for _, hash := range body.Hashes {
+		if tid, err := types.ParseToTxID(hash); err == nil {
+			reqIDs = append(reqIDs, tid)
+		}
+	}
+	remotePeer.UpdateTxCache(reqIDs)
+
 	if err := th.sm.HandleGetTxReq(remotePeer, msg.ID(), body); err != nil {
 		th.logger.Info().Str(p2putil.LogPeerName, remotePeer.Name()).Str(p2putil.LogMsgID, msg.ID().String()).Err(err).Msg("return err for concurrent get tx request")
 		resp := &types.GetTransactionsResponse{
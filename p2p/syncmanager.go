@@ -89,6 +89,11 @@ func (sm *syncManager) HandleNewBlockNotice(peer p2pcommon.RemotePeer, data *typ
 	foundBlock, _ := sm.actor.GetChainAccessor().GetBlock(data.BlockHash)
 	if foundBlock == nil {
 		sm.logger.Debug().Str(p2putil.LogBlkHash, enc.ToString(data.BlockHash)).Str(p2putil.LogPeerName, peer.Name()).Msg("new block notice of unknown hash. request back to notifier")
+		if peer.RemoteInfo().Version >= p2pcommon.P2PVersion210 {
+			// reconstruct block with txs in mempool, instead of receiving whole block
+			NewCompactBlockReceiver(sm.actor, peer, data.BlockHash, compactBlockTTL, sm.logger).StartGet()
+			return
+		}
 		sm.actor.SendRequest(message.P2PSvc, &message.GetBlockInfos{ToWhom: peerID,
 			Hashes: []message.BlockHash{message.BlockHash(data.BlockHash)}})
 	}
@@ -132,6 +137,10 @@ func (sm *syncManager) RetryGetTx(peer p2pcommon.RemotePeer, hashes [][]byte) {
 	sm.tm.retryGetTx(peer.ID(), hashes)
 }
 
+func (sm *syncManager) RetryGetTxFromOthers(peer p2pcommon.RemotePeer, hashes [][]byte) {
+	sm.tm.retryGetTxFromOthers(peer.ID(), hashes)
+}
+
 
 func (sm *syncManager) Summary() map[string]interface{} {
 	type sizes struct {
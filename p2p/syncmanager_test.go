@@ -93,6 +93,23 @@ func TestSyncManager_HandleNewBlockNotice(t *testing.T) {
 				copy(blkHash[:], dummyBlockHash)
 				actor.EXPECT().SendRequest(message.P2PSvc, gomock.Any())
 				peer.EXPECT().Name().Return("16..aadecf@1")
+				peer.EXPECT().RemoteInfo().Return(p2pcommon.RemoteInfo{Version: p2pcommon.P2PVersion200})
+				return blkHash, &types.NewBlockNotice{BlockHash: dummyBlockHash}
+			}},
+		// 1-2. Succ : remote peer supports compact block
+		{"TSuccCompact", nil, false,
+			func(tt *testing.T, actor *p2pmock.MockActorService, ca *p2pmock.MockChainAccessor, peer *p2pmock.MockRemotePeer) (types.BlockID, *types.NewBlockNotice) {
+				ca.EXPECT().GetBlock(gomock.Any()).Return(nil, nil)
+				actor.EXPECT().GetChainAccessor().Return(ca)
+				copy(blkHash[:], dummyBlockHash)
+				actor.EXPECT().SendRequest(message.P2PSvc, gomock.Any()).MaxTimes(0)
+				peer.EXPECT().Name().Return("16..aadecf@1")
+				peer.EXPECT().RemoteInfo().Return(p2pcommon.RemoteInfo{Version: p2pcommon.P2PVersion210})
+				mockMF := p2pmock.NewMockMoFactory(ctrl)
+				mockMo := createDummyMo(ctrl)
+				peer.EXPECT().MF().Return(mockMF)
+				mockMF.EXPECT().NewMsgRequestOrderWithReceiver(gomock.Any(), p2pcommon.GetCompactBlockRequest, gomock.Any()).Return(mockMo)
+				peer.EXPECT().SendMessage(mockMo)
 				return blkHash, &types.NewBlockNotice{BlockHash: dummyBlockHash}
 			}},
 		// 1-1. Succ : valid block hash and exist in chainsvc, but not in cache
@@ -223,6 +223,18 @@ func (tm *syncTxManager) retryGetTx(peerID types.PeerID, hashes [][]byte) {
 	}
 }
 
+// retryGetTxFromOthers makes txs, which the peer did not send, be queried to other peers in next turn.
+func (tm *syncTxManager) retryGetTxFromOthers(peerID types.PeerID, hashes [][]byte) {
+	tm.taskChannel <- func() {
+		txIDs := make([]types.TxID,len(hashes))
+		for i, hash := range hashes {
+			txIDs[i] = types.ToTxID(hash)
+		}
+		tm.logger.Debug().Str(p2putil.LogPeerID, p2putil.ShortForm(peerID)).Array("txIDs", types.NewLogTxIDsMarshaller(txIDs, 10)).Msg("query txs to other peers, that are failed to get from peer")
+		tm.burnFailedTxFrontCache(peerID, txIDs)
+	}
+}
+
 func (tm *syncTxManager) pushBackToFrontCache(peerID types.PeerID, txIDs []types.TxID) {
 	// this method is called when the sending is failed by remote peer is busy.
 	// resetting last sent time will trigger immediate query of that tx.
@@ -34,6 +34,7 @@ type GetTxsReceiver struct {
 	timeout  time.Time
 	finished bool
 	status   receiverStatus
+	retried  bool
 
 	inOffset       int
 	offset         int
@@ -94,10 +95,12 @@ func (br *GetTxsReceiver) handleInWaiting(msg p2pcommon.Message, msgBody p2pcomm
 	// malformed responses means that later responses will be also malformed..
 	respBody, ok := msgBody.(types.ResponseMessage)
 	if !ok || respBody.GetStatus() != types.ResultStatus_OK {
-		br.cancelReceiving(message.RemotePeerFailError, false)
-		if respBody.GetStatus() == types.ResultStatus_RESOURCE_EXHAUSTED {
+		if ok && respBody.GetStatus() == types.ResultStatus_RESOURCE_EXHAUSTED {
+			// remote peer will be queried again later, so no need to query to others
 			br.sm.RetryGetTx(br.peer, br.hashes)
+			br.offset = len(br.hashes)
 		}
+		br.cancelReceiving(message.RemotePeerFailError, false)
 		return
 	}
 	// remote peer response malformed data.
@@ -117,7 +120,7 @@ func (br *GetTxsReceiver) handleInWaiting(msg p2pcommon.Message, msgBody p2pcomm
 		// missing tx
 		for !bytes.Equal(br.hashes[br.offset], tx.Hash) {
 			br.logger.Trace().Str("expect",enc.ToString(br.hashes[br.offset])).Str("received",enc.ToString(tx.Hash)).Int("offset",br.offset).Msg("expected hash was missing")
-			br.missed = append(br.missed,tx.Hash)
+			br.missed = append(br.missed,br.hashes[br.offset])
 			br.offset++
 			if br.offset >= len(br.hashes) {
 				br.cancelReceiving(message.UnexpectedBlockError, body.HasNext)
@@ -140,6 +143,10 @@ func (br *GetTxsReceiver) handleInWaiting(msg p2pcommon.Message, msgBody p2pcomm
 func (br *GetTxsReceiver) cancelReceiving(err error, hasNext bool) {
 	br.status = receiverStatusCanceled
 	br.logger.Info().Str(p2putil.LogOrgReqID,br.requestID.String()).Err(err).Msg("tx receiver canceled by error")
+	// failure status is not penalized, since txs in the mempool of remote peer can be evicted at any time
+	if err != message.RemotePeerFailError {
+		penalizePeer(br.actor, br.peer.ID(), message.PenaltyInvalidResponse)
+	}
 	// check time again. since negative duration of timer will not fire channel.
 	interval := br.timeout.Sub(time.Now())
 	if !hasNext || interval <= 0 {
@@ -147,6 +154,7 @@ func (br *GetTxsReceiver) cancelReceiving(err error, hasNext bool) {
 		br.finishReceiver()
 	} else {
 		// canceling in the middle of responses
+		br.retryFromOthers()
 		br.senderFinished = make(chan interface{})
 		go func() {
 			timer := time.NewTimer(interval)
@@ -165,9 +173,27 @@ func (br *GetTxsReceiver) cancelReceiving(err error, hasNext bool) {
 func (br *GetTxsReceiver) finishReceiver() {
 	br.status = receiverStatusFinished
 	br.peer.ConsumeRequest(br.requestID)
+	br.retryFromOthers()
 	br.logger.Debug().Int("mpSent",br.sent).Int("missed",len(br.missed)).Str(p2putil.LogOrgReqID,br.requestID.String()).Msg("tx receiver finished")
 }
 
+// retryFromOthers hands over missed txs and txs not received yet to syncManager, to get them from other peers
+// instead of waiting for query timeout. It is done only once for a receiver.
+func (br *GetTxsReceiver) retryFromOthers() {
+	if br.retried {
+		return
+	}
+	br.retried = true
+	failed := make([][]byte, 0, len(br.missed)+len(br.hashes))
+	failed = append(failed, br.missed...)
+	if br.offset < len(br.hashes) {
+		failed = append(failed, br.hashes[br.offset:]...)
+	}
+	if len(failed) > 0 {
+		br.sm.RetryGetTxFromOthers(br.peer, failed)
+	}
+}
+
 // ignoreMsg is silently ignore following responses, which is not useless anymore.
 func (br *GetTxsReceiver) ignoreMsg(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) {
 	body, ok := msgBody.(*types.GetTransactionsResponse)
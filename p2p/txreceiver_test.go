@@ -6,6 +6,7 @@
 package p2p
 
 import (
+	"bytes"
 	"github.com/aergoio/aergo/p2p/p2pcommon"
 	"testing"
 	"time"
@@ -78,21 +79,22 @@ func TestGetTxsReceiver_ReceiveResp(t *testing.T) {
 		wantMiss int
 		wantErr  bool
 		wantConsume bool
+		wantRetry int
 	}{
-		{"TSingleResp", inputHashes,  [][]*types.Tx{inTXs}, inSize,0,  false,true},
-		{"TMultiResp", inputHashes, [][]*types.Tx{inTXs[:1], inTXs[1:3], inTXs[3:]}, inSize,0,  false,true},
+		{"TSingleResp", inputHashes,  [][]*types.Tx{inTXs}, inSize,0,  false,true,0},
+		{"TMultiResp", inputHashes, [][]*types.Tx{inTXs[:1], inTXs[1:3], inTXs[3:]}, inSize,0,  false,true,0},
 		// Fail1 remote err
-		{"TRemoteFail", inputHashes, [][]*types.Tx{inTXs[:0]}, 0,  0,true,true},
+		{"TRemoteFail", inputHashes, [][]*types.Tx{inTXs[:0]}, 0,  0,true,true,1},
 		// server didn't sent last parts. and it is very similar to timeout
 		//{"TNotComplete", inputHashes, time.Minute,0,[][]*types.Block{inTXs[:2]},1,0, false},
 		// Fail2 missing some blocks in the middle
-		{"TMissingBlk", inputHashes, [][]*types.Tx{inTXs[:1], inTXs[2:3], inTXs[3:]},inSize-1, 1,false,true},
+		{"TMissingBlk", inputHashes, [][]*types.Tx{inTXs[:1], inTXs[2:3], inTXs[3:]},inSize-1, 1,false,true,1},
 		// Fail2-1 missing some blocks in last
-		{"TMissingBlkLast", inputHashes, [][]*types.Tx{inTXs[:1], inTXs[1:2], inTXs[3:]},inSize-1, 1,false,true},
+		{"TMissingBlkLast", inputHashes, [][]*types.Tx{inTXs[:1], inTXs[1:2], inTXs[3:]},inSize-1, 1,false,true,1},
 		// Fail3 unexpected block
-		{"TDupBlock", inputHashes, [][]*types.Tx{inTXs[:2], inTXs[1:3], inTXs[3:]},2, 0,true, false},
-		{"TTooManyBlks", inputHashes[:4], [][]*types.Tx{inTXs[:1], inTXs[1:3], inTXs[3:]},4, 0,true,true},
-		{"TTooManyBlksMiddle", inputHashes[:2], [][]*types.Tx{inTXs[:1], inTXs[1:3], inTXs[3:]},2,0, true,false},
+		{"TDupBlock", inputHashes, [][]*types.Tx{inTXs[:2], inTXs[1:3], inTXs[3:]},2, 0,true, false,1},
+		{"TTooManyBlks", inputHashes[:4], [][]*types.Tx{inTXs[:1], inTXs[1:3], inTXs[3:]},4, 0,true,true,0},
+		{"TTooManyBlksMiddle", inputHashes[:2], [][]*types.Tx{inTXs[:1], inTXs[1:3], inTXs[3:]},2,0, true,false,0},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -114,8 +116,14 @@ func TestGetTxsReceiver_ReceiveResp(t *testing.T) {
 				mockPeer.EXPECT().ConsumeRequest(gomock.Any()).Times(1)
 			}
 			mockActor.EXPECT().SendRequest(message.MemPoolSvc, gomock.Any()).Times(test.putCnt)
+			penalties := 0
+			if test.wantErr {
+				penalties = 1
+			}
+			mockActor.EXPECT().TellRequest(message.P2PSvc, gomock.AssignableToTypeOf(&message.PenalizePeer{})).Times(penalties)
 
 			mockSM := p2pmock.NewMockSyncManager(ctrl)
+			mockSM.EXPECT().RetryGetTxFromOthers(mockPeer, gomock.AssignableToTypeOf([][]byte{})).Times(test.wantRetry)
 
 			//expire := time.Now().Add(test.ttl)
 			br := NewGetTxsReceiver(mockActor, mockPeer, mockSM, logger, test.input, time.Hour>>1)
@@ -142,6 +150,40 @@ func TestGetTxsReceiver_ReceiveResp(t *testing.T) {
 }
 
 
+func TestGetTxsReceiver_retryFromOthers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	inputHashes := make([]types.TxID, len(sampleTxs))
+	for i, hash := range sampleTxs {
+		inputHashes[i] = types.ToTxID(hash)
+	}
+	mockActor := p2pmock.NewMockActorService(ctrl)
+	mockPeer := p2pmock.NewMockRemotePeer(ctrl)
+	mockSM := p2pmock.NewMockSyncManager(ctrl)
+	var failed [][]byte
+	mockSM.EXPECT().RetryGetTxFromOthers(mockPeer, gomock.Any()).Do(func(_ p2pcommon.RemotePeer, hashes [][]byte) {
+		failed = hashes
+	}).Times(1)
+
+	br := NewGetTxsReceiver(mockActor, mockPeer, mockSM, logger, inputHashes, time.Hour)
+	br.missed = make([][]byte, 1, len(inputHashes))
+	br.missed[0] = br.hashes[0]
+	br.offset = 2
+	br.retryFromOthers()
+	// retried only once
+	br.retryFromOthers()
+
+	if len(failed) != len(inputHashes)-1 {
+		t.Fatalf("retried %v txs, want %v", len(failed), len(inputHashes)-1)
+	}
+	// missed must not share the array with retried ones
+	br.missed = append(br.missed, br.hashes[1])
+	if !bytes.Equal(failed[1], br.hashes[2]) {
+		t.Errorf("retried hashes are changed by missed")
+	}
+}
+
 func TestGetTxsReceiver_ReceiveRespBusyRemote(t *testing.T) {
 	inputHashes := make([]types.TxID, len(sampleBlks))
 	inTXs := make([]*types.Tx, len(sampleBlks))
@@ -157,9 +199,10 @@ func TestGetTxsReceiver_ReceiveRespBusyRemote(t *testing.T) {
 		wantReGet bool
 		wantErr  bool
 		wantConsume bool
+		wantRetryOthers bool
 	}{
-		{"TRemoteBusy", types.ResultStatus_RESOURCE_EXHAUSTED,  true,  true,true},
-		{"TNotFound", types.ResultStatus_NOT_FOUND,  false,  true,true},
+		{"TRemoteBusy", types.ResultStatus_RESOURCE_EXHAUSTED,  true,  true,true, false},
+		{"TNotFound", types.ResultStatus_NOT_FOUND,  false,  true,true, true},
 
 	}
 	for _, test := range tests {
@@ -186,6 +229,9 @@ func TestGetTxsReceiver_ReceiveRespBusyRemote(t *testing.T) {
 			if test.wantReGet {
 				mockSM.EXPECT().RetryGetTx(gomock.Any(), gomock.AssignableToTypeOf([][]byte{})).Times(1)
 			}
+			if test.wantRetryOthers {
+				mockSM.EXPECT().RetryGetTxFromOthers(gomock.Any(), gomock.AssignableToTypeOf([][]byte{})).Times(1)
+			}
 			//expire := time.Now().Add(test.ttl)
 			br := NewGetTxsReceiver(mockActor, mockPeer, mockSM, logger, inputHashes, time.Minute>>1)
 			br.StartGet()
@@ -240,6 +286,8 @@ func TestGetTxsReceiver_ReceiveRespTimeout(t *testing.T) {
 				mockPeer.EXPECT().ConsumeRequest(gomock.Any()).MinTimes(test.consumed)
 			}
 			mockSM := p2pmock.NewMockSyncManager(ctrl)
+			// txs not received before timeout will be queried to other peers
+			mockSM.EXPECT().RetryGetTxFromOthers(gomock.Any(), gomock.AssignableToTypeOf([][]byte{})).Times(test.consumed)
 			//expire := time.Now().Add(test.ttl)
 			br := NewGetTxsReceiver(mockActor, mockPeer, mockSM, logger, test.input, test.ttl)
 			br.StartGet()
@@ -12,10 +12,17 @@ import (
 	"io"
 
 	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/p2putil"
 )
 
 const msgHeaderLength int = 48
 
+// the highest byte of subprotocol field in header is the compression codec of payload
+const (
+	compressionShift = 24
+	subProtocolMask  = 0x00ffffff
+)
+
 type V030ReadWriter struct {
 	r        *bufio.Reader
 	readBuf  [msgHeaderLength]byte
@@ -23,9 +30,14 @@ type V030ReadWriter struct {
 	writeBuf [msgHeaderLength]byte
 	c        io.Closer
 
+	// compression is the codec to compress payloads of written messages, and the only codec accepted in read
+	compression p2pcommon.Compression
+
 	ls []p2pcommon.MsgIOListener
 }
 
+var _ p2pcommon.Compressible = (*V030ReadWriter)(nil)
+
 func NewV030MsgPipe(s io.ReadWriteCloser) *V030ReadWriter {
 	return NewV030ReadWriter(s, s, s)
 }
@@ -53,6 +65,12 @@ func (rw *V030ReadWriter) AddIOListener(l p2pcommon.MsgIOListener) {
 	rw.ls = append(rw.ls, l)
 }
 
+// EnableCompression makes payloads bigger than threshold to be compressed. It must be called before
+// the readwriter is used by multiple goroutines.
+func (rw *V030ReadWriter) EnableCompression(c p2pcommon.Compression) {
+	rw.compression = c
+}
+
 // ReadMsg() must be used in single thread
 func (rw *V030ReadWriter) ReadMsg() (p2pcommon.Message, error) {
 	readN := 0
@@ -66,7 +84,7 @@ func (rw *V030ReadWriter) ReadMsg() (p2pcommon.Message, error) {
 		return nil, fmt.Errorf("invalid msgHeader")
 	}
 
-	msg, bodyLen := parseHeader(rw.readBuf)
+	msg, codec, bodyLen := parseHeader(rw.readBuf)
 	if bodyLen > p2pcommon.MaxPayloadLength {
 		return nil, fmt.Errorf("too big payload")
 	}
@@ -80,6 +98,23 @@ func (rw *V030ReadWriter) ReadMsg() (p2pcommon.Message, error) {
 		return nil, fmt.Errorf("failed to read paylod of msg %s %s : payload length mismatch", msg.Subprotocol().String(), msg.ID())
 	}
 
+	if codec != p2pcommon.CompressionNone {
+		// remote peer can compress messages only with the codec negotiated in handshake
+		if codec != rw.compression {
+			return nil, fmt.Errorf("not negotiated compression %s of msg %s %s", codec.String(), msg.Subprotocol().String(), msg.ID())
+		}
+		compressed := len(payload)
+		payload, err = p2putil.DecompressPayload(codec, payload, p2pcommon.MaxPayloadLength)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress payload of msg %s %s : %s", msg.Subprotocol().String(), msg.ID(), err.Error())
+		}
+		for _, l := range rw.ls {
+			if cl, ok := l.(p2pcommon.CompressionListener); ok {
+				cl.OnCompressedRead(msg.Subprotocol(), len(payload), compressed)
+			}
+		}
+	}
+
 	msg.SetPayload(payload)
 	for _, l := range rw.ls {
 		l.OnRead(msg.Subprotocol(), readN)
@@ -111,7 +146,24 @@ func (rw *V030ReadWriter) WriteMsg(msg p2pcommon.Message) error {
 		return fmt.Errorf("too big payload")
 	}
 
-	rw.marshalHeader(msg)
+	payload, codec := msg.Payload(), p2pcommon.CompressionNone
+	if rw.compression != p2pcommon.CompressionNone && len(payload) >= p2pcommon.CompressThreshold {
+		compressed, err := p2putil.CompressPayload(rw.compression, payload)
+		if err != nil {
+			return err
+		}
+		// send raw payload if it is not compressible
+		if len(compressed) < len(payload) {
+			for _, l := range rw.ls {
+				if cl, ok := l.(p2pcommon.CompressionListener); ok {
+					cl.OnCompressedWrite(msg.Subprotocol(), len(payload), len(compressed))
+				}
+			}
+			payload, codec = compressed, rw.compression
+		}
+	}
+
+	rw.marshalHeader(msg, codec, uint32(len(payload)))
 	written, err := rw.w.Write(rw.writeBuf[:])
 	if err != nil {
 		return err
@@ -120,12 +172,12 @@ func (rw *V030ReadWriter) WriteMsg(msg p2pcommon.Message) error {
 	if written != msgHeaderLength {
 		return fmt.Errorf("header is not written")
 	}
-	written, err = rw.w.Write(msg.Payload())
+	written, err = rw.w.Write(payload)
 	if err != nil {
 		return err
 	}
 	writeN += written
-	if written != int(msg.Length()) {
+	if written != len(payload) {
 		return fmt.Errorf("wrong write")
 	}
 	for _, l := range rw.ls {
@@ -134,18 +186,20 @@ func (rw *V030ReadWriter) WriteMsg(msg p2pcommon.Message) error {
 	return rw.w.Flush()
 }
 
-func parseHeader(buf [msgHeaderLength]byte) (*p2pcommon.MessageValue, uint32) {
-	subProtocol := p2pcommon.SubProtocol(binary.BigEndian.Uint32(buf[0:4]))
+func parseHeader(buf [msgHeaderLength]byte) (*p2pcommon.MessageValue, p2pcommon.Compression, uint32) {
+	protocolField := binary.BigEndian.Uint32(buf[0:4])
+	subProtocol := p2pcommon.SubProtocol(protocolField & subProtocolMask)
+	codec := p2pcommon.Compression(protocolField >> compressionShift)
 	length := binary.BigEndian.Uint32(buf[4:8])
 	timestamp := int64(binary.BigEndian.Uint64(buf[8:16]))
 	msgID := p2pcommon.MustParseBytes(buf[16:32])
 	orgID := p2pcommon.MustParseBytes(buf[32:48])
-	return p2pcommon.NewLiteMessageValue(subProtocol, msgID, orgID, timestamp), length
+	return p2pcommon.NewLiteMessageValue(subProtocol, msgID, orgID, timestamp), codec, length
 }
 
-func (rw *V030ReadWriter) marshalHeader(m p2pcommon.Message) {
-	binary.BigEndian.PutUint32(rw.writeBuf[0:4], m.Subprotocol().Uint32())
-	binary.BigEndian.PutUint32(rw.writeBuf[4:8], m.Length())
+func (rw *V030ReadWriter) marshalHeader(m p2pcommon.Message, codec p2pcommon.Compression, length uint32) {
+	binary.BigEndian.PutUint32(rw.writeBuf[0:4], uint32(codec)<<compressionShift|m.Subprotocol().Uint32())
+	binary.BigEndian.PutUint32(rw.writeBuf[4:8], length)
 	binary.BigEndian.PutUint64(rw.writeBuf[8:16], uint64(m.Timestamp()))
 
 	msgID := m.ID()
@@ -120,6 +120,85 @@ func Test_ReadWrite(t *testing.T) {
 	}
 }
 
+func TestV030ReadWriter_Compression(t *testing.T) {
+	var sampleID p2pcommon.MsgID
+	sampleUUID, _ := uuid.NewV4()
+	copy(sampleID[:], sampleUUID[:])
+
+	bigHashes := make([][]byte, 0, len(sampleTxs)*100)
+	for i := 0; i < 100; i++ {
+		bigHashes = append(bigHashes, sampleTxs...)
+	}
+	tests := []struct {
+		name  string
+		codec p2pcommon.Compression
+		ids   [][]byte
+
+		wantCompressed bool
+	}{
+		{"TNoCodec", p2pcommon.CompressionNone, bigHashes, false},
+		{"TSmall", p2pcommon.CompressionSnappy, sampleTxs[:1], false},
+		{"TBig", p2pcommon.CompressionSnappy, bigHashes, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			wSum, rSum := compressSum{}, compressSum{}
+			payload, _ := proto.Marshal(&types.NewTransactionsNotice{TxHashes: test.ids})
+			sample := p2pcommon.NewMessageValue(p2pcommon.NewTxNotice, sampleID, p2pcommon.EmptyID, time.Now().UnixNano(), payload)
+
+			buf := bytes.NewBuffer(nil)
+			target := NewV030ReadWriter(nil, buf, nil)
+			target.EnableCompression(test.codec)
+			target.AddIOListener(&wSum)
+			if err := target.WriteMsg(sample); err != nil {
+				t.Fatalf("WriteMsg() error %v", err)
+			}
+			written := buf.Len()
+
+			rd := NewV030ReadWriter(bufio.NewReader(bytes.NewReader(buf.Bytes())), ioutil.Discard, nil)
+			rd.EnableCompression(test.codec)
+			rd.AddIOListener(&rSum)
+			readMsg, err := rd.ReadMsg()
+			assert.Nil(t, err)
+			assert.Equal(t, sample, readMsg)
+
+			// compressed message is not accepted if compression is not negotiated
+			notNego := NewV030ReadWriter(bufio.NewReader(bytes.NewReader(buf.Bytes())), ioutil.Discard, nil)
+			_, err = notNego.ReadMsg()
+			assert.Equal(t, test.wantCompressed, err != nil)
+
+			if test.wantCompressed {
+				assert.True(t, written < len(payload)+msgHeaderLength)
+				assert.Equal(t, len(payload), wSum.raw)
+				assert.Equal(t, written-msgHeaderLength, wSum.compressed)
+				assert.Equal(t, wSum.raw, rSum.raw)
+				assert.Equal(t, wSum.compressed, rSum.compressed)
+				assert.Equal(t, wSum.writeN, rSum.readN)
+			} else {
+				assert.Equal(t, len(payload)+msgHeaderLength, written)
+				assert.Equal(t, 0, wSum.raw)
+				assert.Equal(t, 0, rSum.raw)
+			}
+		})
+	}
+}
+
+type compressSum struct {
+	ioSum
+	raw        int
+	compressed int
+}
+
+func (s *compressSum) OnCompressedRead(protocol p2pcommon.SubProtocol, raw, compressed int) {
+	s.raw += raw
+	s.compressed += compressed
+}
+
+func (s *compressSum) OnCompressedWrite(protocol p2pcommon.SubProtocol, raw, compressed int) {
+	s.raw += raw
+	s.compressed += compressed
+}
+
 type ioSum struct {
 	readN int
 	writeN int
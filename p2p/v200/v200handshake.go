@@ -93,6 +93,7 @@ func (h *V200Handshaker) DoForOutbound(ctx context.Context) (*p2pcommon.Handshak
 	if err = h.checkRemoteStatus(remotePeerStatus); err != nil {
 		return nil, err
 	} else {
+		h.enableCompression(remotePeerStatus)
 		hsResult := &p2pcommon.HandshakeResult{Meta: h.remoteMeta, BestBlockHash: h.remoteHash, BestBlockNo: h.remoteNo, MsgRW: h.msgRW, Certificates: h.remoteCerts, Hidden: remotePeerStatus.NoExpose}
 		return hsResult, nil
 	}
@@ -235,10 +236,24 @@ func (h *V200Handshaker) DoForInbound(ctx context.Context) (*p2pcommon.Handshake
 	if err != nil {
 		return nil, err
 	}
+	h.enableCompression(remotePeerStatus)
 	hsResult := &p2pcommon.HandshakeResult{Meta: h.remoteMeta, BestBlockHash: h.remoteHash, BestBlockNo: h.remoteNo, MsgRW: h.msgRW, Certificates: h.remoteCerts, Hidden: remotePeerStatus.NoExpose}
 	return hsResult, nil
 }
 
+// enableCompression makes msgRW to compress messages with the codec which both peers support.
+// It must be called after sending local status, since remote peer can't read compressed status.
+func (h *V200Handshaker) enableCompression(remotePeerStatus *types.Status) {
+	codec := h.vm.FindBestCompression(remotePeerStatus.Compressions)
+	if codec == p2pcommon.CompressionNone {
+		return
+	}
+	if c, ok := h.msgRW.(p2pcommon.Compressible); ok {
+		h.logger.Debug().Str(p2putil.LogPeerID, p2putil.ShortForm(h.peerID)).Str("compression", codec.String()).Msg("enabling message compression")
+		c.EnableCompression(codec)
+	}
+}
+
 func (h *V200Handshaker) handleGoAway(peerID types.PeerID, data p2pcommon.Message) (*types.Status, error) {
 	goAway := &types.GoAwayNotice{}
 	if err := p2putil.UnmarshalMessageBody(data.Payload(), goAway); err != nil {
@@ -316,6 +331,9 @@ func (h *V200Handshaker) createLocalStatus(chainID *types.ChainID, bestBlock *ty
 		Version:       p2pkey.NodeVersion(),
 		Genesis:       h.localGenesisHash,
 	}
+	for _, c := range p2pcommon.SupportedCompressions {
+		statusMsg.Compressions = append(statusMsg.Compressions, c.String())
+	}
 
 	if h.selfMeta.Role == types.PeerRole_Agent {
 		cs := h.cm.GetCertificates()
@@ -184,6 +184,7 @@ func TestV200StatusHS_doForOutbound(t *testing.T) {
 			mockRW.EXPECT().WriteMsg(&MsgMatcher{p2pcommon.StatusRequest}).Return(tt.writeError).MaxTimes(1)
 			mockVM.EXPECT().GetBestChainID().Return(myChainID).AnyTimes()
 			mockVM.EXPECT().GetChainID(gomock.Any()).DoAndReturn(fc.getChainID).AnyTimes()
+			mockVM.EXPECT().FindBestCompression(gomock.Any()).Return(p2pcommon.CompressionNone).AnyTimes()
 
 			h := NewV200VersionedHS(mockIS, logger, mockVM, nil, samplePeerID, dummyReader, dummyGenHash)
 			h.msgRW = mockRW
@@ -284,6 +285,7 @@ func TestV200VersionedHS_DoForInbound(t *testing.T) {
 			mockRW.EXPECT().WriteMsg(gomock.Any()).Return(tt.writeError).AnyTimes()
 			mockVM.EXPECT().GetBestChainID().Return(myChainID).AnyTimes()
 			mockVM.EXPECT().GetChainID(gomock.Any()).DoAndReturn(fc.getChainID).AnyTimes()
+			mockVM.EXPECT().FindBestCompression(gomock.Any()).Return(p2pcommon.CompressionNone).AnyTimes()
 
 			h := NewV200VersionedHS(mockIS, logger, mockVM, nil, samplePeerID, dummyReader, dummyGenHash)
 			h.msgRW = mockRW
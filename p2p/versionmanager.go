@@ -42,9 +42,22 @@ func (vm *defaultVersionManager) FindBestP2PVersion(versions []p2pcommon.P2PVers
 	return p2pcommon.P2PVersionUnknown
 }
 
+// FindBestCompression returns the most preferred compression codec which both local and remote peer support.
+func (vm *defaultVersionManager) FindBestCompression(names []string) p2pcommon.Compression {
+	for _, supported := range p2pcommon.SupportedCompressions {
+		for _, name := range names {
+			if c, ok := p2pcommon.ParseCompression(name); ok && c == supported {
+				return c
+			}
+		}
+	}
+	return p2pcommon.CompressionNone
+}
+
 func (vm *defaultVersionManager) GetVersionedHandshaker(version p2pcommon.P2PVersion, peerID types.PeerID, rwc io.ReadWriteCloser) (p2pcommon.VersionedHandshaker, error) {
 	switch version {
-	case p2pcommon.P2PVersion200:
+	case p2pcommon.P2PVersion210, p2pcommon.P2PVersion200:
+		// compact block relay of 2.1.0 does not change handshake
 		vhs := v200.NewV200VersionedHS(vm.is, vm.logger, vm, vm.is.CertificateManager(), peerID, rwc, chain.Genesis.Block().Hash)
 		return vhs, nil
 	case p2pcommon.P2PVersion033:
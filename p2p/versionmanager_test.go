@@ -32,6 +32,7 @@ func Test_defaultVersionManager_FindBestP2PVersion(t *testing.T) {
 	}{
 		{"TSingle", args{[]p2pcommon.P2PVersion{p2pcommon.P2PVersion033}}, p2pcommon.P2PVersion033},
 		{"TMulti", args{[]p2pcommon.P2PVersion{p2pcommon.P2PVersion031, p2pcommon.P2PVersion033}}, p2pcommon.P2PVersion033},
+		{"TCompact", args{[]p2pcommon.P2PVersion{p2pcommon.P2PVersion200, p2pcommon.P2PVersion210}}, p2pcommon.P2PVersion210},
 		{"TOld", args{[]p2pcommon.P2PVersion{p2pcommon.P2PVersion030}}, p2pcommon.P2PVersionUnknown},
 		{"TUnknown", args{[]p2pcommon.P2PVersion{9999999, 9999998}}, p2pcommon.P2PVersionUnknown},
 	}
@@ -53,6 +54,38 @@ func Test_defaultVersionManager_FindBestP2PVersion(t *testing.T) {
 	}
 }
 
+func Test_defaultVersionManager_FindBestCompression(t *testing.T) {
+	dummyChainID := &types.ChainID{}
+
+	tests := []struct {
+		name  string
+		names []string
+
+		want p2pcommon.Compression
+	}{
+		{"TSnappy", []string{"snappy"}, p2pcommon.CompressionSnappy},
+		{"TWithUnknown", []string{"zstd", "snappy"}, p2pcommon.CompressionSnappy},
+		{"TNotSupported", []string{"zstd"}, p2pcommon.CompressionNone},
+		{"TEmpty", nil, p2pcommon.CompressionNone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			is := p2pmock.NewMockInternalService(ctrl)
+			pm := p2pmock.NewMockPeerManager(ctrl)
+			actor := p2pmock.NewMockActorService(ctrl)
+			ca := p2pmock.NewMockChainAccessor(ctrl)
+			vm := newDefaultVersionManager(is, actor, pm, ca, logger, dummyChainID)
+
+			if got := vm.FindBestCompression(tt.names); got != tt.want {
+				t.Errorf("defaultVersionManager.FindBestCompression() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_defaultVersionManager_GetVersionedHandshaker(t *testing.T) {
 	dummyChainID := &types.ChainID{}
 	if chain.Genesis == nil {
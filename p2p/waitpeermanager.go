@@ -132,6 +132,16 @@ func (dpm *basePeerManager) connectWaitingPeers(maxJob int) {
 			//	dpm.logger.Info().Str(p2putil.LogPeerName, p2putil.ShortMetaForm(wp.Meta)).Msg("Skipping banned peer")
 			//	continue
 			//}
+			// but peer banned by bad reputation is not connected until the ban expires
+			if banned, until := dpm.lm.TempBanned(wp.Meta.ID); banned {
+				wp.NextTrial = until
+				continue
+			}
+			// and also peer in local blacklist, which is set by administrator
+			if dpm.lm.Blacklisted(wp.Meta.PrimaryAddress(), wp.Meta.ID) {
+				wp.NextTrial = now.Add(p2pcommon.WaitingPeerManagerInterval)
+				continue
+			}
 			dpm.logger.Info().Int("trial", wp.TrialCnt).Str(p2putil.LogPeerID, p2putil.ShortForm(wp.Meta.ID)).Msg("Starting scheduled try to connect peer")
 
 			dpm.workingJobs[wp.Meta.ID] = ConnWork{Meta: wp.Meta, PeerID: wp.Meta.ID, StartTime: time.Now()}
@@ -226,7 +236,7 @@ func (dpm *basePeerManager) createRemoteInfo(conn network.Conn, r p2pcommon.Hand
 
 	connection := p2pcommon.RemoteConn{IP: ip, Port: port, Outbound: outbound}
 	zone := p2pcommon.PeerZone(p2putil.IsContainedIP(ip, dpm.is.LocalSettings().InternalZones))
-	ri := p2pcommon.RemoteInfo{Meta: r.Meta, Connection: connection, Hidden: r.Hidden, Certificates: r.Certificates, AcceptedRole: types.PeerRole_Watcher, Zone: zone}
+	ri := p2pcommon.RemoteInfo{Meta: r.Meta, Connection: connection, Hidden: r.Hidden, Certificates: r.Certificates, AcceptedRole: types.PeerRole_Watcher, Zone: zone, Version: r.Version}
 
 	// TODO Is it OK to this function has logic for policy?
 	// check role
@@ -312,13 +312,20 @@ func Test_basePeerManager_connectWaitingPeers(t *testing.T) {
 		name string
 		wjs  []*p2pcommon.WaitingPeer
 		args args
+		bans []*p2pcommon.WaitingPeer
+		// blacklisted peers
+		blacks []*p2pcommon.WaitingPeer
 
 		wantCnt int
 	}{
-		{"TEmptyJob", nil, args{4}, 0},
-		{"TFewer", c[:2], args{4}, 2},
-		{"TLarger", c, args{4}, 4},
-		{"TWithNotConn", append(nc(), c[0], n[0], n[1], c[1], n[4], c[4]), args{4}, 3},
+		{"TEmptyJob", nil, args{4}, nil, nil, 0},
+		{"TFewer", c[:2], args{4}, nil, nil, 2},
+		{"TLarger", c, args{4}, nil, nil, 4},
+		{"TWithNotConn", append(nc(), c[0], n[0], n[1], c[1], n[4], c[4]), args{4}, nil, nil, 3},
+		// temporarily banned peers are skipped
+		{"TWithBanned", c, args{4}, c[1:3], nil, 3},
+		// blacklisted peers are skipped too
+		{"TWithBlacklisted", c, args{4}, nil, c[:3], 2},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -340,6 +347,22 @@ func Test_basePeerManager_connectWaitingPeers(t *testing.T) {
 
 			mockNT.EXPECT().GetOrCreateStream(gomock.Any(), gomock.Any()).Return(nil, errors.New("stream failed")).Times(tt.wantCnt)
 			mockLM.EXPECT().IsBanned(gomock.Any(), gomock.Any()).Return(false, list.FarawayFuture).AnyTimes()
+			mockLM.EXPECT().TempBanned(gomock.Any()).DoAndReturn(func(pid types.PeerID) (bool, time.Time) {
+				for _, b := range tt.bans {
+					if b.Meta.ID == pid {
+						return true, time.Now().Add(time.Minute)
+					}
+				}
+				return false, list.UndefinedTime
+			}).AnyTimes()
+			mockLM.EXPECT().Blacklisted(gomock.Any(), gomock.Any()).DoAndReturn(func(addr string, pid types.PeerID) bool {
+				for _, b := range tt.blacks {
+					if b.Meta.ID == pid {
+						return true
+					}
+				}
+				return false
+			}).AnyTimes()
 
 			dpm.connectWaitingPeers(tt.args.maxJob)
 
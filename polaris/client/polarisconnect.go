@@ -13,6 +13,7 @@ import (
 	"github.com/aergoio/aergo/p2p/v030"
 	"github.com/aergoio/aergo/polaris/common"
 	"github.com/libp2p/go-libp2p-core/network"
+	"strings"
 	"sync"
 
 	"github.com/aergoio/aergo-actor/actor"
@@ -27,6 +28,11 @@ import (
 
 var ErrTooLowVersion = errors.New("aergosvr version is too low")
 
+// chainAccessorGetter is implemented by p2p service of aergosvr
+type chainAccessorGetter interface {
+	GetChainAccessor() types.ChainAccessor
+}
+
 // PeerMapService is
 type PolarisConnectSvc struct {
 	*component.BaseComponent
@@ -36,6 +42,11 @@ type PolarisConnectSvc struct {
 	mapServers []p2pcommon.PeerMeta
 	exposeself bool
 
+	// conditions of peers to query
+	queryRoles      []types.PeerRole
+	queryMinVersion string
+	queryMaxLag     uint64
+
 	ntc p2pcommon.NTContainer
 	nt  p2pcommon.NetworkTransport
 
@@ -58,6 +69,7 @@ func NewPolarisConnectSvc(cfg *config.P2PConfig, ntc p2pcommon.NTContainer) *Pol
 
 func (pcs *PolarisConnectSvc) initSvc(cfg *config.P2PConfig) {
 	pcs.PrivateChain = !pcs.ntc.GenesisChainID().PublicNet
+	pcs.initQueryFilter(cfg)
 	if cfg.NPUsePolaris {
 		// private network does not use public polaris
 		if !pcs.PrivateChain {
@@ -104,6 +116,29 @@ func (pcs *PolarisConnectSvc) initSvc(cfg *config.P2PConfig) {
 	}
 }
 
+func (pcs *PolarisConnectSvc) initQueryFilter(cfg *config.P2PConfig) {
+	for _, roleStr := range cfg.NPPolarisPeerRoles {
+		switch strings.ToLower(roleStr) {
+		case "producer":
+			pcs.queryRoles = append(pcs.queryRoles, types.PeerRole_Producer)
+		case "watcher":
+			pcs.queryRoles = append(pcs.queryRoles, types.PeerRole_Watcher)
+		case "agent":
+			pcs.queryRoles = append(pcs.queryRoles, types.PeerRole_Agent)
+		default:
+			pcs.Logger.Warn().Str("role", roleStr).Msg("invalid peer role to query polaris in config file")
+		}
+	}
+	if len(cfg.NPPolarisMinVersion) > 0 {
+		if _, err := p2pcommon.ParseAergoVersion(cfg.NPPolarisMinVersion); err != nil {
+			pcs.Logger.Warn().Str("version", cfg.NPPolarisMinVersion).Msg("invalid minimum version to query polaris in config file")
+		} else {
+			pcs.queryMinVersion = cfg.NPPolarisMinVersion
+		}
+	}
+	pcs.queryMaxLag = cfg.NPPolarisMaxBlockLag
+}
+
 func (pcs *PolarisConnectSvc) BeforeStart() {}
 
 func (pcs *PolarisConnectSvc) AfterStart() {
@@ -207,7 +242,8 @@ func (pcs *PolarisConnectSvc) queryToPolaris(mapServerMeta p2pcommon.PeerMeta, r
 
 func (pcs *PolarisConnectSvc) sendRequest(status *types.Status, mapServerMeta p2pcommon.PeerMeta, register bool, size int, wt p2pcommon.MsgReadWriter) error {
 	msgID := p2pcommon.NewMsgID()
-	queryReq := &types.MapQuery{Status: status, Size: int32(size), AddMe: register, Excludes: [][]byte{[]byte(mapServerMeta.ID)}}
+	queryReq := &types.MapQuery{Status: status, Size: int32(size), AddMe: register, Excludes: [][]byte{[]byte(mapServerMeta.ID)},
+		Roles: pcs.queryRoles, MinVersion: pcs.queryMinVersion, MaxBlockLag: pcs.queryMaxLag}
 	bytes, err := p2putil.MarshalMessageBody(queryReq)
 	if err != nil {
 		return err
@@ -262,6 +298,13 @@ func (pcs *PolarisConnectSvc) onPing(s network.Stream) {
 	}
 	// TODO: check if sender is known polaris or peer and it not, ban or write to blacklist .
 	pingResp := &types.Ping{}
+	// best block is used by polaris to filter out stale peers
+	if ca, ok := pcs.ntc.(chainAccessorGetter); ok {
+		if best, err := ca.GetChainAccessor().GetBestBlock(); err == nil && best != nil {
+			pingResp.BestBlockHash = best.BlockHash()
+			pingResp.BestHeight = best.GetHeader().GetBlockNo()
+		}
+	}
 	bytes, err := p2putil.MarshalMessageBody(pingResp)
 	if err != nil {
 		return
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"github.com/aergoio/aergo/p2p/p2putil"
 	"github.com/aergoio/aergo/polaris/common"
+	"reflect"
 	"testing"
 
 	"github.com/aergoio/aergo/config"
@@ -109,6 +110,44 @@ func TestPolarisConnectSvc_initSvc(t *testing.T) {
 	}
 }
 
+func TestPolarisConnectSvc_initQueryFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		roles   []string
+		version string
+		lag     uint64
+
+		wantRoles   []types.PeerRole
+		wantVersion string
+	}{
+		{"TEmpty", nil, "", 0, nil, ""},
+		{"TRoles", []string{"Producer", "watcher"}, "", 0, []types.PeerRole{types.PeerRole_Producer, types.PeerRole_Watcher}, ""},
+		{"TWrongRole", []string{"agent", "miner"}, "", 0, []types.PeerRole{types.PeerRole_Agent}, ""},
+		{"TVersion", nil, "v2.1.0", 100, nil, "v2.1.0"},
+		{"TWrongVersion", nil, "latest", 100, nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pmapDummyNTC.chainID = &types.ChainID{}
+			cfg := config.NewServerContext("", "").GetDefaultP2PConfig()
+			cfg.NPPolarisPeerRoles = tt.roles
+			cfg.NPPolarisMinVersion = tt.version
+			cfg.NPPolarisMaxBlockLag = tt.lag
+
+			pcs := NewPolarisConnectSvc(cfg, pmapDummyNTC)
+			if !reflect.DeepEqual(pcs.queryRoles, tt.wantRoles) {
+				t.Errorf("initQueryFilter() roles = %v, want %v", pcs.queryRoles, tt.wantRoles)
+			}
+			if pcs.queryMinVersion != tt.wantVersion {
+				t.Errorf("initQueryFilter() version = %v, want %v", pcs.queryMinVersion, tt.wantVersion)
+			}
+			if pcs.queryMaxLag != tt.lag {
+				t.Errorf("initQueryFilter() lag = %v, want %v", pcs.queryMaxLag, tt.lag)
+			}
+		})
+	}
+}
+
 func TestPolarisConnectSvc_BeforeStop(t *testing.T) {
 
 	type fields struct {
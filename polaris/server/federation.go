@@ -0,0 +1,151 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package server
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/p2pkey"
+	"github.com/aergoio/aergo/p2p/p2putil"
+	"github.com/aergoio/aergo/p2p/v030"
+	"github.com/aergoio/aergo/polaris/common"
+	"github.com/aergoio/aergo/types"
+)
+
+// polarisFederation exchanges registered peers with other polaris servers. It sends map query, the same one as
+// aergosvr sends, to each member periodically and registers peers in the response. Members are expected to be
+// configured each other, so that every member of federation knows peers registered to others.
+type polarisFederation struct {
+	logger  *log.Logger
+	pms     *PeerMapService
+	members []p2pcommon.PeerMeta
+
+	finish chan interface{}
+}
+
+func newPolarisFederation(pms *PeerMapService, addrs []string, logger *log.Logger) *polarisFederation {
+	f := &polarisFederation{logger: logger, pms: pms, finish: make(chan interface{})}
+	for _, addrStr := range addrs {
+		meta, err := p2putil.FromMultiAddrString(addrStr)
+		if err != nil {
+			logger.Info().Str("addr_str", addrStr).Msg("invalid polaris federation address in config file")
+			continue
+		}
+		f.members = append(f.members, meta)
+	}
+	return f
+}
+
+func (f *polarisFederation) isMember(id types.PeerID) bool {
+	for _, m := range f.members {
+		if m.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *polarisFederation) Start() {
+	f.logger.Info().Array("members", p2putil.NewLogPeerMetasMarshaller(f.members, 10)).Msg("Starting polaris federation")
+	go f.run()
+}
+
+func (f *polarisFederation) Stop() {
+	close(f.finish)
+}
+
+func (f *polarisFederation) run() {
+	ticker := time.NewTicker(FederationSyncInterval)
+	defer ticker.Stop()
+	f.syncAll()
+	for {
+		select {
+		case <-ticker.C:
+			f.syncAll()
+		case <-f.finish:
+			f.logger.Info().Msg("Polaris federation finished")
+			return
+		}
+	}
+}
+
+func (f *polarisFederation) syncAll() {
+	for _, meta := range f.members {
+		addrs, err := f.queryMember(meta)
+		if err != nil {
+			f.logger.Info().Err(err).Str("polarisID", p2putil.ShortForm(meta.ID)).Msg("Failed to get peers from federated polaris")
+			continue
+		}
+		added := f.pms.registerFederatedPeers(addrs)
+		f.logger.Debug().Str("polarisID", p2putil.ShortForm(meta.ID)).Int("peer_cnt", len(addrs)).Int("added", added).Msg("Got peers from federated polaris")
+	}
+}
+
+func (f *polarisFederation) queryMember(meta p2pcommon.PeerMeta) ([]*types.PeerAddress, error) {
+	s, err := f.pms.nt.GetOrCreateStreamWithTTL(meta, common.PolarisConnectionTTL, common.PolarisMapSub)
+	if err != nil {
+		return nil, err
+	}
+	defer s.Close()
+	rw := v030.NewV030ReadWriter(bufio.NewReader(s), bufio.NewWriter(s), nil)
+
+	selfAddr := f.pms.ntc.SelfMeta().ToPeerAddress()
+	chainBytes, _ := f.pms.ntc.GenesisChainID().Bytes()
+	status := &types.Status{Sender: &selfAddr, ChainID: chainBytes, Version: p2pkey.NodeVersion(), NoExpose: true}
+	query := &types.MapQuery{Status: status, Size: ResponseMaxPeerLimit, AddMe: false}
+	bytes, err := p2putil.MarshalMessageBody(query)
+	if err != nil {
+		return nil, err
+	}
+	if err = rw.WriteMsg(common.NewPolarisMessage(p2pcommon.NewMsgID(), common.MapQuery, bytes)); err != nil {
+		return nil, err
+	}
+
+	data, err := rw.ReadMsg()
+	if err != nil {
+		return nil, err
+	}
+	resp := &types.MapResponse{}
+	if err = p2putil.UnmarshalMessageBody(data.Payload(), resp); err != nil {
+		return nil, err
+	}
+	if resp.Status != types.ResultStatus_OK {
+		return nil, fmt.Errorf("remote error %s %s", resp.Status.String(), resp.Message)
+	}
+	return resp.Addresses, nil
+}
+
+// registerFederatedPeers adds peers that are received from other polaris, and returns the count of newly added peers.
+// Peers that are already registered are not changed, and added peers are verified by later health check.
+func (pms *PeerMapService) registerFederatedPeers(addrs []*types.PeerAddress) int {
+	selfID := pms.ntc.SelfMeta().ID
+	now := time.Now()
+	added := 0
+	for _, addr := range addrs {
+		meta := p2pcommon.FromPeerAddress(addr)
+		if len(meta.ID) == 0 || meta.ID == selfID || len(meta.Addresses) == 0 {
+			continue
+		}
+		conn := p2pcommon.RemoteConn{IP: net.ParseIP(meta.PrimaryAddress()), Port: meta.PrimaryPort()}
+		if banned, _ := pms.lm.IsBanned(meta.PrimaryAddress(), meta.ID); banned {
+			continue
+		}
+		pms.rwmutex.Lock()
+		if _, found := pms.peerRegistry[meta.ID]; !found {
+			newState := &peerState{conn: conn, connected: now, PeerMapService: pms, meta: meta, addr: meta.ToPeerAddress(), lCheckTime: now}
+			pms.peerRegistry[meta.ID] = newState
+			pms.storePeer(newState)
+			added++
+		}
+		pms.rwmutex.Unlock()
+	}
+	return added
+}
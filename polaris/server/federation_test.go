@@ -0,0 +1,74 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package server
+
+import (
+	"net"
+	"testing"
+
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/types"
+)
+
+func Test_newPolarisFederation(t *testing.T) {
+	validAddr := "/ip4/211.34.56.78/tcp/8916/p2p/16Uiu2HAkvJTHFuJXxr15rFEHsJWnyn1QvGatW2E9ED9Mvy4HWjVF"
+	f := newPolarisFederation(nil, []string{validAddr, "invalid address"}, log.NewLogger("polaris.test"))
+	if len(f.members) != 1 {
+		t.Fatalf("members = %v, want 1", len(f.members))
+	}
+	if !f.isMember(f.members[0].ID) {
+		t.Errorf("isMember() = false, want true")
+	}
+	if f.isMember(types.RandomPeerID()) {
+		t.Errorf("isMember() of other peer = true, want false")
+	}
+}
+
+func TestPeerMapService_registerFederatedPeers(t *testing.T) {
+	ma, _ := types.ParseMultiaddr("/ip4/211.34.56.78/tcp/7846")
+	self := p2pcommon.PeerMeta{ID: types.RandomPeerID(), Addresses: []types.Multiaddr{ma}}
+	toAddr := func(metas ...p2pcommon.PeerMeta) []*types.PeerAddress {
+		addrs := make([]*types.PeerAddress, len(metas))
+		for i, m := range metas {
+			m.Addresses = []types.Multiaddr{ma}
+			addr := m.ToPeerAddress()
+			addrs[i] = &addr
+		}
+		return addrs
+	}
+	noAddr := &types.PeerAddress{PeerID: []byte(types.RandomPeerID())}
+
+	tests := []struct {
+		name       string
+		registered []p2pcommon.PeerMeta
+		in         []*types.PeerAddress
+
+		wantAdded int
+		wantSize  int
+	}{
+		{"TNew", nil, toAddr(metas[:5]...), 5, 5},
+		{"TDupRegistered", metas[:3], toAddr(metas[:5]...), 2, 5},
+		{"TDupInput", nil, toAddr(mm(metas[0], metas[1], metas[0])...), 2, 2},
+		{"TSelf", nil, toAddr(self, metas[0]), 1, 1},
+		{"TNoAddress", nil, []*types.PeerAddress{noAddr}, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pms := NewPolarisService(pmapDummyCfg, &dummyNTC{self: self, chainID: &types.ChainID{}})
+			conn := p2pcommon.RemoteConn{IP: net.ParseIP("211.34.56.78"), Port: 7846}
+			for _, meta := range tt.registered {
+				pms.registerPeer(meta, conn)
+			}
+			if got := pms.registerFederatedPeers(tt.in); got != tt.wantAdded {
+				t.Errorf("registerFederatedPeers() = %v, want %v", got, tt.wantAdded)
+			}
+			if len(pms.peerRegistry) != tt.wantSize {
+				t.Errorf("registry size = %v, want %v", len(pms.peerRegistry), tt.wantSize)
+			}
+		})
+	}
+}
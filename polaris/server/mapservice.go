@@ -10,8 +10,12 @@ import (
 	"fmt"
 	"github.com/aergoio/aergo/p2p/v030"
 	"math"
+	"math/rand"
 	"net"
+	"path/filepath"
+	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/aergoio/aergo-actor/actor"
@@ -38,6 +42,9 @@ const (
 	PeerHealthcheckInterval = time.Minute
 	//PeerHealthcheckInterval = time.Minute * 5
 	ConcurrentHealthCheckCount = 20
+
+	// polaris gets registered peers from other polarises of federation in this interval
+	FederationSyncInterval = time.Minute * 5
 )
 
 var (
@@ -68,6 +75,11 @@ type PeerMapService struct {
 	hc  HealthCheckManager
 	lm  *polarisListManager
 
+	// dbDir is empty if persisting peers is disabled
+	dbDir string
+	store *peerStore
+	fed   *polarisFederation
+
 	rwmutex      *sync.RWMutex
 	peerRegistry map[types.PeerID]*peerState
 }
@@ -87,6 +99,12 @@ func NewPolarisService(cfg *config.Config, ntc p2pcommon.NTContainer) *PeerMapSe
 	pms.PrivateNet = !ntc.GenesisChainID().MainNet
 
 	pms.lm = NewPolarisListManager(cfg.Polaris, cfg.BaseConfig.AuthDir, pms.Logger)
+	if cfg.Polaris.EnablePersist {
+		pms.dbDir = filepath.Join(cfg.BaseConfig.DataDir, peerDBDir)
+	}
+	if len(cfg.Polaris.Federation) > 0 {
+		pms.fed = newPolarisFederation(pms, cfg.Polaris.Federation, pms.Logger)
+	}
 	// initialize map Servers
 	return pms
 }
@@ -100,17 +118,85 @@ func (pms *PeerMapService) BeforeStart() {}
 func (pms *PeerMapService) AfterStart() {
 	pms.nt = pms.ntc.GetNetworkTransport()
 	pms.lm.Start()
+	if len(pms.dbDir) > 0 {
+		pms.loadPeers()
+	}
 	pms.Logger.Info().Str("minAergoVer", p2pcommon.MinimumAergoVersion).Str("maxAergoVer", p2pcommon.MaximumAergoVersion).Str("version", string(common.PolarisMapSub)).Msg("Starting polaris listening")
 	pms.nt.AddStreamHandler(common.PolarisMapSub, pms.onConnect)
 	pms.hc.Start()
+	if pms.fed != nil {
+		pms.fed.Start()
+	}
 }
 
 func (pms *PeerMapService) BeforeStop() {
 	if pms.nt != nil {
+		if pms.fed != nil {
+			pms.fed.Stop()
+		}
 		pms.hc.Stop()
 		pms.nt.RemoveStreamHandler(common.PolarisMapSub)
 	}
 	pms.lm.Stop()
+	pms.rwmutex.Lock()
+	if pms.store != nil {
+		pms.store.close()
+		pms.store = nil
+	}
+	pms.rwmutex.Unlock()
+}
+
+// loadPeers opens peer db and restores peers registered before restart. Restored peers will be checked in next
+// health check, as same as newly registered peers.
+func (pms *PeerMapService) loadPeers() {
+	store := newPeerStore(pms.dbDir, pms.Logger)
+	states := store.loadAll(pms)
+	pms.rwmutex.Lock()
+	defer pms.rwmutex.Unlock()
+	pms.store = store
+	loaded := 0
+	for _, ps := range states {
+		if banned, _ := pms.lm.IsBanned(ps.meta.PrimaryAddress(), ps.meta.ID); banned {
+			store.remove(ps.meta.ID)
+			continue
+		}
+		if _, found := pms.peerRegistry[ps.meta.ID]; !found {
+			pms.peerRegistry[ps.meta.ID] = ps
+			loaded++
+		}
+	}
+	pms.Logger.Info().Str("dir", pms.dbDir).Int("peer_cnt", loaded).Msg("Loaded saved peers")
+}
+
+// storePeer must be called in write lock
+func (pms *PeerMapService) storePeer(ps *peerState) {
+	if pms.store != nil {
+		pms.store.put(ps)
+	}
+}
+
+// updatePeerState saves health check result of peer, if the peer is still registered.
+func (pms *PeerMapService) updatePeerState(ps *peerState, pingResp *types.Ping) {
+	pms.rwmutex.Lock()
+	defer pms.rwmutex.Unlock()
+	if cur, found := pms.peerRegistry[ps.meta.ID]; found && cur == ps {
+		// old aergosvr responds empty ping
+		if pingResp != nil && pingResp.BestHeight > 0 {
+			ps.bestHash, ps.bestNo = pingResp.BestBlockHash, int64(pingResp.BestHeight)
+			ps.checkedNo = ps.bestNo
+		}
+		pms.storePeer(ps)
+	}
+}
+
+// updateBestBlock sets best block of peer reported in the status of map query.
+func (pms *PeerMapService) updateBestBlock(peerID types.PeerID, hash []byte, height uint64) {
+	pms.rwmutex.Lock()
+	defer pms.rwmutex.Unlock()
+	if ps, found := pms.peerRegistry[peerID]; found && height > 0 {
+		ps.bestHash, ps.bestNo = hash, int64(height)
+		pms.storePeer(ps)
+	}
 }
 
 func (pms *PeerMapService) Statistics() *map[string]interface{} {
@@ -213,8 +299,8 @@ func (pms *PeerMapService) handleQuery(conn p2pcommon.RemoteConn, container p2pc
 	// make response
 	resp := &types.MapResponse{}
 
-	// check peer version
-	if !p2pcommon.CheckVersion(receivedMeta.Version) {
+	// check peer version. other polaris of federation is not aergosvr, so its version is not checked.
+	if !pms.isFederated(receivedMeta.ID) && !p2pcommon.CheckVersion(receivedMeta.Version) {
 		pms.Logger.Debug().Str(p2putil.LogPeerID, receivedMeta.ID.String()).Str("version", receivedMeta.Version).Msg("peer version is too old, or too new")
 		resp.Status = types.ResultStatus_FAILED_PRECONDITION
 		resp.Message = common.TooOldVersionMsg
@@ -236,7 +322,14 @@ func (pms *PeerMapService) handleQuery(conn p2pcommon.RemoteConn, container p2pc
 		return resp, nil
 	}
 
-	resp.Addresses = pms.retrieveList(maxPeers, receivedMeta.ID)
+	filter, err := newPeerFilter(query)
+	if err != nil {
+		pms.Logger.Debug().Err(err).Str(p2putil.LogPeerID, receivedMeta.ID.String()).Msg("invalid peer filter")
+		resp.Status = types.ResultStatus_INVALID_ARGUMENT
+		resp.Message = err.Error()
+		return resp, nil
+	}
+	resp.Addresses = pms.retrieveList(maxPeers, receivedMeta.ID, filter)
 
 	// old syntax (AddMe) and newer syntax (status.NoExpose) for expose peer
 	if query.AddMe && !query.Status.NoExpose {
@@ -250,28 +343,64 @@ func (pms *PeerMapService) handleQuery(conn p2pcommon.RemoteConn, container p2pc
 		}
 		pms.Logger.Debug().Str(p2putil.LogPeerID, receivedMeta.ID.String()).Msg("AddMe is set, and register peer to peer registry")
 		pms.registerPeer(receivedMeta, conn)
+		pms.updateBestBlock(receivedMeta.ID, query.Status.BestBlockHash, query.Status.BestHeight)
 	}
 
 	resp.Status = types.ResultStatus_OK
 	return resp, nil
 }
 
-func (pms *PeerMapService) retrieveList(maxPeers int, exclude types.PeerID) []*types.PeerAddress {
-	list := make([]*types.PeerAddress, 0, maxPeers)
+// retrieveList returns peers which match filter. Responsive peers are preferred, and peers with the same health are
+// chosen randomly.
+func (pms *PeerMapService) retrieveList(maxPeers int, exclude types.PeerID, filter peerFilter) []*types.PeerAddress {
 	pms.rwmutex.RLock()
 	defer pms.rwmutex.RUnlock()
+	var topHeight uint64
+	if filter.maxLag > 0 {
+		topHeight = pms.topHeight()
+	}
+	candidates := make([]*peerState, 0, len(pms.peerRegistry))
 	for id, ps := range pms.peerRegistry {
-		if id == exclude {
+		if id == exclude || !filter.accept(ps, topHeight) {
 			continue
 		}
-		list = append(list, &ps.addr)
-		if len(list) >= maxPeers {
-			return list
-		}
+		candidates = append(candidates, ps)
+	}
+	rand.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return atomic.LoadInt32(&candidates[i].contFail) < atomic.LoadInt32(&candidates[j].contFail)
+	})
+	if len(candidates) > maxPeers {
+		candidates = candidates[:maxPeers]
+	}
+	list := make([]*types.PeerAddress, len(candidates))
+	for i, ps := range candidates {
+		list[i] = &ps.addr
 	}
 	return list
 }
 
+// topHeight returns the median of best blocks which registered peers answered to health check. Best blocks in the
+// status of map query are not used, since a peer could hide all other peers by exaggerating its own one. It must be
+// called in read lock.
+func (pms *PeerMapService) topHeight() uint64 {
+	heights := make([]int64, 0, len(pms.peerRegistry))
+	for _, ps := range pms.peerRegistry {
+		if ps.checkedNo > 0 {
+			heights = append(heights, ps.checkedNo)
+		}
+	}
+	if len(heights) == 0 {
+		return 0
+	}
+	sort.Slice(heights, func(i, j int) bool {
+		return heights[i] < heights[j]
+	})
+	return uint64(heights[len(heights)/2])
+}
+
 func (pms *PeerMapService) registerPeer(receivedMeta p2pcommon.PeerMeta, conn p2pcommon.RemoteConn) error {
 	peerID := receivedMeta.ID
 	pms.rwmutex.Lock()
@@ -282,6 +411,7 @@ func (pms *PeerMapService) registerPeer(receivedMeta p2pcommon.PeerMeta, conn p2
 		newState := &peerState{conn:conn, connected: now, PeerMapService: pms, meta: receivedMeta, addr: receivedMeta.ToPeerAddress(), lCheckTime: now}
 		pms.Logger.Info().Str("meta", p2putil.ShortMetaForm(receivedMeta)).Str("version",receivedMeta.GetVersion()).Msg("Registering new peer info")
 		pms.peerRegistry[peerID] = newState
+		pms.storePeer(newState)
 	} else {
 		if !isEqualMeta(prev.meta,receivedMeta) {
 			pms.Logger.Info().Str("meta", p2putil.ShortMetaForm(prev.meta)).Msg("Replacing previous peer info")
@@ -290,6 +420,7 @@ func (pms *PeerMapService) registerPeer(receivedMeta p2pcommon.PeerMeta, conn p2
 			prev.addr = receivedMeta.ToPeerAddress()
 		}
 		prev.lCheckTime = now
+		pms.storePeer(prev)
 	}
 	return nil
 }
@@ -323,6 +454,13 @@ func (pms *PeerMapService) unregisterPeer(peerID types.PeerID) {
 	defer pms.rwmutex.Unlock()
 	pms.Logger.Info().Str(p2putil.LogPeerID, p2putil.ShortForm(peerID)).Msg("Unregistering bad peer")
 	delete(pms.peerRegistry, peerID)
+	if pms.store != nil {
+		pms.store.remove(peerID)
+	}
+}
+
+func (pms *PeerMapService) isFederated(peerID types.PeerID) bool {
+	return pms.fed != nil && pms.fed.isMember(peerID)
 }
 
 func (pms *PeerMapService) applyNewBLEntry(entry types.WhiteListEntry) {
@@ -336,6 +474,9 @@ func (pms *PeerMapService) applyNewBLEntry(entry types.WhiteListEntry) {
 			for _, ip := range ips {
 				if entry.Contains(ip, ps.meta.ID) {
 					delete(pms.peerRegistry, ps.meta.ID)
+					if pms.store != nil {
+						pms.store.remove(ps.meta.ID)
+					}
 					break
 				}
 			}
@@ -346,6 +487,9 @@ func (pms *PeerMapService) applyNewBLEntry(entry types.WhiteListEntry) {
 			for _, ip := range ips {
 				if entry.Contains(ip, ps.meta.ID) {
 					delete(pms.peerRegistry, ps.meta.ID)
+					if pms.store != nil {
+						pms.store.remove(ps.meta.ID)
+					}
 					break
 				}
 			}
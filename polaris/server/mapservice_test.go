@@ -516,6 +516,7 @@ func TestPeerMapService_retrieveList(t *testing.T) {
 	type args struct {
 		maxPeers int
 		exclude  types.PeerID
+		filter   peerFilter
 	}
 	tests := []struct {
 		name   string
@@ -536,7 +537,7 @@ func TestPeerMapService_retrieveList(t *testing.T) {
 				rwmutex:       tt.fields.rwmutex,
 				peerRegistry:  tt.fields.peerRegistry,
 			}
-			if got := pms.retrieveList(tt.args.maxPeers, tt.args.exclude); !reflect.DeepEqual(got, tt.want) {
+			if got := pms.retrieveList(tt.args.maxPeers, tt.args.exclude, tt.args.filter); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("PeerMapService.retrieveList() = %v, want %v", got, tt.want)
 			}
 		})
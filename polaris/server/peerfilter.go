@@ -0,0 +1,59 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package server
+
+import (
+	"fmt"
+
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/types"
+)
+
+// peerFilter is conditions of peers to be returned by map query. Zero value accepts all peers.
+type peerFilter struct {
+	roles      []types.PeerRole
+	minVersion *p2pcommon.AergoVersion
+	maxLag     uint64
+}
+
+func newPeerFilter(query *types.MapQuery) (peerFilter, error) {
+	f := peerFilter{roles: query.Roles, maxLag: query.MaxBlockLag}
+	if len(query.MinVersion) > 0 {
+		ver, err := p2pcommon.ParseAergoVersion(query.MinVersion)
+		if err != nil {
+			return f, fmt.Errorf("invalid minimum version %s", query.MinVersion)
+		}
+		f.minVersion = &ver
+	}
+	return f, nil
+}
+
+// accept returns whether the peer matches filter. topHeight is the height which peers are expected to be near. Peer that
+// has not reported its best block yet is not filtered by lag.
+func (f peerFilter) accept(ps *peerState, topHeight uint64) bool {
+	if len(f.roles) > 0 {
+		found := false
+		for _, r := range f.roles {
+			if ps.meta.Role == r {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if f.minVersion != nil {
+		ver, err := p2pcommon.ParseAergoVersion(ps.meta.Version)
+		if err != nil || ver.LessThan(*f.minVersion) {
+			return false
+		}
+	}
+	if f.maxLag > 0 && ps.bestNo > 0 && uint64(ps.bestNo)+f.maxLag < topHeight {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,156 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package server
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/types"
+)
+
+func Test_newPeerFilter(t *testing.T) {
+	tests := []struct {
+		name  string
+		query *types.MapQuery
+
+		wantErr bool
+		wantVer bool
+	}{
+		{"TEmpty", &types.MapQuery{}, false, false},
+		{"TVersion", &types.MapQuery{MinVersion: "v2.1.0"}, false, true},
+		{"TInvalidVersion", &types.MapQuery{MinVersion: "version2"}, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newPeerFilter(tt.query)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("newPeerFilter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if (got.minVersion != nil) != tt.wantVer {
+				t.Errorf("newPeerFilter() minVersion = %v, want %v", got.minVersion, tt.wantVer)
+			}
+		})
+	}
+}
+
+func Test_peerFilter_accept(t *testing.T) {
+	v21, _ := p2pcommon.ParseAergoVersion("v2.1.0")
+	state := func(role types.PeerRole, version string, bestNo int64) *peerState {
+		return &peerState{meta: p2pcommon.PeerMeta{ID: types.RandomPeerID(), Role: role, Version: version}, bestNo: bestNo}
+	}
+	tests := []struct {
+		name   string
+		filter peerFilter
+		ps     *peerState
+		top    uint64
+
+		want bool
+	}{
+		{"TNoFilter", peerFilter{}, state(types.PeerRole_LegacyVersion, "", 0), 1000, true},
+		{"TRole", peerFilter{roles: []types.PeerRole{types.PeerRole_Producer, types.PeerRole_Watcher}}, state(types.PeerRole_Watcher, "v2.1.0", 0), 0, true},
+		{"TRoleDiff", peerFilter{roles: []types.PeerRole{types.PeerRole_Producer}}, state(types.PeerRole_Agent, "v2.1.0", 0), 0, false},
+		{"TVersionSame", peerFilter{minVersion: &v21}, state(types.PeerRole_Watcher, "v2.1.0", 0), 0, true},
+		{"TVersionHigher", peerFilter{minVersion: &v21}, state(types.PeerRole_Watcher, "v2.2.3-rc", 0), 0, true},
+		{"TVersionLower", peerFilter{minVersion: &v21}, state(types.PeerRole_Watcher, "v2.0.9", 0), 0, false},
+		{"TVersionUnknown", peerFilter{minVersion: &v21}, state(types.PeerRole_Watcher, "", 0), 0, false},
+		{"TLagIn", peerFilter{maxLag: 100}, state(types.PeerRole_Watcher, "v2.1.0", 900), 1000, true},
+		{"TLagOut", peerFilter{maxLag: 100}, state(types.PeerRole_Watcher, "v2.1.0", 899), 1000, false},
+		{"TLagUnknown", peerFilter{maxLag: 100}, state(types.PeerRole_Watcher, "v2.1.0", 0), 1000, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.accept(tt.ps, tt.top); got != tt.want {
+				t.Errorf("accept() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPeerMapService_topHeight(t *testing.T) {
+	tests := []struct {
+		name    string
+		checked []int64
+
+		want uint64
+	}{
+		{"TEmpty", nil, 0},
+		{"TNotChecked", []int64{0, 0}, 0},
+		{"TSingle", []int64{0, 1000}, 1000},
+		{"TMedian", []int64{1000, 999, 1 << 40, 10, 1001}, 1000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := make(map[types.PeerID]*peerState)
+			for _, no := range tt.checked {
+				id := types.RandomPeerID()
+				registry[id] = &peerState{meta: p2pcommon.PeerMeta{ID: id}, bestNo: 1 << 40, checkedNo: no}
+			}
+			pms := &PeerMapService{rwmutex: &sync.RWMutex{}, peerRegistry: registry}
+			if got := pms.topHeight(); got != tt.want {
+				t.Errorf("topHeight() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPeerMapService_retrieveListFiltered(t *testing.T) {
+	// 0~4 are producers, and 5~9 are watchers. even numbered peers are failed in last health check.
+	registry := make(map[types.PeerID]*peerState)
+	for i, meta := range metas[:10] {
+		meta.Role = types.PeerRole_Producer
+		if i >= 5 {
+			meta.Role = types.PeerRole_Watcher
+		}
+		meta.Version = "v2.1.0"
+		registry[meta.ID] = &peerState{meta: meta, addr: meta.ToPeerAddress(), contFail: int32((i + 1) % 2), bestNo: int64(1000 + i),
+			checkedNo: int64(1000 + i)}
+	}
+	// stale peer
+	registry[metas[9].ID].bestNo, registry[metas[9].ID].checkedNo = 10, 10
+	// peer which exaggerates its best block in map query does not raise the top height
+	registry[metas[8].ID].bestNo = 1 << 40
+
+	tests := []struct {
+		name     string
+		maxPeers int
+		exclude  types.PeerID
+		filter   peerFilter
+
+		wantSize int
+		wantGood int
+	}{
+		{"TAll", 100, "", peerFilter{}, 10, 5},
+		{"TExclude", 100, metas[0].ID, peerFilter{}, 9, 5},
+		{"TPreferGood", 3, "", peerFilter{}, 3, 3},
+		{"TPreferGood2", 7, "", peerFilter{}, 7, 5},
+		{"TRole", 100, "", peerFilter{roles: []types.PeerRole{types.PeerRole_Watcher}}, 5, 3},
+		{"TLag", 100, "", peerFilter{maxLag: 100}, 9, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pms := &PeerMapService{rwmutex: &sync.RWMutex{}, peerRegistry: registry}
+			got := pms.retrieveList(tt.maxPeers, tt.exclude, tt.filter)
+			if len(got) != tt.wantSize {
+				t.Fatalf("retrieveList() size = %v, want %v", len(got), tt.wantSize)
+			}
+			good := 0
+			for _, addr := range got {
+				ps := registry[types.PeerID(addr.PeerID)]
+				if !tt.filter.accept(ps, 1004) || ps.meta.ID == tt.exclude {
+					t.Errorf("retrieveList() returns unexpected peer %v", ps.meta.ID)
+				}
+				if ps.health() == PeerHealth_GOOD {
+					good++
+				}
+			}
+			if good != tt.wantGood {
+				t.Errorf("retrieveList() good peers = %v, want %v", good, tt.wantGood)
+			}
+		})
+	}
+}
@@ -43,6 +43,9 @@ type peerState struct {
 	bestNo     int64
 	lCheckTime time.Time
 	contFail   int32
+
+	// checkedNo is the best block which the peer answered to the last health check.
+	checkedNo int64
 }
 
 func (hc *peerState) health() PeerHealth {
@@ -68,6 +71,8 @@ func (hc *peerState) check(wg *sync.WaitGroup, timeout time.Duration) {
 			hc.unregisterPeer(hc.meta.ID)
 		} else if hc.health() == PeerHealth_BAD {
 			hc.unregisterPeer(hc.meta.ID)
+		} else {
+			hc.updatePeerState(hc, success)
 		}
 	}
 }
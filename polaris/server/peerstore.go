@@ -0,0 +1,114 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package server
+
+import (
+	"encoding/json"
+	"net"
+	"sync/atomic"
+	"time"
+
+	"github.com/aergoio/aergo-lib/db"
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/p2putil"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	peerDBDir = "polaris"
+)
+
+var (
+	peerKeyPrefix = []byte("peer:")
+	// peerKeyEnd is the first key after all keys with peerKeyPrefix
+	peerKeyEnd = []byte("peer;")
+)
+
+// peerRecord is the stored form of peerState. Address is marshaled PeerAddress by protobuf.
+type peerRecord struct {
+	Address   []byte `json:"address"`
+	IP        string `json:"ip"`
+	Port      uint32 `json:"port"`
+	Connected int64  `json:"connected"`
+	LastCheck int64  `json:"lastCheck"`
+	ContFail  int32  `json:"contFail"`
+	BestHash  []byte `json:"bestHash,omitempty"`
+	BestNo    int64  `json:"bestNo"`
+	CheckedNo int64  `json:"checkedNo"`
+}
+
+// peerStore saves peer registry of polaris to local db, so registered peers are not lost by restart of polaris.
+type peerStore struct {
+	logger *log.Logger
+	db     db.DB
+}
+
+func newPeerStore(dbDir string, logger *log.Logger) *peerStore {
+	return &peerStore{logger: logger, db: db.NewDB(db.BadgerImpl, dbDir)}
+}
+
+func peerKey(id types.PeerID) []byte {
+	return append(append(make([]byte, 0, len(peerKeyPrefix)+len(id)), peerKeyPrefix...), []byte(id)...)
+}
+
+func (s *peerStore) put(ps *peerState) {
+	addr, err := proto.Marshal(&ps.addr)
+	if err != nil {
+		s.logger.Warn().Err(err).Str(p2putil.LogPeerID, p2putil.ShortForm(ps.meta.ID)).Msg("Failed to marshal peer address")
+		return
+	}
+	rec := peerRecord{Address: addr, Port: ps.conn.Port, Connected: ps.connected.UnixNano(),
+		LastCheck: ps.lastCheck().UnixNano(), ContFail: atomic.LoadInt32(&ps.contFail), BestHash: ps.bestHash, BestNo: ps.bestNo,
+		CheckedNo: ps.checkedNo}
+	if ps.conn.IP != nil {
+		rec.IP = ps.conn.IP.String()
+	}
+	value, err := json.Marshal(rec)
+	if err != nil {
+		s.logger.Warn().Err(err).Str(p2putil.LogPeerID, p2putil.ShortForm(ps.meta.ID)).Msg("Failed to marshal peer state")
+		return
+	}
+	s.db.Set(peerKey(ps.meta.ID), value)
+}
+
+func (s *peerStore) remove(id types.PeerID) {
+	s.db.Delete(peerKey(id))
+}
+
+// loadAll returns all stored peers. Malformed records are skipped and deleted.
+func (s *peerStore) loadAll(pms *PeerMapService) []*peerState {
+	states := make([]*peerState, 0)
+	invalids := make([][]byte, 0)
+	for iter := s.db.Iterator(peerKeyPrefix, peerKeyEnd); iter.Valid(); iter.Next() {
+		rec, addr := peerRecord{}, &types.PeerAddress{}
+		if err := json.Unmarshal(iter.Value(), &rec); err != nil || proto.Unmarshal(rec.Address, addr) != nil {
+			invalids = append(invalids, append([]byte(nil), iter.Key()...))
+			continue
+		}
+		meta := p2pcommon.FromPeerAddress(addr)
+		if len(meta.ID) == 0 || len(meta.Addresses) == 0 {
+			invalids = append(invalids, append([]byte(nil), iter.Key()...))
+			continue
+		}
+		states = append(states, &peerState{PeerMapService: pms, meta: meta, addr: meta.ToPeerAddress(),
+			conn:      p2pcommon.RemoteConn{IP: net.ParseIP(rec.IP), Port: rec.Port},
+			connected: time.Unix(0, rec.Connected), lCheckTime: time.Unix(0, rec.LastCheck), contFail: rec.ContFail,
+			bestHash: rec.BestHash, bestNo: rec.BestNo, checkedNo: rec.CheckedNo})
+	}
+	if len(invalids) > 0 {
+		s.logger.Info().Int("count", len(invalids)).Msg("Deleting malformed peer records")
+		for _, key := range invalids {
+			s.db.Delete(key)
+		}
+	}
+	return states
+}
+
+func (s *peerStore) close() {
+	s.db.Close()
+}
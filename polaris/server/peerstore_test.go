@@ -0,0 +1,74 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package server
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/types"
+)
+
+func Test_peerStore(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "aergoTestPolarisDB")
+	if err != nil {
+		t.Fatalf("failed to create temp directory: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	ma, _ := types.ParseMultiaddr("/ip4/211.34.56.78/tcp/7846")
+	pms := &PeerMapService{}
+	states := make([]*peerState, 3)
+	for i := range states {
+		meta := p2pcommon.PeerMeta{ID: types.RandomPeerID(), Addresses: []types.Multiaddr{ma}, Role: types.PeerRole_Watcher, Version: "v2.0.0"}
+		states[i] = &peerState{PeerMapService: pms, meta: meta, addr: meta.ToPeerAddress(),
+			conn:      p2pcommon.RemoteConn{IP: net.ParseIP("211.34.56.78"), Port: 7846},
+			connected: time.Unix(1000, 0), lCheckTime: time.Unix(2000, 0), contFail: int32(i), bestNo: int64(i * 100),
+			checkedNo: int64(i * 90)}
+	}
+
+	store := newPeerStore(tmpDir, log.NewLogger("polaris.test"))
+	for _, ps := range states {
+		store.put(ps)
+	}
+	store.remove(states[1].meta.ID)
+	store.close()
+
+	// reopen db
+	store = newPeerStore(tmpDir, log.NewLogger("polaris.test"))
+	defer store.close()
+	loaded := store.loadAll(pms)
+	if len(loaded) != 2 {
+		t.Fatalf("loadAll() returns %v peers, want 2", len(loaded))
+	}
+	found := make(map[types.PeerID]*peerState)
+	for _, ps := range loaded {
+		found[ps.meta.ID] = ps
+	}
+	for _, want := range []*peerState{states[0], states[2]} {
+		got, ok := found[want.meta.ID]
+		if !ok {
+			t.Fatalf("peer %v is not loaded", want.meta.ID)
+		}
+		if !got.meta.Equals(want.meta) || !got.conn.IP.Equal(want.conn.IP) || got.conn.Port != want.conn.Port {
+			t.Errorf("loaded peer = %v, want %v", got.meta, want.meta)
+		}
+		if !got.connected.Equal(want.connected) || !got.lastCheck().Equal(want.lastCheck()) {
+			t.Errorf("loaded times = %v %v, want %v %v", got.connected, got.lastCheck(), want.connected, want.lastCheck())
+		}
+		if got.contFail != want.contFail || got.bestNo != want.bestNo || got.checkedNo != want.checkedNo {
+			t.Errorf("loaded health = %v %v %v, want %v %v %v", got.contFail, got.bestNo, got.checkedNo, want.contFail, want.bestNo, want.checkedNo)
+		}
+		if got.PeerMapService != pms {
+			t.Errorf("loaded peer is not bound to map service")
+		}
+	}
+}
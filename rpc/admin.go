@@ -5,9 +5,12 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"reflect"
 	"time"
 
+	"github.com/aergoio/aergo/internal/loglevel"
 	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/p2p/p2putil"
 
 	"github.com/aergoio/aergo/types"
 
@@ -17,6 +20,8 @@ import (
 	"github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
 	"github.com/opentracing/opentracing-go"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type AdminService struct {
@@ -86,3 +91,121 @@ func (as *AdminService) MempoolTx(ctx context.Context, in *types.AccountList) (*
 	}
 	return &types.SingleBytes{Value: data}, err
 }
+
+// AddDesignatedPeer adds a designated peer, which is in the same multiaddr format of NPAddPeers config.
+func (as *AdminService) AddDesignatedPeer(ctx context.Context, in *types.SingleString) (*types.Empty, error) {
+	ma, err := types.ParseMultiaddr(in.Value)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid peer address: %s", err.Error())
+	}
+	meta, err := p2putil.FromMultiAddrToPeerInfo(ma)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	addr := meta.ToPeerAddress()
+	return as.requestP2PAdmin(&message.ChangeDesignatedPeers{Add: []types.PeerAddress{addr}}, "rpc/AddDesignatedPeer")
+}
+
+// RemoveDesignatedPeer removes a designated peer.
+func (as *AdminService) RemoveDesignatedPeer(ctx context.Context, in *types.SingleBytes) (*types.Empty, error) {
+	if len(in.Value) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "peer id is empty")
+	}
+	return as.requestP2PAdmin(&message.ChangeDesignatedPeers{Remove: []types.PeerID{types.PeerID(in.Value)}}, "rpc/RemoveDesignatedPeer")
+}
+
+// DisconnectPeer closes the connection to the peer.
+func (as *AdminService) DisconnectPeer(ctx context.Context, in *types.SingleBytes) (*types.Empty, error) {
+	if len(in.Value) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "peer id is empty")
+	}
+	return as.requestP2PAdmin(&message.DisconnectPeer{ToWhom: types.PeerID(in.Value)}, "rpc/DisconnectPeer")
+}
+
+// AddBlacklist adds an entry of peer id and/or address range to local blacklist.
+func (as *AdminService) AddBlacklist(ctx context.Context, in *types.SingleString) (*types.Empty, error) {
+	if _, err := types.ParseListEntry(in.Value); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	return as.requestP2PAdmin(&message.ChangeBlacklist{Add: []string{in.Value}}, "rpc/AddBlacklist")
+}
+
+// RemoveBlacklist removes an entry from local blacklist.
+func (as *AdminService) RemoveBlacklist(ctx context.Context, in *types.SingleString) (*types.Empty, error) {
+	if _, err := types.ParseListEntry(in.Value); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	return as.requestP2PAdmin(&message.ChangeBlacklist{Remove: []string{in.Value}}, "rpc/RemoveBlacklist")
+}
+
+// ListBlacklist returns entries of local blacklist.
+func (as *AdminService) ListBlacklist(ctx context.Context, in *types.Empty) (*types.BlacklistEntries, error) {
+	result, err := as.RequestFuture(message.P2PSvc, &message.GetBlacklist{}, requestTimeout, "rpc/ListBlacklist").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.GetBlacklistRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return &types.BlacklistEntries{Entries: rsp.Entries}, nil
+}
+
+// ListWaitingPeers returns peers which are waiting for (re)connection.
+func (as *AdminService) ListWaitingPeers(ctx context.Context, in *types.Empty) (*types.WaitingPeerList, error) {
+	result, err := as.RequestFuture(message.P2PSvc, &message.GetWaitingPeers{}, requestTimeout, "rpc/ListWaitingPeers").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.GetWaitingPeersRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	ret := &types.WaitingPeerList{Peers: make([]*types.WaitingPeer, len(rsp.Peers))}
+	for i, wp := range rsp.Peers {
+		peer := &types.WaitingPeer{Address: wp.Addr, Designated: wp.Designated, TrialCnt: int32(wp.TrialCnt), NextTrial: wp.NextTrial.UnixNano()}
+		if wp.LastResult != nil {
+			peer.LastError = wp.LastResult.Error()
+		}
+		ret.Peers[i] = peer
+	}
+	return ret, nil
+}
+
+// SetLogLevel changes log level of the module in runtime.
+func (as *AdminService) SetLogLevel(ctx context.Context, in *types.LogLevelRequest) (*types.Empty, error) {
+	revertAfter := time.Duration(in.RevertSeconds) * time.Second
+	if err := loglevel.SetLevel(in.Module, in.Level, revertAfter); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	as.Info().Str("module", in.Module).Str("level", in.Level).Dur("revert", revertAfter).Msg("log level is changed by admin")
+	return &types.Empty{}, nil
+}
+
+// ListLogLevels returns current log levels of modules which can be changed in runtime.
+func (as *AdminService) ListLogLevels(ctx context.Context, in *types.Empty) (*types.ModuleLogLevelList, error) {
+	levels := loglevel.Levels()
+	ret := &types.ModuleLogLevelList{Levels: make([]*types.ModuleLogLevel, len(levels))}
+	for i, l := range levels {
+		ret.Levels[i] = &types.ModuleLogLevel{Module: l.Module, Level: l.Level, Configured: l.Configured}
+		if !l.RevertAt.IsZero() {
+			ret.Levels[i].RevertAt = l.RevertAt.UnixNano()
+		}
+	}
+	return ret, nil
+}
+
+func (as *AdminService) requestP2PAdmin(msg interface{}, tip string) (*types.Empty, error) {
+	result, err := as.RequestFuture(message.P2PSvc, msg, requestTimeout, tip).Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.P2PAdminRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	if rsp.Err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, rsp.Err.Error())
+	}
+	return &types.Empty{}, nil
+}
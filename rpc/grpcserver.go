@@ -21,6 +21,7 @@ import (
 	"github.com/aergoio/aergo-lib/log"
 	"github.com/aergoio/aergo/chain"
 	"github.com/aergoio/aergo/consensus"
+	"github.com/aergoio/aergo/contract"
 	"github.com/aergoio/aergo/internal/common"
 	"github.com/aergoio/aergo/message"
 	"github.com/aergoio/aergo/p2p/metric"
@@ -912,7 +913,7 @@ func (rpc *AergoRPCService) GetPeers(ctx context.Context, in *types.PeersParams)
 	ret := &types.PeerList{Peers: make([]*types.Peer, 0, len(rsp.Peers))}
 	for _, pi := range rsp.Peers {
 		blkNotice := &types.NewBlockNotice{BlockHash: pi.LastBlockHash, BlockNo: pi.LastBlockNumber}
-		peer := &types.Peer{Address: pi.Addr, State: int32(pi.State), Bestblock: blkNotice, LashCheck: pi.CheckTime.UnixNano(), Hidden: pi.Hidden, Selfpeer: pi.Self, Version: pi.Version, Certificates: pi.Certificates, AcceptedRole: pi.AcceptedRole}
+		peer := &types.Peer{Address: pi.Addr, State: int32(pi.State), Bestblock: blkNotice, LashCheck: pi.CheckTime.UnixNano(), Hidden: pi.Hidden, Selfpeer: pi.Self, Version: pi.Version, Certificates: pi.Certificates, AcceptedRole: pi.AcceptedRole, Reputation: int32(pi.Reputation)}
 		ret.Peers = append(ret.Peers, peer)
 	}
 
@@ -976,6 +977,23 @@ func (rpc *AergoRPCService) GetAccountVotes(ctx context.Context, in *types.Accou
 	return rsp.Info, rsp.Err
 }
 
+//ListProposals handle rpc request listproposals
+func (rpc *AergoRPCService) ListProposals(ctx context.Context, in *types.Empty) (*types.ProposalList, error) {
+	if err := rpc.checkAuth(ctx, ReadBlockChain); err != nil {
+		return nil, err
+	}
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.ListProposals{}, defaultActorTimeout, "rpc.(*AergoRPCService).ListProposals").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.ListProposalsRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.Proposals, rsp.Err
+}
+
 //GetStaking handle rpc request getstaking
 func (rpc *AergoRPCService) GetStaking(ctx context.Context, in *types.AccountAddress) (*types.Staking, error) {
 	if err := rpc.checkAuth(ctx, ReadBlockChain); err != nil {
@@ -1051,6 +1069,101 @@ func (rpc *AergoRPCService) GetABI(ctx context.Context, in *types.SingleBytes) (
 	return rsp.ABI, rsp.Err
 }
 
+// GetCodeHistory returns the code versions of an upgradeable contract.
+func (rpc *AergoRPCService) GetCodeHistory(ctx context.Context, in *types.SingleBytes) (*types.CodeHistory, error) {
+	if err := rpc.checkAuth(ctx, ReadBlockChain); err != nil {
+		return nil, err
+	}
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.GetCodeHistory{Contract: in.Value}, defaultActorTimeout, "rpc.(*AergoRPCService).GetCodeHistory").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(message.GetCodeHistoryRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.History, rsp.Err
+}
+
+// VerifyContractSource compiles the given source and keeps it if it matches the deployed code.
+func (rpc *AergoRPCService) VerifyContractSource(ctx context.Context, in *types.ContractSource) (*types.ContractSource, error) {
+	if err := rpc.checkAuth(ctx, WriteBlockChain); err != nil {
+		return nil, err
+	}
+	if len(in.Source) > contract.MaxSourceSize {
+		return nil, status.Errorf(codes.InvalidArgument, "source is too large")
+	}
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.VerifyContractSource{Contract: in.ContractAddress, Source: in.Source}, defaultActorTimeout, "rpc.(*AergoRPCService).VerifyContractSource").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(message.VerifyContractSourceRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.Source, rsp.Err
+}
+
+// GetContractSource returns the verified source of a contract.
+func (rpc *AergoRPCService) GetContractSource(ctx context.Context, in *types.SingleBytes) (*types.ContractSource, error) {
+	if err := rpc.checkAuth(ctx, ReadBlockChain); err != nil {
+		return nil, err
+	}
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.GetContractSource{Contract: in.Value}, defaultActorTimeout, "rpc.(*AergoRPCService).GetContractSource").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(message.GetContractSourceRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.Source, rsp.Err
+}
+
+// GetContractDBStats returns the size of the sql database of a contract and
+// the row counts of its tables.
+func (rpc *AergoRPCService) GetContractDBStats(ctx context.Context, in *types.SingleBytes) (*types.ContractDBStats, error) {
+	if err := rpc.checkAuth(ctx, ReadBlockChain); err != nil {
+		return nil, err
+	}
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.GetContractDBStats{Contract: in.Value}, defaultActorTimeout, "rpc.(*AergoRPCService).GetContractDBStats").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(message.GetContractDBStatsRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.Stats, rsp.Err
+}
+
+// QueryContractSQL runs a read-only sql query against the database of a
+// contract at the given block.
+func (rpc *AergoRPCService) QueryContractSQL(ctx context.Context, in *types.SQLQuery) (*types.SQLQueryResult, error) {
+	if err := rpc.checkAuth(ctx, ReadBlockChain); err != nil {
+		return nil, err
+	}
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.QueryContractSQL{
+			Contract: in.ContractAddress,
+			Query:    in.Query,
+			BlockNo:  in.BlockNo,
+			Limit:    in.Limit,
+		}, defaultActorTimeout, "rpc.(*AergoRPCService).QueryContractSQL").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(message.QueryContractSQLRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.Result, rsp.Err
+}
+
 func (rpc *AergoRPCService) QueryContract(ctx context.Context, in *types.Query) (*types.SingleBytes, error) {
 	if err := rpc.checkAuth(ctx, ReadBlockChain); err != nil {
 		return nil, err
@@ -1103,6 +1216,10 @@ func (rpc *AergoRPCService) ListEventStream(in *types.FilterInfo, stream types.A
 	if err != nil {
 		return err
 	}
+	_, err = in.GetExIndexedFilter()
+	if err != nil {
+		return err
+	}
 
 	eventStream := &EventStream{in, stream}
 	rpc.eventStreamLock.Lock()
@@ -1129,8 +1246,9 @@ func (rpc *AergoRPCService) BroadcastToEventStream(events []*types.Event) error
 		if es != nil {
 			rpc.eventStreamLock.RUnlock()
 			argFilter, _ := es.filter.GetExArgFilter()
+			indexed, _ := es.filter.GetExIndexedFilter()
 			for _, event := range events {
-				if event.Filter(es.filter, argFilter) {
+				if event.Filter(es.filter, argFilter, indexed) {
 					err = es.stream.Send(event)
 					if err != nil {
 						logger.Warn().Err(err).Msg("failed to broadcast block stream")
@@ -79,6 +79,9 @@ func (bs *BlockState) AddReceipt(r *types.Receipt) error {
 		for _, e := range r.Events {
 			rBloom.Add(e.ContractAddress)
 			rBloom.Add([]byte(e.EventName))
+			for _, h := range e.Indexed {
+				rBloom.Add(h)
+			}
 		}
 		binary, _ := rBloom.GobEncode()
 		r.Bloom = binary[24:]
@@ -339,6 +339,7 @@ func (bf *BlockFetcher) checkTaskTimeout() error {
 
 		bf.runningQueue.Remove(e)
 
+		bf.compRequester.TellTo(message.P2PSvc, &message.PenalizePeer{ToWhom: task.syncPeer.ID, Reason: message.PenaltyTimeout})
 		if err := bf.processFailedTask(task, false); err != nil {
 			return err
 		}
@@ -24,6 +24,8 @@ type BlockProcessor struct {
 
 	prevBlock *types.Block
 	curBlock  *types.Block
+	// curPeer is the peer which sent curBlock
+	curPeer types.PeerID
 
 	targetBlockNo types.BlockNo
 	name          string
@@ -174,6 +176,9 @@ func (bproc *BlockProcessor) GetBlockChunkRspError(msg *message.GetBlockChunksRs
 func (bproc *BlockProcessor) AddBlockResponse(msg *message.AddBlockRsp) error {
 	if err := bproc.isValidResponse(msg); err != nil {
 		logger.Info().Err(err).Uint64("no", msg.BlockNo).Str("hash", enc.ToString(msg.BlockHash)).Msg("block connect failed")
+		if isInvalidBlockErr(err) && len(bproc.curPeer) > 0 {
+			bproc.compRequester.TellTo(message.P2PSvc, &message.PenalizePeer{ToWhom: bproc.curPeer, Reason: message.PenaltyInvalidBlock})
+		}
 		return err
 	}
 
@@ -257,10 +262,21 @@ func (bproc *BlockProcessor) getNextBlockToConnect() *types.Block {
 		Int("idx in req", next).Msg("next block to connect")
 
 	bproc.curBlock = nextBlock
+	bproc.curPeer = bproc.curConnRequest.FromPeer
 
 	return nextBlock
 }
 
+// isInvalidBlockErr returns whether err means that the block itself is wrong, rather than the local node.
+// State and receipt root mismatches can be caused by the local node, so they are not counted.
+func isInvalidBlockErr(err error) bool {
+	switch err {
+	case chain.ErrorBlockVerifySign, chain.ErrorBlockVerifyTxRoot:
+		return true
+	}
+	return false
+}
+
 func (bproc *BlockProcessor) connectBlock(block *types.Block) {
 	if block == nil {
 		return
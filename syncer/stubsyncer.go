@@ -108,6 +108,8 @@ func isOtherActorRequest(msg interface{}) bool {
 		return true
 	case *message.AddBlock:
 		return true
+	case *message.PenalizePeer:
+		return true
 	}
 
 	return false
@@ -196,6 +198,8 @@ func (stubSyncer *StubSyncer) handleActorMsg(inmsg interface{}) {
 	case *message.AddBlock:
 		stubSyncer.AddBlock(msg, nil)
 
+	case *message.PenalizePeer: // donothing
+
 	case *actor.Started, *actor.Stopping, *actor.Stopped, *component.CompStatReq: // donothing
 
 	default:
@@ -5,8 +5,8 @@ import (
 	"github.com/aergoio/aergo/p2p/p2putil"
 	"runtime/debug"
 
-	"github.com/aergoio/aergo-lib/log"
 	cfg "github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/internal/loglevel"
 	"github.com/aergoio/aergo/pkg/component"
 
 	"fmt"
@@ -66,7 +66,7 @@ type SyncerDebug struct {
 }
 
 var (
-	logger             = log.NewLogger("syncer")
+	logger             = loglevel.NewLogger("syncer")
 	NameFinder         = "Finder"
 	NameHashFetcher    = "HashFetcher"
 	NameBlockFetcher   = "BlockFetcher"
@@ -101,7 +101,7 @@ func NewSyncer(cfg *cfg.Config, chain types.ChainAccessor, syncerCfg *SyncerConf
 
 	syncer := &Syncer{cfg: cfg, syncerCfg: syncerCfg}
 
-	syncer.BaseComponent = component.NewBaseComponent(message.SyncerSvc, syncer, logger)
+	syncer.BaseComponent = component.NewBaseComponent(message.SyncerSvc, syncer, logger.Logger)
 	syncer.compRequester = syncer.BaseComponent
 	syncer.chain = chain
 	syncer.Seq = 1
@@ -13,6 +13,22 @@ const AddressLength = 33
 const NameLength = 12
 const EncodedAddressLength = 52
 
+// MaxSubNameLength is the maximum length of a dotted subname. It is shorter
+// than an address so that a subname is never taken for a raw address.
+const MaxSubNameLength = AddressLength - 1
+
+// IsSubName reports whether name is a dotted subname such as shop.mycompany.
+// It does not check the characters of the labels.
+func IsSubName(name []byte) bool {
+	return len(name) > NameLength+1 && len(name) <= MaxSubNameLength &&
+		strings.Contains(string(name), ".") && !IsSpecialAccount(name)
+}
+
+// ParentName returns the name which the subname belongs to.
+func ParentName(subname string) string {
+	return subname[strings.Index(subname, ".")+1:]
+}
+
 //NewAccount alloc new account object
 func NewAccount(addr []byte) *Account {
 	return &Account{
@@ -59,7 +75,7 @@ const allowed = "abcdefghijklmnopqrstuvwxyz1234567890."
 func DecodeAddress(encodedAddr string) (Address, error) {
 	if IsSpecialAccount([]byte(encodedAddr)) {
 		return []byte(encodedAddr), nil
-	} else if len(encodedAddr) <= NameLength  { // name address
+	} else if len(encodedAddr) <= NameLength || IsSubName([]byte(encodedAddr)) { // name address
 		name := encodedAddr
 		for _, char := range string(name) {
 			if !strings.Contains(allowed, strings.ToLower(string(char))) {
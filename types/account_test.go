@@ -48,3 +48,17 @@ func TestToAddress(t *testing.T) {
 	addr := ToAddress("")
 	assert.Equal(t, 0, len(addr), "nil")
 }
+
+func TestDecodeSubName(t *testing.T) {
+	addr, err := DecodeAddress("shop.mycompany0000")
+	assert.NoError(t, err, "decode subname")
+	assert.Equal(t, "shop.mycompany0000", string(addr), "decode subname")
+	assert.Equal(t, "mycompany0000", ParentName("shop.mycompany0000"), "parent")
+
+	assert.True(t, IsSubName([]byte("a.b.mycompany000")), "nested subname")
+	assert.False(t, IsSubName([]byte("aergo.system")), "special account")
+	assert.False(t, IsSubName([]byte("mycompany000")), "name")
+
+	_, err = DecodeAddress("shop!.mycompany0000")
+	assert.Error(t, err, "not allowed character")
+}
@@ -26,6 +26,315 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type WaitingPeer struct {
+	Address              *PeerAddress `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Designated           bool         `protobuf:"varint,2,opt,name=designated,proto3" json:"designated,omitempty"`
+	TrialCnt             int32        `protobuf:"varint,3,opt,name=trialCnt,proto3" json:"trialCnt,omitempty"`
+	NextTrial            int64        `protobuf:"varint,4,opt,name=nextTrial,proto3" json:"nextTrial,omitempty"`
+	LastError            string       `protobuf:"bytes,5,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *WaitingPeer) Reset()         { *m = WaitingPeer{} }
+func (m *WaitingPeer) String() string { return proto.CompactTextString(m) }
+func (*WaitingPeer) ProtoMessage()    {}
+func (*WaitingPeer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_689acc0f363f2cb6, []int{0}
+}
+func (m *WaitingPeer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WaitingPeer.Unmarshal(m, b)
+}
+func (m *WaitingPeer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WaitingPeer.Marshal(b, m, deterministic)
+}
+func (dst *WaitingPeer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WaitingPeer.Merge(dst, src)
+}
+func (m *WaitingPeer) XXX_Size() int {
+	return xxx_messageInfo_WaitingPeer.Size(m)
+}
+func (m *WaitingPeer) XXX_DiscardUnknown() {
+	xxx_messageInfo_WaitingPeer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WaitingPeer proto.InternalMessageInfo
+
+func (m *WaitingPeer) GetAddress() *PeerAddress {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *WaitingPeer) GetDesignated() bool {
+	if m != nil {
+		return m.Designated
+	}
+	return false
+}
+
+func (m *WaitingPeer) GetTrialCnt() int32 {
+	if m != nil {
+		return m.TrialCnt
+	}
+	return 0
+}
+
+func (m *WaitingPeer) GetNextTrial() int64 {
+	if m != nil {
+		return m.NextTrial
+	}
+	return 0
+}
+
+func (m *WaitingPeer) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+type WaitingPeerList struct {
+	Peers                []*WaitingPeer `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *WaitingPeerList) Reset()         { *m = WaitingPeerList{} }
+func (m *WaitingPeerList) String() string { return proto.CompactTextString(m) }
+func (*WaitingPeerList) ProtoMessage()    {}
+func (*WaitingPeerList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_689acc0f363f2cb6, []int{1}
+}
+func (m *WaitingPeerList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WaitingPeerList.Unmarshal(m, b)
+}
+func (m *WaitingPeerList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WaitingPeerList.Marshal(b, m, deterministic)
+}
+func (dst *WaitingPeerList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WaitingPeerList.Merge(dst, src)
+}
+func (m *WaitingPeerList) XXX_Size() int {
+	return xxx_messageInfo_WaitingPeerList.Size(m)
+}
+func (m *WaitingPeerList) XXX_DiscardUnknown() {
+	xxx_messageInfo_WaitingPeerList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WaitingPeerList proto.InternalMessageInfo
+
+func (m *WaitingPeerList) GetPeers() []*WaitingPeer {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+type BlacklistEntries struct {
+	Entries              []string `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlacklistEntries) Reset()         { *m = BlacklistEntries{} }
+func (m *BlacklistEntries) String() string { return proto.CompactTextString(m) }
+func (*BlacklistEntries) ProtoMessage()    {}
+func (*BlacklistEntries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_689acc0f363f2cb6, []int{2}
+}
+func (m *BlacklistEntries) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlacklistEntries.Unmarshal(m, b)
+}
+func (m *BlacklistEntries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlacklistEntries.Marshal(b, m, deterministic)
+}
+func (dst *BlacklistEntries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlacklistEntries.Merge(dst, src)
+}
+func (m *BlacklistEntries) XXX_Size() int {
+	return xxx_messageInfo_BlacklistEntries.Size(m)
+}
+func (m *BlacklistEntries) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlacklistEntries.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlacklistEntries proto.InternalMessageInfo
+
+func (m *BlacklistEntries) GetEntries() []string {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type LogLevelRequest struct {
+	Module               string   `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Level                string   `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	RevertSeconds        uint32   `protobuf:"varint,3,opt,name=revertSeconds,proto3" json:"revertSeconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogLevelRequest) Reset()         { *m = LogLevelRequest{} }
+func (m *LogLevelRequest) String() string { return proto.CompactTextString(m) }
+func (*LogLevelRequest) ProtoMessage()    {}
+func (*LogLevelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_689acc0f363f2cb6, []int{3}
+}
+func (m *LogLevelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogLevelRequest.Unmarshal(m, b)
+}
+func (m *LogLevelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogLevelRequest.Marshal(b, m, deterministic)
+}
+func (dst *LogLevelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogLevelRequest.Merge(dst, src)
+}
+func (m *LogLevelRequest) XXX_Size() int {
+	return xxx_messageInfo_LogLevelRequest.Size(m)
+}
+func (m *LogLevelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogLevelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogLevelRequest proto.InternalMessageInfo
+
+func (m *LogLevelRequest) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *LogLevelRequest) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+func (m *LogLevelRequest) GetRevertSeconds() uint32 {
+	if m != nil {
+		return m.RevertSeconds
+	}
+	return 0
+}
+
+type ModuleLogLevel struct {
+	Module               string   `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Level                string   `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	Configured           string   `protobuf:"bytes,3,opt,name=configured,proto3" json:"configured,omitempty"`
+	RevertAt             int64    `protobuf:"varint,4,opt,name=revertAt,proto3" json:"revertAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModuleLogLevel) Reset()         { *m = ModuleLogLevel{} }
+func (m *ModuleLogLevel) String() string { return proto.CompactTextString(m) }
+func (*ModuleLogLevel) ProtoMessage()    {}
+func (*ModuleLogLevel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_689acc0f363f2cb6, []int{4}
+}
+func (m *ModuleLogLevel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModuleLogLevel.Unmarshal(m, b)
+}
+func (m *ModuleLogLevel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModuleLogLevel.Marshal(b, m, deterministic)
+}
+func (dst *ModuleLogLevel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleLogLevel.Merge(dst, src)
+}
+func (m *ModuleLogLevel) XXX_Size() int {
+	return xxx_messageInfo_ModuleLogLevel.Size(m)
+}
+func (m *ModuleLogLevel) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleLogLevel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleLogLevel proto.InternalMessageInfo
+
+func (m *ModuleLogLevel) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *ModuleLogLevel) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+func (m *ModuleLogLevel) GetConfigured() string {
+	if m != nil {
+		return m.Configured
+	}
+	return ""
+}
+
+func (m *ModuleLogLevel) GetRevertAt() int64 {
+	if m != nil {
+		return m.RevertAt
+	}
+	return 0
+}
+
+type ModuleLogLevelList struct {
+	Levels               []*ModuleLogLevel `protobuf:"bytes,1,rep,name=levels,proto3" json:"levels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ModuleLogLevelList) Reset()         { *m = ModuleLogLevelList{} }
+func (m *ModuleLogLevelList) String() string { return proto.CompactTextString(m) }
+func (*ModuleLogLevelList) ProtoMessage()    {}
+func (*ModuleLogLevelList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_689acc0f363f2cb6, []int{5}
+}
+func (m *ModuleLogLevelList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModuleLogLevelList.Unmarshal(m, b)
+}
+func (m *ModuleLogLevelList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModuleLogLevelList.Marshal(b, m, deterministic)
+}
+func (dst *ModuleLogLevelList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleLogLevelList.Merge(dst, src)
+}
+func (m *ModuleLogLevelList) XXX_Size() int {
+	return xxx_messageInfo_ModuleLogLevelList.Size(m)
+}
+func (m *ModuleLogLevelList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleLogLevelList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleLogLevelList proto.InternalMessageInfo
+
+func (m *ModuleLogLevelList) GetLevels() []*ModuleLogLevel {
+	if m != nil {
+		return m.Levels
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*WaitingPeer)(nil), "types.WaitingPeer")
+	proto.RegisterType((*WaitingPeerList)(nil), "types.WaitingPeerList")
+	proto.RegisterType((*BlacklistEntries)(nil), "types.BlacklistEntries")
+	proto.RegisterType((*LogLevelRequest)(nil), "types.LogLevelRequest")
+	proto.RegisterType((*ModuleLogLevel)(nil), "types.ModuleLogLevel")
+	proto.RegisterType((*ModuleLogLevelList)(nil), "types.ModuleLogLevelList")
+}
+
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
 var _ grpc.ClientConn
@@ -42,6 +351,24 @@ type AdminRPCServiceClient interface {
 	MempoolTxStat(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SingleBytes, error)
 	// Returns the TX-relasted statistics of the current mempool.
 	MempoolTx(ctx context.Context, in *AccountList, opts ...grpc.CallOption) (*SingleBytes, error)
+	// Adds a designated peer in multiaddr format, and connects to it.
+	AddDesignatedPeer(ctx context.Context, in *SingleString, opts ...grpc.CallOption) (*Empty, error)
+	// Removes a designated peer. The connection to the peer is not closed.
+	RemoveDesignatedPeer(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*Empty, error)
+	// Closes the connection to the peer.
+	DisconnectPeer(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*Empty, error)
+	// Adds an entry to local blacklist, and disconnects banned peers.
+	AddBlacklist(ctx context.Context, in *SingleString, opts ...grpc.CallOption) (*Empty, error)
+	// Removes an entry from local blacklist.
+	RemoveBlacklist(ctx context.Context, in *SingleString, opts ...grpc.CallOption) (*Empty, error)
+	// Returns entries of local blacklist.
+	ListBlacklist(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BlacklistEntries, error)
+	// Returns peers waiting for (re)connection with their next trial time.
+	ListWaitingPeers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*WaitingPeerList, error)
+	// Changes log level of the module. Empty level means the configured level.
+	SetLogLevel(ctx context.Context, in *LogLevelRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Returns current log levels of modules.
+	ListLogLevels(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ModuleLogLevelList, error)
 }
 
 type adminRPCServiceClient struct {
@@ -70,12 +397,111 @@ func (c *adminRPCServiceClient) MempoolTx(ctx context.Context, in *AccountList,
 	return out, nil
 }
 
+func (c *adminRPCServiceClient) AddDesignatedPeer(ctx context.Context, in *SingleString, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/types.AdminRPCService/AddDesignatedPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCServiceClient) RemoveDesignatedPeer(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/types.AdminRPCService/RemoveDesignatedPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCServiceClient) DisconnectPeer(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/types.AdminRPCService/DisconnectPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCServiceClient) AddBlacklist(ctx context.Context, in *SingleString, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/types.AdminRPCService/AddBlacklist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCServiceClient) RemoveBlacklist(ctx context.Context, in *SingleString, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/types.AdminRPCService/RemoveBlacklist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCServiceClient) ListBlacklist(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BlacklistEntries, error) {
+	out := new(BlacklistEntries)
+	err := c.cc.Invoke(ctx, "/types.AdminRPCService/ListBlacklist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCServiceClient) ListWaitingPeers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*WaitingPeerList, error) {
+	out := new(WaitingPeerList)
+	err := c.cc.Invoke(ctx, "/types.AdminRPCService/ListWaitingPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCServiceClient) SetLogLevel(ctx context.Context, in *LogLevelRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/types.AdminRPCService/SetLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminRPCServiceClient) ListLogLevels(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ModuleLogLevelList, error) {
+	out := new(ModuleLogLevelList)
+	err := c.cc.Invoke(ctx, "/types.AdminRPCService/ListLogLevels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminRPCServiceServer is the server API for AdminRPCService service.
 type AdminRPCServiceServer interface {
 	// Returns the TX-relasted statistics of the current mempool.
 	MempoolTxStat(context.Context, *Empty) (*SingleBytes, error)
 	// Returns the TX-relasted statistics of the current mempool.
 	MempoolTx(context.Context, *AccountList) (*SingleBytes, error)
+	// Adds a designated peer in multiaddr format, and connects to it.
+	AddDesignatedPeer(context.Context, *SingleString) (*Empty, error)
+	// Removes a designated peer. The connection to the peer is not closed.
+	RemoveDesignatedPeer(context.Context, *SingleBytes) (*Empty, error)
+	// Closes the connection to the peer.
+	DisconnectPeer(context.Context, *SingleBytes) (*Empty, error)
+	// Adds an entry to local blacklist, and disconnects banned peers.
+	AddBlacklist(context.Context, *SingleString) (*Empty, error)
+	// Removes an entry from local blacklist.
+	RemoveBlacklist(context.Context, *SingleString) (*Empty, error)
+	// Returns entries of local blacklist.
+	ListBlacklist(context.Context, *Empty) (*BlacklistEntries, error)
+	// Returns peers waiting for (re)connection with their next trial time.
+	ListWaitingPeers(context.Context, *Empty) (*WaitingPeerList, error)
+	// Changes log level of the module. Empty level means the configured level.
+	SetLogLevel(context.Context, *LogLevelRequest) (*Empty, error)
+	// Returns current log levels of modules.
+	ListLogLevels(context.Context, *Empty) (*ModuleLogLevelList, error)
 }
 
 func RegisterAdminRPCServiceServer(s *grpc.Server, srv AdminRPCServiceServer) {
@@ -118,6 +544,168 @@ func _AdminRPCService_MempoolTx_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminRPCService_AddDesignatedPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SingleString)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServiceServer).AddDesignatedPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AdminRPCService/AddDesignatedPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServiceServer).AddDesignatedPeer(ctx, req.(*SingleString))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPCService_RemoveDesignatedPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SingleBytes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServiceServer).RemoveDesignatedPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AdminRPCService/RemoveDesignatedPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServiceServer).RemoveDesignatedPeer(ctx, req.(*SingleBytes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPCService_DisconnectPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SingleBytes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServiceServer).DisconnectPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AdminRPCService/DisconnectPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServiceServer).DisconnectPeer(ctx, req.(*SingleBytes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPCService_AddBlacklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SingleString)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServiceServer).AddBlacklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AdminRPCService/AddBlacklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServiceServer).AddBlacklist(ctx, req.(*SingleString))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPCService_RemoveBlacklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SingleString)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServiceServer).RemoveBlacklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AdminRPCService/RemoveBlacklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServiceServer).RemoveBlacklist(ctx, req.(*SingleString))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPCService_ListBlacklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServiceServer).ListBlacklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AdminRPCService/ListBlacklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServiceServer).ListBlacklist(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPCService_ListWaitingPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServiceServer).ListWaitingPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AdminRPCService/ListWaitingPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServiceServer).ListWaitingPeers(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPCService_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServiceServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AdminRPCService/SetLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServiceServer).SetLogLevel(ctx, req.(*LogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminRPCService_ListLogLevels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminRPCServiceServer).ListLogLevels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AdminRPCService/ListLogLevels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminRPCServiceServer).ListLogLevels(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AdminRPCService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "types.AdminRPCService",
 	HandlerType: (*AdminRPCServiceServer)(nil),
@@ -130,23 +718,84 @@ var _AdminRPCService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MempoolTx",
 			Handler:    _AdminRPCService_MempoolTx_Handler,
 		},
+		{
+			MethodName: "AddDesignatedPeer",
+			Handler:    _AdminRPCService_AddDesignatedPeer_Handler,
+		},
+		{
+			MethodName: "RemoveDesignatedPeer",
+			Handler:    _AdminRPCService_RemoveDesignatedPeer_Handler,
+		},
+		{
+			MethodName: "DisconnectPeer",
+			Handler:    _AdminRPCService_DisconnectPeer_Handler,
+		},
+		{
+			MethodName: "AddBlacklist",
+			Handler:    _AdminRPCService_AddBlacklist_Handler,
+		},
+		{
+			MethodName: "RemoveBlacklist",
+			Handler:    _AdminRPCService_RemoveBlacklist_Handler,
+		},
+		{
+			MethodName: "ListBlacklist",
+			Handler:    _AdminRPCService_ListBlacklist_Handler,
+		},
+		{
+			MethodName: "ListWaitingPeers",
+			Handler:    _AdminRPCService_ListWaitingPeers_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _AdminRPCService_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "ListLogLevels",
+			Handler:    _AdminRPCService_ListLogLevels_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin.proto",
 }
 
-func init() { proto.RegisterFile("admin.proto", fileDescriptor_admin_dc33129327e562d2) }
-
-var fileDescriptor_admin_dc33129327e562d2 = []byte{
-	// 147 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4e, 0x4c, 0xc9, 0xcd,
-	0xcc, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x2d, 0xa9, 0x2c, 0x48, 0x2d, 0x96, 0xe2,
-	0x2c, 0x2a, 0x48, 0x86, 0x88, 0x48, 0xf1, 0x26, 0x26, 0x27, 0xe7, 0x97, 0xe6, 0x95, 0x40, 0xb8,
-	0x46, 0xb5, 0x5c, 0xfc, 0x8e, 0x20, 0xf5, 0x41, 0x01, 0xce, 0xc1, 0xa9, 0x45, 0x65, 0x99, 0xc9,
-	0xa9, 0x42, 0xc6, 0x5c, 0xbc, 0xbe, 0xa9, 0xb9, 0x05, 0xf9, 0xf9, 0x39, 0x21, 0x15, 0xc1, 0x25,
-	0x89, 0x25, 0x42, 0x3c, 0x7a, 0x60, 0x53, 0xf4, 0x5c, 0x73, 0x0b, 0x4a, 0x2a, 0xa5, 0x84, 0xa0,
-	0xbc, 0xe0, 0xcc, 0xbc, 0xf4, 0x9c, 0x54, 0xa7, 0xca, 0x92, 0xd4, 0x62, 0x25, 0x06, 0x21, 0x53,
-	0x2e, 0x4e, 0xb8, 0x26, 0x21, 0x98, 0x12, 0x47, 0x88, 0x55, 0x3e, 0x99, 0xc5, 0x25, 0xd8, 0xb5,
-	0x25, 0xb1, 0x81, 0x5d, 0x61, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x92, 0xc9, 0x09, 0xab, 0xb5,
-	0x00, 0x00, 0x00,
+func init() { proto.RegisterFile("admin.proto", fileDescriptor_admin_689acc0f363f2cb6) }
+
+var fileDescriptor_admin_689acc0f363f2cb6 = []byte{
+	// 548 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xd1, 0x4e, 0xdb, 0x30,
+	0x14, 0x55, 0x06, 0x05, 0x72, 0x4b, 0x29, 0xf3, 0x18, 0xcb, 0xaa, 0x09, 0x55, 0xd1, 0x1e, 0xf2,
+	0xc0, 0x90, 0x80, 0x09, 0x21, 0xed, 0x29, 0x94, 0xbe, 0x15, 0x09, 0x39, 0x48, 0x7b, 0xce, 0xe2,
+	0xbb, 0xc8, 0x5a, 0x6a, 0x67, 0xb6, 0x5b, 0xd1, 0xfd, 0xd6, 0xbe, 0x6a, 0x7f, 0x31, 0xc5, 0x49,
+	0xda, 0xa4, 0xed, 0x03, 0xbc, 0xe5, 0x5e, 0x9f, 0xe3, 0x7b, 0x74, 0x7c, 0x6e, 0xa0, 0x1b, 0xb3,
+	0x29, 0x17, 0x17, 0xb9, 0x92, 0x46, 0x92, 0x8e, 0x59, 0xe4, 0xa8, 0x07, 0xae, 0xca, 0x93, 0xb2,
+	0x33, 0xe8, 0xc5, 0x49, 0x22, 0x67, 0xc2, 0x54, 0x25, 0x08, 0xc9, 0xb0, 0xfc, 0xf6, 0xff, 0x3a,
+	0xd0, 0xfd, 0x1e, 0x73, 0xc3, 0x45, 0xfa, 0x88, 0xa8, 0xc8, 0x39, 0xec, 0xc7, 0x8c, 0x29, 0xd4,
+	0xda, 0x73, 0x86, 0x4e, 0xd0, 0xbd, 0x22, 0x17, 0xf6, 0xba, 0x8b, 0xe2, 0x34, 0x2c, 0x4f, 0x68,
+	0x0d, 0x21, 0x67, 0x00, 0x0c, 0x35, 0x4f, 0x45, 0x6c, 0x90, 0x79, 0x6f, 0x86, 0x4e, 0x70, 0x40,
+	0x1b, 0x1d, 0x32, 0x80, 0x03, 0xa3, 0x78, 0x9c, 0x8d, 0x84, 0xf1, 0x76, 0x86, 0x4e, 0xd0, 0xa1,
+	0xcb, 0x9a, 0x7c, 0x02, 0x57, 0xe0, 0xb3, 0x79, 0x2a, 0x6a, 0x6f, 0x77, 0xe8, 0x04, 0x3b, 0x74,
+	0xd5, 0x28, 0x4e, 0xb3, 0x58, 0x9b, 0xb1, 0x52, 0x52, 0x79, 0x9d, 0xa1, 0x13, 0xb8, 0x74, 0xd5,
+	0xf0, 0xbf, 0x41, 0xbf, 0x21, 0x7a, 0xc2, 0xb5, 0x21, 0x01, 0x74, 0x72, 0x44, 0x55, 0xc8, 0xde,
+	0x69, 0xc8, 0x6e, 0xc0, 0x68, 0x09, 0xf0, 0xcf, 0xe1, 0xf8, 0x2e, 0x8b, 0x93, 0x5f, 0x19, 0xd7,
+	0x66, 0x2c, 0x8c, 0xe2, 0xa8, 0x89, 0x07, 0xfb, 0x58, 0x7e, 0x5a, 0xbe, 0x4b, 0xeb, 0xd2, 0x47,
+	0xe8, 0x4f, 0x64, 0x3a, 0xc1, 0x39, 0x66, 0x14, 0x7f, 0xcf, 0x50, 0x1b, 0x72, 0x0a, 0x7b, 0x53,
+	0xc9, 0x66, 0x19, 0x5a, 0x8b, 0x5c, 0x5a, 0x55, 0xe4, 0x04, 0x3a, 0x59, 0x81, 0xb3, 0x46, 0xb8,
+	0xb4, 0x2c, 0xc8, 0x67, 0xe8, 0x29, 0x9c, 0xa3, 0x32, 0x11, 0x26, 0x52, 0x30, 0x6d, 0x8d, 0xe8,
+	0xd1, 0x76, 0xd3, 0xff, 0x03, 0x47, 0x0f, 0xf6, 0x96, 0x7a, 0xd8, 0x2b, 0xa7, 0x9c, 0x01, 0x24,
+	0x52, 0xfc, 0xe4, 0xe9, 0x4c, 0x21, 0xb3, 0x23, 0x5c, 0xda, 0xe8, 0x14, 0x2f, 0x51, 0x0e, 0x0c,
+	0x4d, 0x65, 0xf6, 0xb2, 0xf6, 0x47, 0x40, 0xda, 0xb3, 0xad, 0xa1, 0x5f, 0x60, 0xcf, 0x5e, 0x5d,
+	0x3b, 0xfa, 0xbe, 0x72, 0xb4, 0x0d, 0xa5, 0x15, 0xe8, 0xea, 0xdf, 0x2e, 0xf4, 0xc3, 0x22, 0x85,
+	0xf4, 0x71, 0x14, 0xa1, 0x9a, 0xf3, 0x04, 0xc9, 0x25, 0xf4, 0x1e, 0x70, 0x9a, 0x4b, 0x99, 0x3d,
+	0x3d, 0x47, 0x26, 0x36, 0xe4, 0xb0, 0xba, 0x63, 0x3c, 0xcd, 0xcd, 0x62, 0x50, 0xbf, 0x51, 0xc4,
+	0x45, 0x9a, 0xe1, 0xdd, 0xc2, 0xa0, 0x26, 0xd7, 0xe0, 0x2e, 0x29, 0xa4, 0x06, 0x84, 0x65, 0x7c,
+	0x0b, 0x59, 0x5b, 0x49, 0x37, 0xf0, 0x36, 0x64, 0xec, 0x7e, 0x99, 0x3b, 0x9b, 0xe4, 0x77, 0x2d,
+	0x60, 0x64, 0x14, 0x17, 0xe9, 0xa0, 0x25, 0x80, 0xdc, 0xc2, 0x09, 0xc5, 0xa9, 0x9c, 0xe3, 0x1a,
+	0x75, 0xcb, 0x8c, 0x35, 0xe6, 0x15, 0x1c, 0xdd, 0x73, 0x9d, 0x48, 0x21, 0x30, 0x31, 0x2f, 0xe4,
+	0x5c, 0xc2, 0x61, 0xc8, 0xd8, 0x32, 0x7a, 0x2f, 0x11, 0xf8, 0x15, 0xfa, 0xa5, 0xc0, 0x57, 0xb1,
+	0x6e, 0xa0, 0x57, 0x58, 0xb5, 0xe2, 0xb4, 0x6d, 0xff, 0x50, 0x55, 0x1b, 0x4b, 0x70, 0x0b, 0xc7,
+	0x05, 0xaf, 0xb1, 0x32, 0x7a, 0x8d, 0x7a, 0xba, 0xb9, 0x55, 0x36, 0x2b, 0xd7, 0xd0, 0x8d, 0xd0,
+	0xac, 0xa2, 0x5b, 0xc1, 0xd6, 0x16, 0x67, 0xc3, 0x7d, 0x2b, 0xb3, 0x06, 0xad, 0xcf, 0xfa, 0xb8,
+	0x35, 0x6f, 0x05, 0xe3, 0xc7, 0x9e, 0xfd, 0x77, 0x5d, 0xff, 0x1f, 0x00, 0xbc, 0xd6, 0x29, 0xbf,
+	0xf7, 0x04, 0x00, 0x00,
 }
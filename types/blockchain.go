@@ -8,11 +8,13 @@ package types
 import (
 	"bytes"
 	"encoding/binary"
+	"fmt"
 	"io"
 	"math"
 	"math/big"
 	"reflect"
 	"runtime"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -525,6 +527,18 @@ func CalculateTxsRootHash(txs []*Tx) []byte {
 	return merkle.CalculateMerkleRoot(mes)
 }
 
+// ShortTxIDLength is the length of short tx id used to relay compact block.
+const ShortTxIDLength = 6
+
+// ShortTxID returns the short id of tx in the block. The id is salted by the block hash, so txs whose ids collide
+// in one block are not likely to collide in another.
+func ShortTxID(blockHash, txHash []byte) []byte {
+	h := sha256.New()
+	h.Write(blockHash)
+	h.Write(txHash)
+	return h.Sum(nil)[:ShortTxIDLength]
+}
+
 func NewTx() *Tx {
 	tx := &Tx{
 		Body: &TxBody{
@@ -560,7 +574,7 @@ func (tx *Tx) HasNameAccount() bool {
 }
 
 func (tx *Tx) HasNameRecipient() bool {
-	return tx.Body.Recipient != nil && len(tx.Body.Recipient) <= NameLength
+	return tx.Body.Recipient != nil && (len(tx.Body.Recipient) <= NameLength || IsSubName(tx.Body.Recipient))
 }
 
 func (tx *Tx) Clone() *Tx {
@@ -695,10 +709,124 @@ func (b *BlockHeaderInfo) ChainIdHash() []byte {
 
 // HasFunction returns if a function with the given name exists in the ABI definition
 func (abi *ABI) HasFunction(name string) bool {
+	return abi.GetFunction(name) != nil
+}
+
+// GetFunction returns the function with the given name in the ABI definition
+func (abi *ABI) GetFunction(name string) *Function {
 	for _, fn := range abi.Functions {
 		if fn.GetName() == name {
+			return fn
+		}
+	}
+	return nil
+}
+
+var abiTypes = map[string]bool{
+	"any":     true,
+	"string":  true,
+	"number":  true,
+	"integer": true,
+	"bool":    true,
+	"bignum":  true,
+	"address": true,
+	"table":   true,
+}
+
+// IsValidABIType returns if t can be used as a type of the ABI definition. A
+// type followed by "[]" is an array of that type.
+func IsValidABIType(t string) bool {
+	return abiTypes[strings.TrimSuffix(t, "[]")]
+}
+
+// ValidateArgs checks the JSON decoded arguments of a call against the
+// argument types of the function. Untyped arguments accept any value.
+func (fn *Function) ValidateArgs(args []interface{}) error {
+	typed := false
+	for _, arg := range fn.Arguments {
+		if arg.Type != "" {
+			typed = true
+			break
+		}
+	}
+	if !typed {
+		return nil
+	}
+	if len(args) > len(fn.Arguments) {
+		return fmt.Errorf("too many arguments: %s takes %d", fn.Name, len(fn.Arguments))
+	}
+	for i, arg := range fn.Arguments {
+		if arg.Type == "" {
+			continue
+		}
+		var v interface{}
+		if i < len(args) {
+			v = args[i]
+		}
+		if !checkABIType(arg.Type, v) {
+			return fmt.Errorf("argument %d (%s) of %s: %s expected", i+1, arg.Name, fn.Name, arg.Type)
+		}
+	}
+	return nil
+}
+
+func checkABIType(t string, v interface{}) bool {
+	if strings.HasSuffix(t, "[]") {
+		elems, ok := v.([]interface{})
+		if !ok {
+			return false
+		}
+		for _, e := range elems {
+			if !checkABIType(strings.TrimSuffix(t, "[]"), e) {
+				return false
+			}
+		}
+		return true
+	}
+	switch t {
+	case "any":
+		return true
+	case "string":
+		_, ok := v.(string)
+		return ok
+	case "number":
+		_, ok := v.(float64)
+		return ok
+	case "integer":
+		n, ok := v.(float64)
+		return ok && n == math.Trunc(n)
+	case "bool":
+		_, ok := v.(bool)
+		return ok
+	case "bignum":
+		switch n := v.(type) {
+		case float64:
+			return n == math.Trunc(n)
+		case string:
+			_, ok := new(big.Int).SetString(n, 10)
+			return ok
+		case map[string]interface{}:
+			s, ok := n["_bignum"].(string)
+			if !ok {
+				return false
+			}
+			_, ok = new(big.Int).SetString(s, 10)
+			return ok
+		}
+		return false
+	case "address":
+		s, ok := v.(string)
+		if !ok {
+			return false
+		}
+		_, err := DecodeAddress(s)
+		return err == nil
+	case "table":
+		switch v.(type) {
+		case map[string]interface{}, []interface{}:
 			return true
 		}
+		return false
 	}
 	return false
 }
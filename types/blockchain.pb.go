@@ -53,7 +53,7 @@ func (x TxType) String() string {
 	return proto.EnumName(TxType_name, int32(x))
 }
 func (TxType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_bcfdea0869ea68f3, []int{0}
+	return fileDescriptor_blockchain_be88d8ca0fef0a7b, []int{0}
 }
 
 type Block struct {
@@ -69,7 +69,7 @@ func (m *Block) Reset()         { *m = Block{} }
 func (m *Block) String() string { return proto.CompactTextString(m) }
 func (*Block) ProtoMessage()    {}
 func (*Block) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_bcfdea0869ea68f3, []int{0}
+	return fileDescriptor_blockchain_be88d8ca0fef0a7b, []int{0}
 }
 func (m *Block) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Block.Unmarshal(m, b)
@@ -132,7 +132,7 @@ func (m *BlockHeader) Reset()         { *m = BlockHeader{} }
 func (m *BlockHeader) String() string { return proto.CompactTextString(m) }
 func (*BlockHeader) ProtoMessage()    {}
 func (*BlockHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_bcfdea0869ea68f3, []int{1}
+	return fileDescriptor_blockchain_be88d8ca0fef0a7b, []int{1}
 }
 func (m *BlockHeader) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockHeader.Unmarshal(m, b)
@@ -247,7 +247,7 @@ func (m *BlockBody) Reset()         { *m = BlockBody{} }
 func (m *BlockBody) String() string { return proto.CompactTextString(m) }
 func (*BlockBody) ProtoMessage()    {}
 func (*BlockBody) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_bcfdea0869ea68f3, []int{2}
+	return fileDescriptor_blockchain_be88d8ca0fef0a7b, []int{2}
 }
 func (m *BlockBody) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockBody.Unmarshal(m, b)
@@ -285,7 +285,7 @@ func (m *TxList) Reset()         { *m = TxList{} }
 func (m *TxList) String() string { return proto.CompactTextString(m) }
 func (*TxList) ProtoMessage()    {}
 func (*TxList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_bcfdea0869ea68f3, []int{3}
+	return fileDescriptor_blockchain_be88d8ca0fef0a7b, []int{3}
 }
 func (m *TxList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TxList.Unmarshal(m, b)
@@ -324,7 +324,7 @@ func (m *Tx) Reset()         { *m = Tx{} }
 func (m *Tx) String() string { return proto.CompactTextString(m) }
 func (*Tx) ProtoMessage()    {}
 func (*Tx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_bcfdea0869ea68f3, []int{4}
+	return fileDescriptor_blockchain_be88d8ca0fef0a7b, []int{4}
 }
 func (m *Tx) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Tx.Unmarshal(m, b)
@@ -378,7 +378,7 @@ func (m *TxBody) Reset()         { *m = TxBody{} }
 func (m *TxBody) String() string { return proto.CompactTextString(m) }
 func (*TxBody) ProtoMessage()    {}
 func (*TxBody) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_bcfdea0869ea68f3, []int{5}
+	return fileDescriptor_blockchain_be88d8ca0fef0a7b, []int{5}
 }
 func (m *TxBody) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TxBody.Unmarshal(m, b)
@@ -481,7 +481,7 @@ func (m *TxIdx) Reset()         { *m = TxIdx{} }
 func (m *TxIdx) String() string { return proto.CompactTextString(m) }
 func (*TxIdx) ProtoMessage()    {}
 func (*TxIdx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_bcfdea0869ea68f3, []int{6}
+	return fileDescriptor_blockchain_be88d8ca0fef0a7b, []int{6}
 }
 func (m *TxIdx) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TxIdx.Unmarshal(m, b)
@@ -527,7 +527,7 @@ func (m *TxInBlock) Reset()         { *m = TxInBlock{} }
 func (m *TxInBlock) String() string { return proto.CompactTextString(m) }
 func (*TxInBlock) ProtoMessage()    {}
 func (*TxInBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_bcfdea0869ea68f3, []int{7}
+	return fileDescriptor_blockchain_be88d8ca0fef0a7b, []int{7}
 }
 func (m *TxInBlock) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TxInBlock.Unmarshal(m, b)
@@ -576,7 +576,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_bcfdea0869ea68f3, []int{8}
+	return fileDescriptor_blockchain_be88d8ca0fef0a7b, []int{8}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_State.Unmarshal(m, b)
@@ -649,7 +649,7 @@ func (m *AccountProof) Reset()         { *m = AccountProof{} }
 func (m *AccountProof) String() string { return proto.CompactTextString(m) }
 func (*AccountProof) ProtoMessage()    {}
 func (*AccountProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_bcfdea0869ea68f3, []int{9}
+	return fileDescriptor_blockchain_be88d8ca0fef0a7b, []int{9}
 }
 func (m *AccountProof) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountProof.Unmarshal(m, b)
@@ -743,7 +743,7 @@ func (m *ContractVarProof) Reset()         { *m = ContractVarProof{} }
 func (m *ContractVarProof) String() string { return proto.CompactTextString(m) }
 func (*ContractVarProof) ProtoMessage()    {}
 func (*ContractVarProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_bcfdea0869ea68f3, []int{10}
+	return fileDescriptor_blockchain_be88d8ca0fef0a7b, []int{10}
 }
 func (m *ContractVarProof) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ContractVarProof.Unmarshal(m, b)
@@ -831,7 +831,7 @@ func (m *StateQueryProof) Reset()         { *m = StateQueryProof{} }
 func (m *StateQueryProof) String() string { return proto.CompactTextString(m) }
 func (*StateQueryProof) ProtoMessage()    {}
 func (*StateQueryProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_bcfdea0869ea68f3, []int{11}
+	return fileDescriptor_blockchain_be88d8ca0fef0a7b, []int{11}
 }
 func (m *StateQueryProof) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StateQueryProof.Unmarshal(m, b)
@@ -881,6 +881,9 @@ type Receipt struct {
 	To                   []byte   `protobuf:"bytes,13,opt,name=to,proto3" json:"to,omitempty"`
 	FeeDelegation        bool     `protobuf:"varint,14,opt,name=feeDelegation" json:"feeDelegation,omitempty"`
 	GasUsed              uint64   `protobuf:"varint,15,opt,name=gasUsed" json:"gasUsed,omitempty"`
+	RevertCode           string   `protobuf:"bytes,16,opt,name=revertCode" json:"revertCode,omitempty"`
+	RevertMessage        string   `protobuf:"bytes,17,opt,name=revertMessage" json:"revertMessage,omitempty"`
+	RevertData           string   `protobuf:"bytes,18,opt,name=revertData" json:"revertData,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -890,7 +893,7 @@ func (m *Receipt) Reset()         { *m = Receipt{} }
 func (m *Receipt) String() string { return proto.CompactTextString(m) }
 func (*Receipt) ProtoMessage()    {}
 func (*Receipt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_bcfdea0869ea68f3, []int{12}
+	return fileDescriptor_blockchain_be88d8ca0fef0a7b, []int{12}
 }
 func (m *Receipt) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Receipt.Unmarshal(m, b)
@@ -1015,6 +1018,27 @@ func (m *Receipt) GetGasUsed() uint64 {
 	return 0
 }
 
+func (m *Receipt) GetRevertCode() string {
+	if m != nil {
+		return m.RevertCode
+	}
+	return ""
+}
+
+func (m *Receipt) GetRevertMessage() string {
+	if m != nil {
+		return m.RevertMessage
+	}
+	return ""
+}
+
+func (m *Receipt) GetRevertData() string {
+	if m != nil {
+		return m.RevertData
+	}
+	return ""
+}
+
 type Event struct {
 	ContractAddress      []byte   `protobuf:"bytes,1,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
 	EventName            string   `protobuf:"bytes,2,opt,name=eventName" json:"eventName,omitempty"`
@@ -1024,6 +1048,7 @@ type Event struct {
 	BlockHash            []byte   `protobuf:"bytes,6,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
 	BlockNo              uint64   `protobuf:"varint,7,opt,name=blockNo" json:"blockNo,omitempty"`
 	TxIndex              int32    `protobuf:"varint,8,opt,name=txIndex" json:"txIndex,omitempty"`
+	Indexed              [][]byte `protobuf:"bytes,9,rep,name=indexed,proto3" json:"indexed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1033,7 +1058,7 @@ func (m *Event) Reset()         { *m = Event{} }
 func (m *Event) String() string { return proto.CompactTextString(m) }
 func (*Event) ProtoMessage()    {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_bcfdea0869ea68f3, []int{13}
+	return fileDescriptor_blockchain_be88d8ca0fef0a7b, []int{13}
 }
 func (m *Event) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Event.Unmarshal(m, b)
@@ -1109,8 +1134,16 @@ func (m *Event) GetTxIndex() int32 {
 	return 0
 }
 
+func (m *Event) GetIndexed() [][]byte {
+	if m != nil {
+		return m.Indexed
+	}
+	return nil
+}
+
 type FnArgument struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Type                 string   `protobuf:"bytes,2,opt,name=type" json:"type,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1120,7 +1153,7 @@ func (m *FnArgument) Reset()         { *m = FnArgument{} }
 func (m *FnArgument) String() string { return proto.CompactTextString(m) }
 func (*FnArgument) ProtoMessage()    {}
 func (*FnArgument) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_bcfdea0869ea68f3, []int{14}
+	return fileDescriptor_blockchain_be88d8ca0fef0a7b, []int{14}
 }
 func (m *FnArgument) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FnArgument.Unmarshal(m, b)
@@ -1147,12 +1180,20 @@ func (m *FnArgument) GetName() string {
 	return ""
 }
 
+func (m *FnArgument) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
 type Function struct {
 	Name                 string        `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
 	Arguments            []*FnArgument `protobuf:"bytes,2,rep,name=arguments" json:"arguments,omitempty"`
 	Payable              bool          `protobuf:"varint,3,opt,name=payable" json:"payable,omitempty"`
 	View                 bool          `protobuf:"varint,4,opt,name=view" json:"view,omitempty"`
 	FeeDelegation        bool          `protobuf:"varint,5,opt,name=fee_delegation,json=feeDelegation" json:"fee_delegation,omitempty"`
+	Returns              []*FnArgument `protobuf:"bytes,6,rep,name=returns" json:"returns,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
@@ -1162,7 +1203,7 @@ func (m *Function) Reset()         { *m = Function{} }
 func (m *Function) String() string { return proto.CompactTextString(m) }
 func (*Function) ProtoMessage()    {}
 func (*Function) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_bcfdea0869ea68f3, []int{15}
+	return fileDescriptor_blockchain_be88d8ca0fef0a7b, []int{15}
 }
 func (m *Function) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Function.Unmarshal(m, b)
@@ -1217,6 +1258,13 @@ func (m *Function) GetFeeDelegation() bool {
 	return false
 }
 
+func (m *Function) GetReturns() []*FnArgument {
+	if m != nil {
+		return m.Returns
+	}
+	return nil
+}
+
 type StateVar struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
 	Type                 string   `protobuf:"bytes,2,opt,name=type" json:"type,omitempty"`
@@ -1230,7 +1278,7 @@ func (m *StateVar) Reset()         { *m = StateVar{} }
 func (m *StateVar) String() string { return proto.CompactTextString(m) }
 func (*StateVar) ProtoMessage()    {}
 func (*StateVar) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_bcfdea0869ea68f3, []int{16}
+	return fileDescriptor_blockchain_be88d8ca0fef0a7b, []int{16}
 }
 func (m *StateVar) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StateVar.Unmarshal(m, b)
@@ -1272,20 +1320,21 @@ func (m *StateVar) GetLen() int32 {
 }
 
 type ABI struct {
-	Version              string      `protobuf:"bytes,1,opt,name=version" json:"version,omitempty"`
-	Language             string      `protobuf:"bytes,2,opt,name=language" json:"language,omitempty"`
-	Functions            []*Function `protobuf:"bytes,3,rep,name=functions" json:"functions,omitempty"`
-	StateVariables       []*StateVar `protobuf:"bytes,4,rep,name=state_variables,json=stateVariables" json:"state_variables,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Version              string         `protobuf:"bytes,1,opt,name=version" json:"version,omitempty"`
+	Language             string         `protobuf:"bytes,2,opt,name=language" json:"language,omitempty"`
+	Functions            []*Function    `protobuf:"bytes,3,rep,name=functions" json:"functions,omitempty"`
+	StateVariables       []*StateVar    `protobuf:"bytes,4,rep,name=state_variables,json=stateVariables" json:"state_variables,omitempty"`
+	Events               []*EventSchema `protobuf:"bytes,5,rep,name=events" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *ABI) Reset()         { *m = ABI{} }
 func (m *ABI) String() string { return proto.CompactTextString(m) }
 func (*ABI) ProtoMessage()    {}
 func (*ABI) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_bcfdea0869ea68f3, []int{17}
+	return fileDescriptor_blockchain_be88d8ca0fef0a7b, []int{17}
 }
 func (m *ABI) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ABI.Unmarshal(m, b)
@@ -1333,6 +1382,13 @@ func (m *ABI) GetStateVariables() []*StateVar {
 	return nil
 }
 
+func (m *ABI) GetEvents() []*EventSchema {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
 type Query struct {
 	ContractAddress      []byte   `protobuf:"bytes,1,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
 	Queryinfo            []byte   `protobuf:"bytes,2,opt,name=queryinfo,proto3" json:"queryinfo,omitempty"`
@@ -1345,7 +1401,7 @@ func (m *Query) Reset()         { *m = Query{} }
 func (m *Query) String() string { return proto.CompactTextString(m) }
 func (*Query) ProtoMessage()    {}
 func (*Query) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_bcfdea0869ea68f3, []int{18}
+	return fileDescriptor_blockchain_be88d8ca0fef0a7b, []int{18}
 }
 func (m *Query) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Query.Unmarshal(m, b)
@@ -1393,7 +1449,7 @@ func (m *StateQuery) Reset()         { *m = StateQuery{} }
 func (m *StateQuery) String() string { return proto.CompactTextString(m) }
 func (*StateQuery) ProtoMessage()    {}
 func (*StateQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_bcfdea0869ea68f3, []int{19}
+	return fileDescriptor_blockchain_be88d8ca0fef0a7b, []int{19}
 }
 func (m *StateQuery) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StateQuery.Unmarshal(m, b)
@@ -1449,6 +1505,7 @@ type FilterInfo struct {
 	Desc                 bool     `protobuf:"varint,5,opt,name=desc" json:"desc,omitempty"`
 	ArgFilter            []byte   `protobuf:"bytes,6,opt,name=argFilter,proto3" json:"argFilter,omitempty"`
 	RecentBlockCnt       int32    `protobuf:"varint,7,opt,name=recentBlockCnt" json:"recentBlockCnt,omitempty"`
+	IndexedFilter        []byte   `protobuf:"bytes,8,opt,name=indexedFilter,proto3" json:"indexedFilter,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1458,7 +1515,7 @@ func (m *FilterInfo) Reset()         { *m = FilterInfo{} }
 func (m *FilterInfo) String() string { return proto.CompactTextString(m) }
 func (*FilterInfo) ProtoMessage()    {}
 func (*FilterInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_bcfdea0869ea68f3, []int{20}
+	return fileDescriptor_blockchain_be88d8ca0fef0a7b, []int{20}
 }
 func (m *FilterInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FilterInfo.Unmarshal(m, b)
@@ -1527,6 +1584,13 @@ func (m *FilterInfo) GetRecentBlockCnt() int32 {
 	return 0
 }
 
+func (m *FilterInfo) GetIndexedFilter() []byte {
+	if m != nil {
+		return m.IndexedFilter
+	}
+	return nil
+}
+
 type Proposal struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	Description          string   `protobuf:"bytes,3,opt,name=description" json:"description,omitempty"`
@@ -1540,7 +1604,7 @@ func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_bcfdea0869ea68f3, []int{21}
+	return fileDescriptor_blockchain_be88d8ca0fef0a7b, []int{21}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Proposal.Unmarshal(m, b)
@@ -1581,6 +1645,160 @@ func (m *Proposal) GetMultipleChoice() uint32 {
 	return 0
 }
 
+type EventSchema struct {
+	Name                 string        `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Arguments            []*FnArgument `protobuf:"bytes,2,rep,name=arguments" json:"arguments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *EventSchema) Reset()         { *m = EventSchema{} }
+func (m *EventSchema) String() string { return proto.CompactTextString(m) }
+func (*EventSchema) ProtoMessage()    {}
+func (*EventSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_be88d8ca0fef0a7b, []int{22}
+}
+func (m *EventSchema) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventSchema.Unmarshal(m, b)
+}
+func (m *EventSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventSchema.Marshal(b, m, deterministic)
+}
+func (dst *EventSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSchema.Merge(dst, src)
+}
+func (m *EventSchema) XXX_Size() int {
+	return xxx_messageInfo_EventSchema.Size(m)
+}
+func (m *EventSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSchema proto.InternalMessageInfo
+
+func (m *EventSchema) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventSchema) GetArguments() []*FnArgument {
+	if m != nil {
+		return m.Arguments
+	}
+	return nil
+}
+
+type ContractCodeVersion struct {
+	Version              uint64   `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
+	CodeHash             []byte   `protobuf:"bytes,2,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	BlockNo              uint64   `protobuf:"varint,3,opt,name=block_no,json=blockNo" json:"block_no,omitempty"`
+	TxHash               []byte   `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Deployer             []byte   `protobuf:"bytes,5,opt,name=deployer,proto3" json:"deployer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContractCodeVersion) Reset()         { *m = ContractCodeVersion{} }
+func (m *ContractCodeVersion) String() string { return proto.CompactTextString(m) }
+func (*ContractCodeVersion) ProtoMessage()    {}
+func (*ContractCodeVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_be88d8ca0fef0a7b, []int{23}
+}
+func (m *ContractCodeVersion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractCodeVersion.Unmarshal(m, b)
+}
+func (m *ContractCodeVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractCodeVersion.Marshal(b, m, deterministic)
+}
+func (dst *ContractCodeVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractCodeVersion.Merge(dst, src)
+}
+func (m *ContractCodeVersion) XXX_Size() int {
+	return xxx_messageInfo_ContractCodeVersion.Size(m)
+}
+func (m *ContractCodeVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractCodeVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractCodeVersion proto.InternalMessageInfo
+
+func (m *ContractCodeVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ContractCodeVersion) GetCodeHash() []byte {
+	if m != nil {
+		return m.CodeHash
+	}
+	return nil
+}
+
+func (m *ContractCodeVersion) GetBlockNo() uint64 {
+	if m != nil {
+		return m.BlockNo
+	}
+	return 0
+}
+
+func (m *ContractCodeVersion) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *ContractCodeVersion) GetDeployer() []byte {
+	if m != nil {
+		return m.Deployer
+	}
+	return nil
+}
+
+type CodeHistory struct {
+	Versions             []*ContractCodeVersion `protobuf:"bytes,1,rep,name=versions" json:"versions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *CodeHistory) Reset()         { *m = CodeHistory{} }
+func (m *CodeHistory) String() string { return proto.CompactTextString(m) }
+func (*CodeHistory) ProtoMessage()    {}
+func (*CodeHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_be88d8ca0fef0a7b, []int{24}
+}
+func (m *CodeHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CodeHistory.Unmarshal(m, b)
+}
+func (m *CodeHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CodeHistory.Marshal(b, m, deterministic)
+}
+func (dst *CodeHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeHistory.Merge(dst, src)
+}
+func (m *CodeHistory) XXX_Size() int {
+	return xxx_messageInfo_CodeHistory.Size(m)
+}
+func (m *CodeHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodeHistory proto.InternalMessageInfo
+
+func (m *CodeHistory) GetVersions() []*ContractCodeVersion {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Block)(nil), "types.Block")
 	proto.RegisterType((*BlockHeader)(nil), "types.BlockHeader")
@@ -1604,104 +1822,119 @@ func init() {
 	proto.RegisterType((*StateQuery)(nil), "types.StateQuery")
 	proto.RegisterType((*FilterInfo)(nil), "types.FilterInfo")
 	proto.RegisterType((*Proposal)(nil), "types.Proposal")
+	proto.RegisterType((*EventSchema)(nil), "types.EventSchema")
+	proto.RegisterType((*ContractCodeVersion)(nil), "types.ContractCodeVersion")
+	proto.RegisterType((*CodeHistory)(nil), "types.CodeHistory")
 	proto.RegisterEnum("types.TxType", TxType_name, TxType_value)
 }
 
-func init() { proto.RegisterFile("blockchain.proto", fileDescriptor_blockchain_bcfdea0869ea68f3) }
-
-var fileDescriptor_blockchain_bcfdea0869ea68f3 = []byte{
-	// 1483 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4b, 0x8f, 0x1b, 0xc7,
-	0x11, 0x0e, 0xc9, 0x19, 0x2e, 0x59, 0xfb, 0xa2, 0x3a, 0x42, 0x32, 0x49, 0x84, 0x60, 0x33, 0x90,
-	0x82, 0x85, 0x90, 0x28, 0x80, 0x82, 0x20, 0x09, 0x72, 0xa2, 0x76, 0xb9, 0x0a, 0xa5, 0xcd, 0xee,
-	0xa6, 0xc5, 0x2c, 0x90, 0x93, 0xd0, 0x9c, 0x69, 0x92, 0x13, 0x0d, 0xa7, 0xa9, 0xe9, 0x26, 0x43,
-	0x9e, 0x7d, 0xf4, 0xcd, 0x37, 0x1f, 0x0d, 0xf8, 0xee, 0x3f, 0x65, 0x18, 0xbe, 0xfa, 0x1f, 0x18,
-	0x55, 0xdd, 0xf3, 0x20, 0x77, 0x6d, 0x43, 0x80, 0x0f, 0xbe, 0x75, 0x7d, 0x5d, 0xdd, 0x53, 0x55,
-	0x5f, 0x3d, 0x7a, 0xa0, 0x37, 0x4e, 0x55, 0xf4, 0x2e, 0x9a, 0x89, 0x24, 0x7b, 0xb6, 0xc8, 0x95,
-	0x51, 0xcc, 0x37, 0x9b, 0x85, 0xd4, 0xe1, 0x1c, 0xfc, 0x17, 0xb8, 0xc5, 0x18, 0x78, 0x33, 0xa1,
-	0x67, 0x41, 0xe3, 0xa4, 0x71, 0x7a, 0xc0, 0x69, 0xcd, 0x9e, 0x42, 0x7b, 0x26, 0x45, 0x2c, 0xf3,
-	0xa0, 0x79, 0xd2, 0x38, 0xdd, 0x7f, 0xce, 0x9e, 0xd1, 0xa1, 0x67, 0x74, 0xe2, 0x9f, 0xb4, 0xc3,
-	0x9d, 0x06, 0x7b, 0x0c, 0xde, 0x58, 0xc5, 0x9b, 0xa0, 0x45, 0x9a, 0xbd, 0xba, 0xe6, 0x0b, 0x15,
-	0x6f, 0x38, 0xed, 0x86, 0x1f, 0xb7, 0x60, 0xbf, 0x76, 0x9a, 0x05, 0xb0, 0x47, 0x46, 0x0d, 0xcf,
-	0xdd, 0x87, 0x0b, 0x91, 0x3d, 0x86, 0xc3, 0x45, 0x2e, 0x57, 0x56, 0x19, 0x0d, 0x6b, 0xd2, 0xfe,
-	0x36, 0x88, 0xe7, 0xc9, 0xb3, 0x2b, 0x45, 0x1f, 0xf6, 0x78, 0x21, 0xb2, 0x47, 0xd0, 0x35, 0xc9,
-	0x5c, 0x6a, 0x23, 0xe6, 0x8b, 0xc0, 0x3b, 0x69, 0x9c, 0xb6, 0x78, 0x05, 0xb0, 0xdf, 0xc3, 0x11,
-	0x29, 0x6a, 0xae, 0x94, 0xa1, 0xeb, 0x7d, 0xba, 0x7e, 0x07, 0x65, 0x27, 0xb0, 0x6f, 0xd6, 0x95,
-	0x52, 0x9b, 0x94, 0xea, 0x10, 0x7b, 0x0a, 0xbd, 0x5c, 0x46, 0x32, 0x59, 0x98, 0x4a, 0x6d, 0x8f,
-	0xd4, 0xee, 0xe0, 0xec, 0xd7, 0xd0, 0x89, 0x54, 0x36, 0x49, 0xf2, 0xb9, 0x0e, 0x3a, 0x64, 0x6e,
-	0x29, 0xb3, 0x5f, 0x40, 0x7b, 0xb1, 0x1c, 0xbf, 0x96, 0x9b, 0xa0, 0x4b, 0xa7, 0x9d, 0xc4, 0x4e,
-	0xe1, 0x38, 0x52, 0x49, 0x36, 0x16, 0x5a, 0xf6, 0xa3, 0x48, 0x2d, 0x33, 0x13, 0x00, 0x29, 0xec,
-	0xc2, 0xc8, 0xa0, 0x4e, 0xa6, 0x59, 0xb0, 0x6f, 0x19, 0xc4, 0x35, 0x46, 0x21, 0x52, 0x99, 0x96,
-	0x99, 0x5e, 0xea, 0xe0, 0x80, 0x36, 0x2a, 0x20, 0x3c, 0x85, 0x6e, 0x49, 0x10, 0xfb, 0x0d, 0xb4,
-	0xcc, 0x5a, 0x07, 0x8d, 0x93, 0xd6, 0xe9, 0xfe, 0xf3, 0xae, 0xe3, 0x6f, 0xb4, 0xe6, 0x88, 0x86,
-	0x4f, 0xa0, 0x3d, 0x5a, 0x5f, 0x26, 0xda, 0x7c, 0xbf, 0xda, 0x3f, 0xa0, 0x39, 0x5a, 0xdf, 0x9b,
-	0x4a, 0xbf, 0x73, 0xe9, 0x61, 0x13, 0xe9, 0xb0, 0x3c, 0x57, 0xcb, 0x8d, 0x4f, 0x9b, 0xf8, 0x11,
-	0xb2, 0xe5, 0x21, 0xf8, 0x99, 0xca, 0x22, 0x49, 0x57, 0x78, 0xdc, 0x0a, 0x48, 0xb6, 0x70, 0x21,
-	0xb0, 0xc9, 0x50, 0x88, 0xe8, 0x66, 0x2e, 0xa3, 0x64, 0x91, 0xc8, 0xcc, 0x50, 0x22, 0x1c, 0xf0,
-	0x0a, 0xc0, 0xd0, 0x8a, 0x39, 0x1d, 0xf3, 0x6c, 0x68, 0xad, 0x84, 0xf7, 0x2d, 0xc4, 0x26, 0x55,
-	0x22, 0x76, 0xec, 0x17, 0x22, 0x12, 0x35, 0x15, 0xfa, 0x32, 0x99, 0x27, 0x86, 0x38, 0xf7, 0x78,
-	0x29, 0xbb, 0xbd, 0x9b, 0x3c, 0x89, 0xa4, 0x23, 0xba, 0x94, 0xd1, 0x4b, 0x74, 0x8c, 0xc8, 0x3d,
-	0xaa, 0x79, 0x39, 0xda, 0x2c, 0x24, 0xa7, 0x2d, 0xcc, 0x28, 0x9b, 0xe2, 0x31, 0xa5, 0x8a, 0x25,
-	0xbb, 0x0e, 0x95, 0x3c, 0x42, 0xc5, 0x63, 0xf8, 0x57, 0xf0, 0x47, 0xeb, 0x61, 0xbc, 0x46, 0x4f,
-	0xc7, 0x65, 0x49, 0xd8, 0x00, 0x57, 0x00, 0xeb, 0x41, 0x2b, 0x89, 0xd7, 0x14, 0x1d, 0x9f, 0xe3,
-	0x32, 0x7c, 0x05, 0xdd, 0xd1, 0x7a, 0x98, 0xd9, 0x1a, 0x0f, 0xc1, 0x37, 0x78, 0x0b, 0x1d, 0xdc,
-	0x7f, 0x7e, 0x50, 0xda, 0x37, 0x8c, 0xd7, 0xdc, 0x6e, 0xb1, 0x5f, 0x41, 0xd3, 0xac, 0x1d, 0x4d,
-	0x35, 0x7a, 0x9b, 0x66, 0x1d, 0x7e, 0xd6, 0x00, 0xff, 0x8d, 0x11, 0x46, 0x7e, 0x37, 0x3f, 0x63,
-	0x91, 0x0a, 0xc4, 0x1d, 0x3f, 0x4e, 0xb4, 0x89, 0x1f, 0x4b, 0x32, 0xda, 0xd2, 0x53, 0xca, 0x18,
-	0x10, 0x6d, 0x54, 0x2e, 0xa6, 0x12, 0xeb, 0xc4, 0x51, 0x54, 0x87, 0xb0, 0xc4, 0xf4, 0xfb, 0x94,
-	0xcb, 0x48, 0xad, 0x64, 0xbe, 0xb9, 0x51, 0x49, 0x66, 0x88, 0x30, 0x8f, 0xdf, 0xc1, 0xc3, 0xaf,
-	0x1b, 0x70, 0xe0, 0x0a, 0xe2, 0x26, 0x57, 0x6a, 0x82, 0x3e, 0x6b, 0xb4, 0x79, 0xc7, 0x67, 0xf2,
-	0x83, 0xdb, 0x2d, 0x0c, 0x6a, 0x92, 0x45, 0xe9, 0x52, 0x27, 0x2a, 0x23, 0xd3, 0x3b, 0xbc, 0x02,
-	0x30, 0xa8, 0xef, 0xe4, 0xc6, 0xd9, 0x8d, 0x4b, 0x74, 0x67, 0x81, 0x97, 0x63, 0xb5, 0x5a, 0x7b,
-	0x4b, 0xb9, 0xdc, 0xbb, 0x15, 0xa9, 0xcb, 0xaa, 0x52, 0xc6, 0x44, 0x1c, 0x27, 0x66, 0x2e, 0x16,
-	0xae, 0x91, 0x38, 0x09, 0xf1, 0x99, 0x4c, 0xa6, 0x33, 0x43, 0x09, 0x75, 0xc8, 0x9d, 0x84, 0x76,
-	0x89, 0x65, 0x9c, 0x98, 0x1b, 0x61, 0x66, 0x41, 0xe7, 0xa4, 0x85, 0x64, 0x97, 0x40, 0xf8, 0x65,
-	0x03, 0x7a, 0x67, 0x2a, 0x33, 0xb9, 0x88, 0xcc, 0xad, 0xc8, 0xad, 0xbb, 0x0f, 0xc1, 0x5f, 0x89,
-	0x74, 0x29, 0x5d, 0x6e, 0x58, 0xe1, 0x07, 0x1c, 0xfc, 0x49, 0xb8, 0x53, 0x84, 0xb9, 0x5b, 0x86,
-	0xf9, 0x95, 0xd7, 0x69, 0xf5, 0xbc, 0xf0, 0xa3, 0x06, 0x1c, 0x13, 0x5b, 0xff, 0x5e, 0x22, 0xcb,
-	0xe4, 0xe5, 0xdf, 0xe1, 0x30, 0x72, 0x9e, 0x13, 0xe0, 0xc8, 0xfd, 0xb9, 0x23, 0xb7, 0x9e, 0x00,
-	0x7c, 0x5b, 0x93, 0xfd, 0x05, 0xba, 0x2b, 0x17, 0x2c, 0x1d, 0x34, 0xa9, 0x8b, 0xfd, 0xd2, 0x1d,
-	0xdb, 0x0d, 0x26, 0xaf, 0x34, 0xc3, 0x2f, 0x5a, 0xb0, 0xc7, 0x6d, 0x3f, 0xb7, 0x2d, 0xd9, 0xaa,
-	0xf6, 0xe3, 0x38, 0x97, 0x5a, 0xbb, 0x68, 0xef, 0xc2, 0x18, 0x09, 0xcc, 0xb0, 0xa5, 0xa6, 0xa0,
-	0x77, 0xb9, 0x93, 0xd0, 0xd7, 0x5c, 0xda, 0x4e, 0xd5, 0xe5, 0xb8, 0x44, 0x4d, 0xb3, 0xa6, 0xfa,
-	0x70, 0x3d, 0xca, 0x4a, 0x58, 0x53, 0x13, 0x29, 0xff, 0xa3, 0x65, 0xd9, 0xa3, 0x9c, 0xc8, 0xfe,
-	0x00, 0x0f, 0xa2, 0xe5, 0x7c, 0x99, 0x0a, 0x93, 0xac, 0xe4, 0x85, 0xd3, 0xb1, 0x44, 0xdc, 0xdd,
-	0xc0, 0xbc, 0x18, 0xa7, 0x4a, 0xcd, 0x5d, 0xcb, 0xb2, 0x02, 0x7b, 0x0c, 0x6d, 0xb9, 0x92, 0x99,
-	0xd1, 0x44, 0x47, 0x55, 0x1d, 0x03, 0x04, 0xb9, 0xdb, 0xab, 0x0f, 0xd9, 0xee, 0x9d, 0x21, 0x5b,
-	0x75, 0x23, 0xd8, 0xed, 0x46, 0x01, 0xec, 0x99, 0xf5, 0x30, 0x8b, 0xe5, 0x9a, 0x66, 0x92, 0xcf,
-	0x0b, 0x11, 0x5b, 0xdc, 0x24, 0x57, 0x73, 0x37, 0x91, 0x68, 0xcd, 0x8e, 0xa0, 0x69, 0x54, 0x70,
-	0x48, 0x48, 0xd3, 0x28, 0x7c, 0x00, 0x4c, 0xa4, 0x3c, 0x97, 0xa9, 0x9c, 0x0a, 0x83, 0x79, 0x7b,
-	0x44, 0x79, 0xbb, 0x0d, 0xe2, 0x37, 0xa6, 0x42, 0x93, 0xef, 0xc7, 0xd6, 0x36, 0x27, 0x86, 0xdf,
-	0x34, 0xc0, 0x27, 0x3f, 0x3e, 0x80, 0xaf, 0x47, 0xd0, 0x25, 0x9f, 0xaf, 0xc4, 0x5c, 0x3a, 0xca,
-	0x2a, 0x00, 0x6b, 0xe1, 0x7f, 0x5a, 0x65, 0xfd, 0x7c, 0xaa, 0x1d, 0x75, 0xa5, 0x8c, 0x7b, 0xa4,
-	0x88, 0xdd, 0xd5, 0x23, 0x67, 0x4b, 0xb9, 0xc6, 0xad, 0xbf, 0xc5, 0xed, 0x56, 0xf4, 0xda, 0xf7,
-	0x44, 0xaf, 0x88, 0xfa, 0xde, 0x76, 0xd4, 0x6b, 0x71, 0xed, 0x6c, 0xc5, 0x35, 0x3c, 0x01, 0xb8,
-	0x40, 0x7b, 0x96, 0x73, 0x69, 0x1f, 0x04, 0x19, 0x3a, 0xd2, 0x20, 0x5b, 0x69, 0x1d, 0x7e, 0xde,
-	0x80, 0xce, 0xc5, 0x32, 0x8b, 0x28, 0x78, 0xf7, 0x28, 0xb0, 0x3f, 0x41, 0x57, 0xb8, 0x0b, 0x8a,
-	0xfa, 0x78, 0xe0, 0xb2, 0xa2, 0xba, 0x9a, 0x57, 0x3a, 0x6e, 0x8a, 0x8a, 0x71, 0x2a, 0x29, 0x28,
-	0x1d, 0x5e, 0x88, 0x78, 0xfd, 0x2a, 0x91, 0xff, 0xa7, 0x78, 0x74, 0x38, 0xad, 0xd9, 0x13, 0x38,
-	0x9a, 0x48, 0xf9, 0x36, 0xae, 0x68, 0xf5, 0xef, 0xa1, 0x35, 0x3c, 0x87, 0x0e, 0xd5, 0xfc, 0xad,
-	0xc8, 0xef, 0xb5, 0x92, 0xb9, 0x41, 0x6b, 0x39, 0xb2, 0x93, 0xb5, 0x07, 0xad, 0x54, 0x66, 0x64,
-	0x84, 0xcf, 0x71, 0x89, 0xce, 0xb6, 0xfa, 0x2f, 0x86, 0x68, 0xe2, 0x4a, 0xe6, 0xd4, 0xfc, 0xec,
-	0x25, 0x85, 0x88, 0xb4, 0xa5, 0x22, 0x9b, 0x2e, 0xc5, 0xb4, 0xb8, 0xab, 0x94, 0xd9, 0x1f, 0xa1,
-	0x3b, 0x71, 0x91, 0x42, 0xbe, 0x31, 0x12, 0xc7, 0x45, 0x24, 0x1c, 0xce, 0x2b, 0x0d, 0xf6, 0x37,
-	0x38, 0xa6, 0x69, 0xf2, 0x76, 0x25, 0xf2, 0x04, 0xfd, 0xd7, 0x81, 0xb7, 0x75, 0xa8, 0x70, 0x88,
-	0x1f, 0x69, 0xb7, 0xb2, 0x6a, 0xe1, 0x35, 0xf8, 0xd4, 0xdb, 0x3e, 0x2c, 0x51, 0xdf, 0xe3, 0x91,
-	0x24, 0x9b, 0x28, 0x37, 0x6c, 0x2b, 0x20, 0xfc, 0xa4, 0x01, 0x50, 0xb5, 0xcc, 0x0f, 0xb8, 0x96,
-	0x81, 0x97, 0xe3, 0x10, 0xb6, 0xb3, 0x8e, 0xd6, 0xec, 0xb7, 0x00, 0x91, 0x9a, 0x2f, 0x70, 0x5f,
-	0xc6, 0x8e, 0xcb, 0x1a, 0x52, 0x9b, 0xdf, 0xaf, 0xe5, 0x46, 0x07, 0x3e, 0xf5, 0xf5, 0x3a, 0xf4,
-	0xca, 0xeb, 0x34, 0x7b, 0xad, 0xf0, 0xab, 0x06, 0xc0, 0x45, 0x92, 0x1a, 0x99, 0x0f, 0xb3, 0x89,
-	0xfa, 0xd1, 0x8a, 0xb2, 0x28, 0x22, 0xea, 0x27, 0xf6, 0x1f, 0xa0, 0x02, 0xca, 0x22, 0x32, 0x8a,
-	0x2c, 0x2f, 0x8a, 0xc8, 0x28, 0x74, 0x35, 0x96, 0x3a, 0x72, 0xe9, 0x47, 0x6b, 0x1a, 0x50, 0xf9,
-	0xd4, 0x1a, 0x59, 0x14, 0x64, 0x09, 0xe0, 0x3f, 0x03, 0xbe, 0xe8, 0x33, 0x43, 0x8f, 0xa9, 0xb3,
-	0xcc, 0x8e, 0x37, 0x9f, 0xef, 0xa0, 0x61, 0x0c, 0x9d, 0x9b, 0x5c, 0x2d, 0x94, 0x16, 0x29, 0x36,
-	0xb5, 0x24, 0x76, 0x49, 0xd7, 0x4c, 0x28, 0x58, 0xf8, 0xa5, 0x3c, 0x59, 0x50, 0xee, 0xdb, 0x2e,
-	0x52, 0x87, 0xf0, 0x2b, 0xf3, 0x65, 0x6a, 0x92, 0x45, 0x2a, 0xcf, 0x66, 0x0a, 0x1f, 0x99, 0x6d,
-	0x1a, 0xa2, 0x3b, 0xe8, 0xd3, 0x04, 0x1f, 0xcb, 0xf8, 0xae, 0x64, 0x00, 0xed, 0xab, 0x6b, 0xfe,
-	0xaf, 0xfe, 0x65, 0xef, 0x67, 0xec, 0x08, 0xe0, 0xe5, 0xf5, 0xed, 0x80, 0x5f, 0xf5, 0xaf, 0xce,
-	0x06, 0xbd, 0x06, 0x3b, 0x80, 0x0e, 0x1f, 0x9c, 0x0f, 0x6e, 0x2e, 0xaf, 0xff, 0xdb, 0x6b, 0xb2,
-	0x07, 0x70, 0x78, 0x31, 0x18, 0x9c, 0x0f, 0x2e, 0x07, 0x2f, 0xfb, 0xa3, 0xe1, 0xf5, 0x55, 0xaf,
-	0x85, 0x0a, 0x23, 0xde, 0xbf, 0x7a, 0x73, 0x31, 0xe0, 0x3d, 0x8f, 0x75, 0xc0, 0x3b, 0xeb, 0x5f,
-	0x5e, 0xf6, 0x7c, 0xbc, 0xd4, 0x1d, 0x6b, 0x8f, 0xdb, 0xf4, 0xc7, 0xf8, 0xe7, 0x6f, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0x25, 0xc1, 0x82, 0x2d, 0x45, 0x0e, 0x00, 0x00,
+func init() { proto.RegisterFile("blockchain.proto", fileDescriptor_blockchain_be88d8ca0fef0a7b) }
+
+var fileDescriptor_blockchain_be88d8ca0fef0a7b = []byte{
+	// 1672 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x8e, 0x23, 0x49,
+	0x11, 0xc6, 0x76, 0x95, 0xbb, 0x1c, 0xfd, 0xe7, 0xc9, 0x5d, 0xb1, 0xb5, 0xb3, 0x2b, 0xd4, 0x94,
+	0x66, 0x51, 0x6b, 0x80, 0x41, 0x1a, 0xfe, 0xc5, 0xc9, 0xd3, 0xed, 0xde, 0xed, 0xd9, 0xde, 0xee,
+	0x26, 0xa7, 0x69, 0x89, 0xd3, 0x28, 0x5d, 0x95, 0xb6, 0x8b, 0x2d, 0x57, 0xd6, 0x56, 0xa6, 0x8d,
+	0x7d, 0xe6, 0xb8, 0x9c, 0x90, 0x38, 0x20, 0x71, 0xe1, 0x8d, 0xe0, 0x1d, 0x38, 0xf0, 0x18, 0x28,
+	0x22, 0xb3, 0x7e, 0xec, 0x9e, 0x05, 0x46, 0x9a, 0x03, 0xb7, 0x8c, 0x2f, 0x23, 0xc3, 0x11, 0xf1,
+	0x45, 0x44, 0x65, 0x1a, 0x86, 0x93, 0x4c, 0xc5, 0x5f, 0xc6, 0x73, 0x91, 0xe6, 0xcf, 0x8a, 0x52,
+	0x19, 0xc5, 0x7c, 0xb3, 0x29, 0xa4, 0x8e, 0x16, 0xe0, 0xbf, 0xc0, 0x2d, 0xc6, 0xc0, 0x9b, 0x0b,
+	0x3d, 0x0f, 0x3b, 0x27, 0x9d, 0xd3, 0x03, 0x4e, 0x6b, 0xf6, 0x14, 0xfa, 0x73, 0x29, 0x12, 0x59,
+	0x86, 0xdd, 0x93, 0xce, 0xe9, 0xfe, 0x73, 0xf6, 0x8c, 0x0e, 0x3d, 0xa3, 0x13, 0x9f, 0xd1, 0x0e,
+	0x77, 0x1a, 0xec, 0x09, 0x78, 0x13, 0x95, 0x6c, 0xc2, 0x1e, 0x69, 0x0e, 0xdb, 0x9a, 0x2f, 0x54,
+	0xb2, 0xe1, 0xb4, 0x1b, 0x7d, 0xdd, 0x83, 0xfd, 0xd6, 0x69, 0x16, 0xc2, 0x1e, 0x39, 0x75, 0x79,
+	0xee, 0x7e, 0xb8, 0x12, 0xd9, 0x13, 0x38, 0x2c, 0x4a, 0xb9, 0xb2, 0xca, 0xe8, 0x58, 0x97, 0xf6,
+	0xb7, 0x41, 0x3c, 0x4f, 0x91, 0x5d, 0x2b, 0xfa, 0x61, 0x8f, 0x57, 0x22, 0xfb, 0x18, 0x06, 0x26,
+	0x5d, 0x48, 0x6d, 0xc4, 0xa2, 0x08, 0xbd, 0x93, 0xce, 0x69, 0x8f, 0x37, 0x00, 0xfb, 0x1e, 0x1c,
+	0x91, 0xa2, 0xe6, 0x4a, 0x19, 0x32, 0xef, 0x93, 0xf9, 0x1d, 0x94, 0x9d, 0xc0, 0xbe, 0x59, 0x37,
+	0x4a, 0x7d, 0x52, 0x6a, 0x43, 0xec, 0x29, 0x0c, 0x4b, 0x19, 0xcb, 0xb4, 0x30, 0x8d, 0xda, 0x1e,
+	0xa9, 0x3d, 0xc0, 0xd9, 0x63, 0x08, 0x62, 0x95, 0x4f, 0xd3, 0x72, 0xa1, 0xc3, 0x80, 0xdc, 0xad,
+	0x65, 0xf6, 0x6d, 0xe8, 0x17, 0xcb, 0xc9, 0xe7, 0x72, 0x13, 0x0e, 0xe8, 0xb4, 0x93, 0xd8, 0x29,
+	0x1c, 0xc7, 0x2a, 0xcd, 0x27, 0x42, 0xcb, 0x51, 0x1c, 0xab, 0x65, 0x6e, 0x42, 0x20, 0x85, 0x5d,
+	0x18, 0x19, 0xd4, 0xe9, 0x2c, 0x0f, 0xf7, 0x2d, 0x83, 0xb8, 0xc6, 0x2c, 0xc4, 0x2a, 0xd7, 0x32,
+	0xd7, 0x4b, 0x1d, 0x1e, 0xd0, 0x46, 0x03, 0x44, 0xa7, 0x30, 0xa8, 0x09, 0x62, 0x1f, 0x41, 0xcf,
+	0xac, 0x75, 0xd8, 0x39, 0xe9, 0x9d, 0xee, 0x3f, 0x1f, 0x38, 0xfe, 0xee, 0xd6, 0x1c, 0xd1, 0xe8,
+	0x13, 0xe8, 0xdf, 0xad, 0xaf, 0x52, 0x6d, 0xfe, 0xb3, 0xda, 0xaf, 0xa0, 0x7b, 0xb7, 0x7e, 0x63,
+	0x29, 0x7d, 0xd7, 0x95, 0x87, 0x2d, 0xa4, 0xc3, 0xfa, 0x5c, 0xab, 0x36, 0xfe, 0xd2, 0x85, 0xbe,
+	0x05, 0xd8, 0xfb, 0xe0, 0xe7, 0x2a, 0x8f, 0x25, 0x99, 0xf0, 0xb8, 0x15, 0x90, 0x6c, 0xe1, 0x52,
+	0x60, 0x8b, 0xa1, 0x12, 0x31, 0xcc, 0x52, 0xc6, 0x69, 0x91, 0xca, 0xdc, 0x50, 0x21, 0x1c, 0xf0,
+	0x06, 0xc0, 0xd4, 0x8a, 0x05, 0x1d, 0xf3, 0x6c, 0x6a, 0xad, 0x84, 0xf6, 0x0a, 0xb1, 0xc9, 0x94,
+	0x48, 0x1c, 0xfb, 0x95, 0x88, 0x44, 0xcd, 0x84, 0xbe, 0x4a, 0x17, 0xa9, 0x21, 0xce, 0x3d, 0x5e,
+	0xcb, 0x6e, 0xef, 0xb6, 0x4c, 0x63, 0xe9, 0x88, 0xae, 0x65, 0x8c, 0x12, 0x03, 0x23, 0x72, 0x8f,
+	0x5a, 0x51, 0xde, 0x6d, 0x0a, 0xc9, 0x69, 0x0b, 0x2b, 0xca, 0x96, 0x78, 0x42, 0xa5, 0x62, 0xc9,
+	0x6e, 0x43, 0x35, 0x8f, 0xd0, 0xf0, 0x18, 0xfd, 0x1c, 0xfc, 0xbb, 0xf5, 0x65, 0xb2, 0xc6, 0x48,
+	0x27, 0x75, 0x4b, 0xd8, 0x04, 0x37, 0x00, 0x1b, 0x42, 0x2f, 0x4d, 0xd6, 0x94, 0x1d, 0x9f, 0xe3,
+	0x32, 0x7a, 0x09, 0x83, 0xbb, 0xf5, 0x65, 0x6e, 0x7b, 0x3c, 0x02, 0xdf, 0xa0, 0x15, 0x3a, 0xb8,
+	0xff, 0xfc, 0xa0, 0xf6, 0xef, 0x32, 0x59, 0x73, 0xbb, 0xc5, 0x3e, 0x84, 0xae, 0x59, 0x3b, 0x9a,
+	0x5a, 0xf4, 0x76, 0xcd, 0x3a, 0xfa, 0x5b, 0x07, 0xfc, 0x57, 0x46, 0x18, 0xf9, 0xcd, 0xfc, 0x4c,
+	0x44, 0x26, 0x10, 0x77, 0xfc, 0x38, 0xd1, 0x16, 0x7e, 0x22, 0xc9, 0x69, 0x4b, 0x4f, 0x2d, 0x63,
+	0x42, 0xb4, 0x51, 0xa5, 0x98, 0x49, 0xec, 0x13, 0x47, 0x51, 0x1b, 0xc2, 0x16, 0xd3, 0x5f, 0x65,
+	0x5c, 0xc6, 0x6a, 0x25, 0xcb, 0xcd, 0xad, 0x4a, 0x73, 0x43, 0x84, 0x79, 0xfc, 0x01, 0x1e, 0xfd,
+	0xab, 0x03, 0x07, 0xae, 0x21, 0x6e, 0x4b, 0xa5, 0xa6, 0x18, 0xb3, 0x46, 0x9f, 0x77, 0x62, 0xa6,
+	0x38, 0xb8, 0xdd, 0xc2, 0xa4, 0xa6, 0x79, 0x9c, 0x2d, 0x75, 0xaa, 0x72, 0x72, 0x3d, 0xe0, 0x0d,
+	0x80, 0x49, 0xfd, 0x52, 0x6e, 0x9c, 0xdf, 0xb8, 0xc4, 0x70, 0x0a, 0x34, 0x8e, 0xdd, 0x6a, 0xfd,
+	0xad, 0xe5, 0x7a, 0xef, 0x5e, 0x64, 0xae, 0xaa, 0x6a, 0x19, 0x0b, 0x71, 0x92, 0x9a, 0x85, 0x28,
+	0xdc, 0x20, 0x71, 0x12, 0xe2, 0x73, 0x99, 0xce, 0xe6, 0x86, 0x0a, 0xea, 0x90, 0x3b, 0x09, 0xfd,
+	0x12, 0xcb, 0x24, 0x35, 0xb7, 0xc2, 0xcc, 0xc3, 0xe0, 0xa4, 0x87, 0x64, 0xd7, 0x40, 0xf4, 0xcf,
+	0x0e, 0x0c, 0xcf, 0x54, 0x6e, 0x4a, 0x11, 0x9b, 0x7b, 0x51, 0xda, 0x70, 0xdf, 0x07, 0x7f, 0x25,
+	0xb2, 0xa5, 0x74, 0xb5, 0x61, 0x85, 0xff, 0x12, 0xe0, 0xff, 0x45, 0x38, 0x55, 0x9a, 0x07, 0x75,
+	0x9a, 0x5f, 0x7a, 0x41, 0x6f, 0xe8, 0x45, 0x7f, 0xe8, 0xc0, 0x31, 0xb1, 0xf5, 0xeb, 0x25, 0xb2,
+	0x4c, 0x51, 0xfe, 0x12, 0x0e, 0x63, 0x17, 0x39, 0x01, 0x8e, 0xdc, 0xf7, 0x1c, 0xb9, 0xed, 0x02,
+	0xe0, 0xdb, 0x9a, 0xec, 0xa7, 0x30, 0x58, 0xb9, 0x64, 0xe9, 0xb0, 0x4b, 0x53, 0xec, 0x03, 0x77,
+	0x6c, 0x37, 0x99, 0xbc, 0xd1, 0x8c, 0xfe, 0xec, 0xc1, 0x1e, 0xb7, 0xf3, 0xdc, 0x8e, 0x64, 0xab,
+	0x3a, 0x4a, 0x92, 0x52, 0x6a, 0xed, 0xb2, 0xbd, 0x0b, 0x63, 0x26, 0xb0, 0xc2, 0x96, 0x9a, 0x92,
+	0x3e, 0xe0, 0x4e, 0xc2, 0x58, 0x4b, 0x69, 0x27, 0xd5, 0x80, 0xe3, 0x12, 0x35, 0xcd, 0x9a, 0xfa,
+	0xc3, 0xcd, 0x28, 0x2b, 0x61, 0x4f, 0x4d, 0xa5, 0xfc, 0x8d, 0x96, 0xf5, 0x8c, 0x72, 0x22, 0xfb,
+	0x01, 0x3c, 0x8a, 0x97, 0x8b, 0x65, 0x26, 0x4c, 0xba, 0x92, 0x17, 0x4e, 0xc7, 0x12, 0xf1, 0x70,
+	0x03, 0xeb, 0x62, 0x92, 0x29, 0xb5, 0x70, 0x23, 0xcb, 0x0a, 0xec, 0x09, 0xf4, 0xe5, 0x4a, 0xe6,
+	0x46, 0x13, 0x1d, 0x4d, 0x77, 0x8c, 0x11, 0xe4, 0x6e, 0xaf, 0xfd, 0x91, 0x1d, 0x3c, 0xf8, 0xc8,
+	0x36, 0xd3, 0x08, 0x76, 0xa7, 0x51, 0x08, 0x7b, 0x66, 0x7d, 0x99, 0x27, 0x72, 0x4d, 0xdf, 0x24,
+	0x9f, 0x57, 0x22, 0x8e, 0xb8, 0x69, 0xa9, 0x16, 0xee, 0x8b, 0x44, 0x6b, 0x76, 0x04, 0x5d, 0xa3,
+	0xc2, 0x43, 0x42, 0xba, 0x46, 0xe1, 0x05, 0x60, 0x2a, 0xe5, 0xb9, 0xcc, 0xe4, 0x4c, 0x18, 0xac,
+	0xdb, 0x23, 0xaa, 0xdb, 0x6d, 0x10, 0x7f, 0x63, 0x26, 0x34, 0xc5, 0x7e, 0x6c, 0x7d, 0x73, 0x22,
+	0xfb, 0x0e, 0x40, 0x29, 0x57, 0xb2, 0x34, 0x67, 0x2a, 0x91, 0xe1, 0x90, 0x52, 0xdd, 0x42, 0xd0,
+	0xbe, 0x95, 0xbe, 0x90, 0x5a, 0x8b, 0x99, 0x0c, 0x1f, 0x91, 0xca, 0x36, 0xd8, 0x58, 0x39, 0x17,
+	0x46, 0x84, 0xac, 0x6d, 0x05, 0x91, 0xe8, 0xeb, 0x2e, 0xf8, 0x94, 0xad, 0xb7, 0xa8, 0x8a, 0x8f,
+	0x61, 0x40, 0x99, 0xbd, 0x16, 0x0b, 0xe9, 0x0a, 0xa3, 0x01, 0xb0, 0xe3, 0x7e, 0xa7, 0x55, 0x3e,
+	0x2a, 0x67, 0xda, 0x15, 0x48, 0x2d, 0xe3, 0x1e, 0x29, 0xe2, 0x0c, 0xf7, 0x28, 0xa5, 0xb5, 0xdc,
+	0xaa, 0x20, 0x7f, 0xab, 0x82, 0xb6, 0x38, 0xea, 0xbf, 0x81, 0xa3, 0x8a, 0xdb, 0xbd, 0x6d, 0x6e,
+	0x5b, 0xec, 0x05, 0xdb, 0xec, 0x85, 0xb0, 0x97, 0xe2, 0x42, 0x26, 0xe1, 0x80, 0xba, 0xb8, 0x12,
+	0xa3, 0x9f, 0x00, 0x5c, 0xa0, 0xa7, 0xcb, 0x85, 0xb4, 0x17, 0x92, 0x1c, 0x43, 0xec, 0x50, 0x14,
+	0xb4, 0x46, 0x8c, 0xbe, 0x90, 0x36, 0x6c, 0x5a, 0x47, 0xff, 0xe8, 0x40, 0x70, 0xb1, 0xcc, 0x63,
+	0x22, 0xf4, 0x4d, 0x87, 0x7e, 0x04, 0x03, 0xe1, 0x8c, 0x56, 0x3d, 0xfb, 0xc8, 0x55, 0x6a, 0xf3,
+	0x73, 0xbc, 0xd1, 0x71, 0x5f, 0x76, 0x31, 0xc9, 0x24, 0xa5, 0x30, 0xe0, 0x95, 0x88, 0xe6, 0x57,
+	0xa9, 0xfc, 0x3d, 0x65, 0x2f, 0xe0, 0xb4, 0x66, 0x9f, 0xc0, 0xd1, 0x54, 0xca, 0xd7, 0x49, 0x53,
+	0x6a, 0xfe, 0x9b, 0x4a, 0xed, 0xfb, 0xb0, 0x57, 0x4a, 0xb3, 0x2c, 0x73, 0x1d, 0xf6, 0xbf, 0xc9,
+	0x87, 0x4a, 0x23, 0x3a, 0x87, 0x80, 0x86, 0xd6, 0xbd, 0x28, 0xff, 0xd7, 0x3c, 0xe0, 0x54, 0xc8,
+	0x64, 0x4e, 0x1e, 0xfb, 0x1c, 0x97, 0xd1, 0xdf, 0x3b, 0xd0, 0x1b, 0xbd, 0xb8, 0xc4, 0x78, 0x56,
+	0xb2, 0xa4, 0xe9, 0x6d, 0x8d, 0x54, 0x22, 0x56, 0x44, 0x26, 0xf2, 0xd9, 0x52, 0xcc, 0x2a, 0x5b,
+	0xb5, 0xcc, 0x7e, 0x08, 0x83, 0xa9, 0x4b, 0x2b, 0x96, 0x12, 0xba, 0x7c, 0x5c, 0xb9, 0xec, 0x70,
+	0xde, 0x68, 0xb0, 0x5f, 0xc0, 0x31, 0x7d, 0x0e, 0x5f, 0xaf, 0x44, 0x99, 0x62, 0xb2, 0x74, 0xe8,
+	0x6d, 0x1d, 0xaa, 0x02, 0xe2, 0x47, 0xda, 0xad, 0xac, 0x1a, 0xbe, 0x13, 0xdc, 0x18, 0xf1, 0x4f,
+	0x7a, 0xad, 0x77, 0x02, 0x35, 0xc6, 0xab, 0x78, 0x2e, 0x17, 0xa2, 0x1a, 0x26, 0xd1, 0x0d, 0xf8,
+	0x34, 0xc8, 0xdf, 0xae, 0x5f, 0xbe, 0xc2, 0x23, 0x69, 0x3e, 0x55, 0xee, 0x66, 0xd1, 0x00, 0xd1,
+	0x9f, 0x3a, 0x00, 0xcd, 0xf7, 0xe1, 0x2d, 0xcc, 0x32, 0xf0, 0x4a, 0xa5, 0xaa, 0xfb, 0x22, 0xad,
+	0xb1, 0xdd, 0x63, 0xb5, 0x28, 0x70, 0x5f, 0x26, 0xae, 0x48, 0x5a, 0x48, 0xeb, 0xb2, 0xf2, 0xb9,
+	0xdc, 0xd8, 0x70, 0x0f, 0x78, 0x1b, 0x7a, 0xe9, 0x05, 0xdd, 0x61, 0x2f, 0xfa, 0x63, 0x17, 0xe0,
+	0x22, 0xcd, 0x8c, 0x2c, 0x2f, 0xf3, 0xa9, 0x7a, 0x67, 0xb3, 0xa1, 0xea, 0x65, 0x1a, 0x9e, 0xf6,
+	0xc1, 0xd3, 0x00, 0x75, 0x2f, 0x1b, 0x15, 0x7a, 0xad, 0x5e, 0x36, 0x0a, 0x43, 0x4d, 0xa4, 0x8e,
+	0x5d, 0x5d, 0xd3, 0x9a, 0xbe, 0xc6, 0xe5, 0xcc, 0x3a, 0x59, 0xcd, 0x85, 0x1a, 0xc0, 0x07, 0x12,
+	0x3e, 0x5f, 0x72, 0x43, 0x37, 0xc7, 0xb3, 0xdc, 0x7e, 0xcb, 0x7d, 0xbe, 0x83, 0xe2, 0x14, 0x75,
+	0xcd, 0xef, 0x2c, 0x05, 0xf6, 0x99, 0xb6, 0x05, 0x46, 0x09, 0x04, 0xb7, 0xa5, 0x2a, 0x94, 0x16,
+	0x19, 0xce, 0xf9, 0x34, 0x71, 0x65, 0xdc, 0x4d, 0x29, 0xa5, 0xe8, 0x4f, 0x99, 0x16, 0xd4, 0x7a,
+	0x76, 0xe4, 0xb5, 0x21, 0xf4, 0x65, 0xb1, 0xcc, 0x4c, 0x5a, 0x64, 0xf2, 0x6c, 0xae, 0xf0, 0xde,
+	0xdd, 0xa7, 0x7b, 0xc5, 0x0e, 0x1a, 0x71, 0xd8, 0x6f, 0x55, 0xdc, 0x3b, 0x99, 0x24, 0xd1, 0x5f,
+	0x3b, 0xf0, 0x5e, 0x75, 0x2f, 0xc0, 0xcf, 0xc6, 0xbd, 0xeb, 0xbb, 0x9d, 0x8e, 0xf4, 0x9a, 0x8e,
+	0xfc, 0x08, 0x9f, 0x5c, 0x89, 0x7c, 0x3d, 0x6f, 0x1e, 0xad, 0xcd, 0x65, 0xf7, 0x43, 0x08, 0x88,
+	0x93, 0xd7, 0xf9, 0x83, 0x07, 0xeb, 0x07, 0x38, 0x6f, 0xed, 0xa9, 0xed, 0x2b, 0xc0, 0x63, 0x08,
+	0x12, 0x59, 0x64, 0x6a, 0x23, 0xcb, 0xea, 0x0a, 0x56, 0xc9, 0xd1, 0x18, 0xf6, 0xd1, 0xab, 0xcf,
+	0x52, 0x2c, 0xc1, 0x0d, 0xfb, 0x19, 0x04, 0xce, 0x8d, 0xea, 0x85, 0xf6, 0x78, 0xe7, 0x6e, 0xd3,
+	0x8a, 0x81, 0xd7, 0xba, 0x4f, 0x53, 0xe8, 0xdb, 0x47, 0x0a, 0x03, 0xe8, 0x5f, 0xdf, 0xf0, 0x2f,
+	0x46, 0x57, 0xc3, 0x6f, 0xb1, 0x23, 0x80, 0x4f, 0x6f, 0xee, 0xc7, 0xfc, 0x7a, 0x74, 0x7d, 0x36,
+	0x1e, 0x76, 0xd8, 0x01, 0x04, 0x7c, 0x7c, 0x3e, 0xbe, 0xbd, 0xba, 0xf9, 0xed, 0xb0, 0xcb, 0x1e,
+	0xc1, 0xe1, 0xc5, 0x78, 0x7c, 0x3e, 0xbe, 0x1a, 0x7f, 0x3a, 0xba, 0xbb, 0xbc, 0xb9, 0x1e, 0xf6,
+	0x50, 0xe1, 0x8e, 0x8f, 0xae, 0x5f, 0x5d, 0x8c, 0xf9, 0xd0, 0x63, 0x01, 0x78, 0x67, 0xa3, 0xab,
+	0xab, 0xa1, 0x8f, 0x46, 0xdd, 0xb1, 0xfe, 0xa4, 0x4f, 0x7f, 0x3f, 0xfc, 0xf8, 0xdf, 0x03, 0x00,
+	0xe7, 0x2a, 0xff, 0x0a, 0x92, 0x10, 0x00, 0x00,
 }
@@ -242,3 +242,38 @@ func TestBlockLimit(t *testing.T) {
 	a.True(block.Size() <= txSize*i+hdrSize, "block size violation")
 	a.True(block.Size() <= limit, "block size violation")
 }
+
+func TestFunctionValidateArgs(t *testing.T) {
+	fn := &Function{
+		Name: "transfer",
+		Arguments: []*FnArgument{
+			{Name: "to", Type: "address"},
+			{Name: "amount", Type: "bignum"},
+			{Name: "ids", Type: "integer[]"},
+			{Name: "memo"},
+		},
+	}
+	tests := []struct {
+		args  []interface{}
+		valid bool
+	}{
+		{[]interface{}{"AmPbWrQbtQrCaJqLWdMtfk2KiN83m2HFpBbQQSTxqqchVv58o82i", "1000", []interface{}{1.0, 2.0}, 3.0}, true},
+		{[]interface{}{"aergo.name", map[string]interface{}{"_bignum": "1000"}, []interface{}{}}, true},
+		{[]interface{}{"AmPbWrQbtQrCaJqLWdMtfk2KiN83m2HFpBbQQSTxqqchVv58o82", "1000", []interface{}{}}, false},
+		{[]interface{}{"aergo.name", "10a", []interface{}{}}, false},
+		{[]interface{}{"aergo.name", 1.5, []interface{}{}}, false},
+		{[]interface{}{"aergo.name", "1", []interface{}{1.5}}, false},
+		{[]interface{}{"aergo.name", "1"}, false},
+		{[]interface{}{"aergo.name", "1", []interface{}{}, nil, nil}, false},
+	}
+	for i, test := range tests {
+		err := fn.ValidateArgs(test.args)
+		assert.Equal(t, test.valid, err == nil, "case %d: %v", i, err)
+	}
+
+	untyped := &Function{Name: "f", Arguments: []*FnArgument{{Name: "a"}}}
+	assert.NoError(t, untyped.ValidateArgs([]interface{}{1.0, 2.0}))
+
+	assert.True(t, IsValidABIType("bignum[]"))
+	assert.False(t, IsValidABIType("uint256"))
+}
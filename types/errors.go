@@ -78,6 +78,16 @@ var (
 	ErrNotAllowedFeeDelegation = errors.New("fee delegation is not allowed")
 
 	ErrNotEnoughGas = errors.New("not enough gas")
+
+	ErrNotUpgradeable = errors.New("contract is not upgradeable")
+
+	ErrUpgradeOwnerNotMatch = errors.New("upgrade owner not matched")
+
+	ErrSourceNotMatch = errors.New("source code does not match the deployed contract")
+
+	ErrSourceNotVerified = errors.New("source code is not verified")
+
+	ErrSourceAlreadyVerified = errors.New("source code is already verified")
 )
 
 type InternalError struct {
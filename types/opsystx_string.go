@@ -12,12 +12,16 @@ func _() {
 	_ = x[OpvoteDAO-1]
 	_ = x[Opstake-2]
 	_ = x[Opunstake-3]
-	_ = x[OpSysTxMax-4]
+	_ = x[Opunbond-4]
+	_ = x[Opwithdraw-5]
+	_ = x[OpcreateProposal-6]
+	_ = x[OpexecuteProposal-7]
+	_ = x[OpSysTxMax-8]
 }
 
-const _OpSysTx_name = "OpvoteBPOpvoteDAOOpstakeOpunstakeOpSysTxMax"
+const _OpSysTx_name = "OpvoteBPOpvoteDAOOpstakeOpunstakeOpunbondOpwithdrawOpcreateProposalOpexecuteProposalOpSysTxMax"
 
-var _OpSysTx_index = [...]uint8{0, 8, 17, 24, 33, 43}
+var _OpSysTx_index = [...]uint8{0, 8, 17, 24, 33, 41, 51, 67, 84, 94}
 
 func (i OpSysTx) String() string {
 	if i < 0 || i >= OpSysTx(len(_OpSysTx_index)-1) {
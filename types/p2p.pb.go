@@ -110,7 +110,7 @@ func (x ResultStatus) String() string {
 	return proto.EnumName(ResultStatus_name, int32(x))
 }
 func (ResultStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_6496de2d566cf566, []int{0}
+	return fileDescriptor_p2p_0c19a8d2bd01fe02, []int{0}
 }
 
 // MsgHeader contains common properties of all p2p messages
@@ -143,7 +143,7 @@ func (m *MsgHeader) Reset()         { *m = MsgHeader{} }
 func (m *MsgHeader) String() string { return proto.CompactTextString(m) }
 func (*MsgHeader) ProtoMessage()    {}
 func (*MsgHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_6496de2d566cf566, []int{0}
+	return fileDescriptor_p2p_0c19a8d2bd01fe02, []int{0}
 }
 func (m *MsgHeader) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgHeader.Unmarshal(m, b)
@@ -239,7 +239,7 @@ func (m *P2PMessage) Reset()         { *m = P2PMessage{} }
 func (m *P2PMessage) String() string { return proto.CompactTextString(m) }
 func (*P2PMessage) ProtoMessage()    {}
 func (*P2PMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_6496de2d566cf566, []int{1}
+	return fileDescriptor_p2p_0c19a8d2bd01fe02, []int{1}
 }
 func (m *P2PMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_P2PMessage.Unmarshal(m, b)
@@ -286,7 +286,7 @@ func (m *Ping) Reset()         { *m = Ping{} }
 func (m *Ping) String() string { return proto.CompactTextString(m) }
 func (*Ping) ProtoMessage()    {}
 func (*Ping) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_6496de2d566cf566, []int{2}
+	return fileDescriptor_p2p_0c19a8d2bd01fe02, []int{2}
 }
 func (m *Ping) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Ping.Unmarshal(m, b)
@@ -333,7 +333,7 @@ func (m *Pong) Reset()         { *m = Pong{} }
 func (m *Pong) String() string { return proto.CompactTextString(m) }
 func (*Pong) ProtoMessage()    {}
 func (*Pong) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_6496de2d566cf566, []int{3}
+	return fileDescriptor_p2p_0c19a8d2bd01fe02, []int{3}
 }
 func (m *Pong) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Pong.Unmarshal(m, b)
@@ -382,7 +382,9 @@ type Status struct {
 	Genesis      []byte              `protobuf:"bytes,7,opt,name=genesis,proto3" json:"genesis,omitempty"`
 	Certificates []*AgentCertificate `protobuf:"bytes,8,rep,name=certificates" json:"certificates,omitempty"`
 	// request to issue agent certificates
-	IssueCertificate     bool     `protobuf:"varint,9,opt,name=issueCertificate" json:"issueCertificate,omitempty"`
+	IssueCertificate bool `protobuf:"varint,9,opt,name=issueCertificate" json:"issueCertificate,omitempty"`
+	// names of compression codecs which sender can handle, in order of preference
+	Compressions         []string `protobuf:"bytes,10,rep,name=compressions" json:"compressions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -392,7 +394,7 @@ func (m *Status) Reset()         { *m = Status{} }
 func (m *Status) String() string { return proto.CompactTextString(m) }
 func (*Status) ProtoMessage()    {}
 func (*Status) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_6496de2d566cf566, []int{4}
+	return fileDescriptor_p2p_0c19a8d2bd01fe02, []int{4}
 }
 func (m *Status) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Status.Unmarshal(m, b)
@@ -475,6 +477,13 @@ func (m *Status) GetIssueCertificate() bool {
 	return false
 }
 
+func (m *Status) GetCompressions() []string {
+	if m != nil {
+		return m.Compressions
+	}
+	return nil
+}
+
 // GoAwayNotice is sent before host peer is closing connection to remote peer. it contains why the host closing connection.
 type GoAwayNotice struct {
 	Message              string   `protobuf:"bytes,1,opt,name=message" json:"message,omitempty"`
@@ -487,7 +496,7 @@ func (m *GoAwayNotice) Reset()         { *m = GoAwayNotice{} }
 func (m *GoAwayNotice) String() string { return proto.CompactTextString(m) }
 func (*GoAwayNotice) ProtoMessage()    {}
 func (*GoAwayNotice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_6496de2d566cf566, []int{5}
+	return fileDescriptor_p2p_0c19a8d2bd01fe02, []int{5}
 }
 func (m *GoAwayNotice) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GoAwayNotice.Unmarshal(m, b)
@@ -526,7 +535,7 @@ func (m *AddressesRequest) Reset()         { *m = AddressesRequest{} }
 func (m *AddressesRequest) String() string { return proto.CompactTextString(m) }
 func (*AddressesRequest) ProtoMessage()    {}
 func (*AddressesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_6496de2d566cf566, []int{6}
+	return fileDescriptor_p2p_0c19a8d2bd01fe02, []int{6}
 }
 func (m *AddressesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddressesRequest.Unmarshal(m, b)
@@ -572,7 +581,7 @@ func (m *AddressesResponse) Reset()         { *m = AddressesResponse{} }
 func (m *AddressesResponse) String() string { return proto.CompactTextString(m) }
 func (*AddressesResponse) ProtoMessage()    {}
 func (*AddressesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_6496de2d566cf566, []int{7}
+	return fileDescriptor_p2p_0c19a8d2bd01fe02, []int{7}
 }
 func (m *AddressesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddressesResponse.Unmarshal(m, b)
@@ -621,7 +630,7 @@ func (m *NewBlockNotice) Reset()         { *m = NewBlockNotice{} }
 func (m *NewBlockNotice) String() string { return proto.CompactTextString(m) }
 func (*NewBlockNotice) ProtoMessage()    {}
 func (*NewBlockNotice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_6496de2d566cf566, []int{8}
+	return fileDescriptor_p2p_0c19a8d2bd01fe02, []int{8}
 }
 func (m *NewBlockNotice) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NewBlockNotice.Unmarshal(m, b)
@@ -670,7 +679,7 @@ func (m *BlockProducedNotice) Reset()         { *m = BlockProducedNotice{} }
 func (m *BlockProducedNotice) String() string { return proto.CompactTextString(m) }
 func (*BlockProducedNotice) ProtoMessage()    {}
 func (*BlockProducedNotice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_6496de2d566cf566, []int{9}
+	return fileDescriptor_p2p_0c19a8d2bd01fe02, []int{9}
 }
 func (m *BlockProducedNotice) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockProducedNotice.Unmarshal(m, b)
@@ -730,7 +739,7 @@ func (m *GetBlockHeadersRequest) Reset()         { *m = GetBlockHeadersRequest{}
 func (m *GetBlockHeadersRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockHeadersRequest) ProtoMessage()    {}
 func (*GetBlockHeadersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_6496de2d566cf566, []int{10}
+	return fileDescriptor_p2p_0c19a8d2bd01fe02, []int{10}
 }
 func (m *GetBlockHeadersRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBlockHeadersRequest.Unmarshal(m, b)
@@ -800,7 +809,7 @@ func (m *GetBlockHeadersResponse) Reset()         { *m = GetBlockHeadersResponse
 func (m *GetBlockHeadersResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBlockHeadersResponse) ProtoMessage()    {}
 func (*GetBlockHeadersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_6496de2d566cf566, []int{11}
+	return fileDescriptor_p2p_0c19a8d2bd01fe02, []int{11}
 }
 func (m *GetBlockHeadersResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBlockHeadersResponse.Unmarshal(m, b)
@@ -860,7 +869,7 @@ func (m *GetBlockRequest) Reset()         { *m = GetBlockRequest{} }
 func (m *GetBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockRequest) ProtoMessage()    {}
 func (*GetBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_6496de2d566cf566, []int{12}
+	return fileDescriptor_p2p_0c19a8d2bd01fe02, []int{12}
 }
 func (m *GetBlockRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBlockRequest.Unmarshal(m, b)
@@ -901,7 +910,7 @@ func (m *GetBlockResponse) Reset()         { *m = GetBlockResponse{} }
 func (m *GetBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBlockResponse) ProtoMessage()    {}
 func (*GetBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_6496de2d566cf566, []int{13}
+	return fileDescriptor_p2p_0c19a8d2bd01fe02, []int{13}
 }
 func (m *GetBlockResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBlockResponse.Unmarshal(m, b)
@@ -953,7 +962,7 @@ func (m *NewTransactionsNotice) Reset()         { *m = NewTransactionsNotice{} }
 func (m *NewTransactionsNotice) String() string { return proto.CompactTextString(m) }
 func (*NewTransactionsNotice) ProtoMessage()    {}
 func (*NewTransactionsNotice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_6496de2d566cf566, []int{14}
+	return fileDescriptor_p2p_0c19a8d2bd01fe02, []int{14}
 }
 func (m *NewTransactionsNotice) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NewTransactionsNotice.Unmarshal(m, b)
@@ -991,7 +1000,7 @@ func (m *GetTransactionsRequest) Reset()         { *m = GetTransactionsRequest{}
 func (m *GetTransactionsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTransactionsRequest) ProtoMessage()    {}
 func (*GetTransactionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_6496de2d566cf566, []int{15}
+	return fileDescriptor_p2p_0c19a8d2bd01fe02, []int{15}
 }
 func (m *GetTransactionsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTransactionsRequest.Unmarshal(m, b)
@@ -1032,7 +1041,7 @@ func (m *GetTransactionsResponse) Reset()         { *m = GetTransactionsResponse
 func (m *GetTransactionsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTransactionsResponse) ProtoMessage()    {}
 func (*GetTransactionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_6496de2d566cf566, []int{16}
+	return fileDescriptor_p2p_0c19a8d2bd01fe02, []int{16}
 }
 func (m *GetTransactionsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTransactionsResponse.Unmarshal(m, b)
@@ -1095,7 +1104,7 @@ func (m *GetMissingRequest) Reset()         { *m = GetMissingRequest{} }
 func (m *GetMissingRequest) String() string { return proto.CompactTextString(m) }
 func (*GetMissingRequest) ProtoMessage()    {}
 func (*GetMissingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_6496de2d566cf566, []int{17}
+	return fileDescriptor_p2p_0c19a8d2bd01fe02, []int{17}
 }
 func (m *GetMissingRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMissingRequest.Unmarshal(m, b)
@@ -1141,7 +1150,7 @@ func (m *GetAncestorRequest) Reset()         { *m = GetAncestorRequest{} }
 func (m *GetAncestorRequest) String() string { return proto.CompactTextString(m) }
 func (*GetAncestorRequest) ProtoMessage()    {}
 func (*GetAncestorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_6496de2d566cf566, []int{18}
+	return fileDescriptor_p2p_0c19a8d2bd01fe02, []int{18}
 }
 func (m *GetAncestorRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAncestorRequest.Unmarshal(m, b)
@@ -1181,7 +1190,7 @@ func (m *GetAncestorResponse) Reset()         { *m = GetAncestorResponse{} }
 func (m *GetAncestorResponse) String() string { return proto.CompactTextString(m) }
 func (*GetAncestorResponse) ProtoMessage()    {}
 func (*GetAncestorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_6496de2d566cf566, []int{19}
+	return fileDescriptor_p2p_0c19a8d2bd01fe02, []int{19}
 }
 func (m *GetAncestorResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAncestorResponse.Unmarshal(m, b)
@@ -1233,7 +1242,7 @@ func (m *GetHashByNo) Reset()         { *m = GetHashByNo{} }
 func (m *GetHashByNo) String() string { return proto.CompactTextString(m) }
 func (*GetHashByNo) ProtoMessage()    {}
 func (*GetHashByNo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_6496de2d566cf566, []int{20}
+	return fileDescriptor_p2p_0c19a8d2bd01fe02, []int{20}
 }
 func (m *GetHashByNo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetHashByNo.Unmarshal(m, b)
@@ -1272,7 +1281,7 @@ func (m *GetHashByNoResponse) Reset()         { *m = GetHashByNoResponse{} }
 func (m *GetHashByNoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetHashByNoResponse) ProtoMessage()    {}
 func (*GetHashByNoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_6496de2d566cf566, []int{21}
+	return fileDescriptor_p2p_0c19a8d2bd01fe02, []int{21}
 }
 func (m *GetHashByNoResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetHashByNoResponse.Unmarshal(m, b)
@@ -1323,7 +1332,7 @@ func (m *GetHashesRequest) Reset()         { *m = GetHashesRequest{} }
 func (m *GetHashesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetHashesRequest) ProtoMessage()    {}
 func (*GetHashesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_6496de2d566cf566, []int{22}
+	return fileDescriptor_p2p_0c19a8d2bd01fe02, []int{22}
 }
 func (m *GetHashesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetHashesRequest.Unmarshal(m, b)
@@ -1378,7 +1387,7 @@ func (m *GetHashesResponse) Reset()         { *m = GetHashesResponse{} }
 func (m *GetHashesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetHashesResponse) ProtoMessage()    {}
 func (*GetHashesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_6496de2d566cf566, []int{23}
+	return fileDescriptor_p2p_0c19a8d2bd01fe02, []int{23}
 }
 func (m *GetHashesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetHashesResponse.Unmarshal(m, b)
@@ -1430,7 +1439,7 @@ func (m *IssueCertificateRequest) Reset()         { *m = IssueCertificateRequest
 func (m *IssueCertificateRequest) String() string { return proto.CompactTextString(m) }
 func (*IssueCertificateRequest) ProtoMessage()    {}
 func (*IssueCertificateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_6496de2d566cf566, []int{24}
+	return fileDescriptor_p2p_0c19a8d2bd01fe02, []int{24}
 }
 func (m *IssueCertificateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IssueCertificateRequest.Unmarshal(m, b)
@@ -1463,7 +1472,7 @@ func (m *IssueCertificateResponse) Reset()         { *m = IssueCertificateRespon
 func (m *IssueCertificateResponse) String() string { return proto.CompactTextString(m) }
 func (*IssueCertificateResponse) ProtoMessage()    {}
 func (*IssueCertificateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_6496de2d566cf566, []int{25}
+	return fileDescriptor_p2p_0c19a8d2bd01fe02, []int{25}
 }
 func (m *IssueCertificateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IssueCertificateResponse.Unmarshal(m, b)
@@ -1509,7 +1518,7 @@ func (m *CertificateRenewedNotice) Reset()         { *m = CertificateRenewedNoti
 func (m *CertificateRenewedNotice) String() string { return proto.CompactTextString(m) }
 func (*CertificateRenewedNotice) ProtoMessage()    {}
 func (*CertificateRenewedNotice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_6496de2d566cf566, []int{26}
+	return fileDescriptor_p2p_0c19a8d2bd01fe02, []int{26}
 }
 func (m *CertificateRenewedNotice) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CertificateRenewedNotice.Unmarshal(m, b)
@@ -1536,6 +1545,193 @@ func (m *CertificateRenewedNotice) GetCertificate() *AgentCertificate {
 	return nil
 }
 
+// GetCompactBlockRequest asks the block header and short ids of txs in the block
+type GetCompactBlockRequest struct {
+	BlockHash            []byte   `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCompactBlockRequest) Reset()         { *m = GetCompactBlockRequest{} }
+func (m *GetCompactBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCompactBlockRequest) ProtoMessage()    {}
+func (*GetCompactBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_p2p_0c19a8d2bd01fe02, []int{27}
+}
+func (m *GetCompactBlockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCompactBlockRequest.Unmarshal(m, b)
+}
+func (m *GetCompactBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCompactBlockRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetCompactBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCompactBlockRequest.Merge(dst, src)
+}
+func (m *GetCompactBlockRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCompactBlockRequest.Size(m)
+}
+func (m *GetCompactBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCompactBlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCompactBlockRequest proto.InternalMessageInfo
+
+func (m *GetCompactBlockRequest) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+type GetCompactBlockResponse struct {
+	Status ResultStatus `protobuf:"varint,1,opt,name=status,enum=types.ResultStatus" json:"status,omitempty"`
+	Header *BlockHeader `protobuf:"bytes,2,opt,name=header" json:"header,omitempty"`
+	// short ids of txs in the block, made by ShortTxID with the block hash
+	ShortTxIDs           [][]byte `protobuf:"bytes,3,rep,name=shortTxIDs,proto3" json:"shortTxIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCompactBlockResponse) Reset()         { *m = GetCompactBlockResponse{} }
+func (m *GetCompactBlockResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCompactBlockResponse) ProtoMessage()    {}
+func (*GetCompactBlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_p2p_0c19a8d2bd01fe02, []int{28}
+}
+func (m *GetCompactBlockResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCompactBlockResponse.Unmarshal(m, b)
+}
+func (m *GetCompactBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCompactBlockResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetCompactBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCompactBlockResponse.Merge(dst, src)
+}
+func (m *GetCompactBlockResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCompactBlockResponse.Size(m)
+}
+func (m *GetCompactBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCompactBlockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCompactBlockResponse proto.InternalMessageInfo
+
+func (m *GetCompactBlockResponse) GetStatus() ResultStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResultStatus_OK
+}
+
+func (m *GetCompactBlockResponse) GetHeader() *BlockHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *GetCompactBlockResponse) GetShortTxIDs() [][]byte {
+	if m != nil {
+		return m.ShortTxIDs
+	}
+	return nil
+}
+
+// GetBlockTxsRequest asks the txs in the block by their indexes
+type GetBlockTxsRequest struct {
+	BlockHash            []byte   `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Indexes              []uint32 `protobuf:"varint,2,rep,packed,name=indexes" json:"indexes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBlockTxsRequest) Reset()         { *m = GetBlockTxsRequest{} }
+func (m *GetBlockTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockTxsRequest) ProtoMessage()    {}
+func (*GetBlockTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_p2p_0c19a8d2bd01fe02, []int{29}
+}
+func (m *GetBlockTxsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlockTxsRequest.Unmarshal(m, b)
+}
+func (m *GetBlockTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlockTxsRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetBlockTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockTxsRequest.Merge(dst, src)
+}
+func (m *GetBlockTxsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBlockTxsRequest.Size(m)
+}
+func (m *GetBlockTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockTxsRequest proto.InternalMessageInfo
+
+func (m *GetBlockTxsRequest) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *GetBlockTxsRequest) GetIndexes() []uint32 {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+type GetBlockTxsResponse struct {
+	Status               ResultStatus `protobuf:"varint,1,opt,name=status,enum=types.ResultStatus" json:"status,omitempty"`
+	Txs                  []*Tx        `protobuf:"bytes,2,rep,name=txs" json:"txs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GetBlockTxsResponse) Reset()         { *m = GetBlockTxsResponse{} }
+func (m *GetBlockTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBlockTxsResponse) ProtoMessage()    {}
+func (*GetBlockTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_p2p_0c19a8d2bd01fe02, []int{30}
+}
+func (m *GetBlockTxsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlockTxsResponse.Unmarshal(m, b)
+}
+func (m *GetBlockTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlockTxsResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetBlockTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockTxsResponse.Merge(dst, src)
+}
+func (m *GetBlockTxsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBlockTxsResponse.Size(m)
+}
+func (m *GetBlockTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockTxsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockTxsResponse proto.InternalMessageInfo
+
+func (m *GetBlockTxsResponse) GetStatus() ResultStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResultStatus_OK
+}
+
+func (m *GetBlockTxsResponse) GetTxs() []*Tx {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgHeader)(nil), "types.MsgHeader")
 	proto.RegisterType((*P2PMessage)(nil), "types.P2PMessage")
@@ -1564,91 +1760,102 @@ func init() {
 	proto.RegisterType((*IssueCertificateRequest)(nil), "types.IssueCertificateRequest")
 	proto.RegisterType((*IssueCertificateResponse)(nil), "types.IssueCertificateResponse")
 	proto.RegisterType((*CertificateRenewedNotice)(nil), "types.CertificateRenewedNotice")
+	proto.RegisterType((*GetCompactBlockRequest)(nil), "types.GetCompactBlockRequest")
+	proto.RegisterType((*GetCompactBlockResponse)(nil), "types.GetCompactBlockResponse")
+	proto.RegisterType((*GetBlockTxsRequest)(nil), "types.GetBlockTxsRequest")
+	proto.RegisterType((*GetBlockTxsResponse)(nil), "types.GetBlockTxsResponse")
 	proto.RegisterEnum("types.ResultStatus", ResultStatus_name, ResultStatus_value)
 }
 
-func init() { proto.RegisterFile("p2p.proto", fileDescriptor_p2p_6496de2d566cf566) }
-
-var fileDescriptor_p2p_6496de2d566cf566 = []byte{
-	// 1279 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6e, 0xdb, 0x46,
-	0x13, 0xff, 0x28, 0xd9, 0xb2, 0x34, 0xa2, 0x6c, 0x7a, 0xfd, 0x25, 0x66, 0xdd, 0x20, 0x15, 0x88,
-	0xa0, 0x55, 0xd3, 0x20, 0x28, 0x9c, 0x53, 0xd1, 0x13, 0x2d, 0x32, 0x12, 0x1b, 0x79, 0x29, 0xac,
-	0xa4, 0x34, 0x3d, 0xa9, 0x94, 0xb4, 0x91, 0xd8, 0xda, 0xa4, 0xca, 0x5d, 0xc5, 0x76, 0x2e, 0x05,
-	0x7a, 0xe8, 0x1b, 0xf4, 0x15, 0xfa, 0x18, 0x7d, 0x83, 0x3e, 0x52, 0x81, 0x62, 0x97, 0x4b, 0x89,
-	0xb4, 0x93, 0x18, 0x35, 0x72, 0xdb, 0xf9, 0xc3, 0x99, 0xdf, 0xcc, 0xfc, 0x76, 0x96, 0x50, 0x5b,
-	0x1e, 0x2f, 0x9f, 0x2e, 0x93, 0x98, 0xc7, 0x68, 0x9b, 0x5f, 0x2d, 0x29, 0x3b, 0x32, 0x26, 0x67,
-	0xf1, 0xf4, 0xe7, 0xe9, 0x22, 0x08, 0xa3, 0xd4, 0x70, 0x04, 0x51, 0x3c, 0xa3, 0xe9, 0xd9, 0xfa,
-	0x47, 0x83, 0xda, 0x29, 0x9b, 0x77, 0x69, 0x30, 0xa3, 0x09, 0x7a, 0x04, 0x8d, 0xe9, 0x59, 0x48,
-	0x23, 0xfe, 0x92, 0x26, 0x2c, 0x8c, 0x23, 0x53, 0x6b, 0x6a, 0xad, 0x1a, 0x29, 0x2a, 0xd1, 0x03,
-	0xa8, 0xf1, 0xf0, 0x9c, 0x32, 0x1e, 0x9c, 0x2f, 0xcd, 0x52, 0x53, 0x6b, 0x95, 0xc9, 0x46, 0x81,
-	0x76, 0xa1, 0x14, 0xce, 0xcc, 0xb2, 0xfc, 0xb0, 0x14, 0xce, 0xd0, 0x7d, 0xa8, 0xcc, 0x63, 0xc6,
-	0xc2, 0xa5, 0xb9, 0xd5, 0xd4, 0x5a, 0x55, 0xa2, 0x24, 0xa1, 0x5f, 0x52, 0x9a, 0x78, 0x8e, 0xb9,
-	0xdd, 0xd4, 0x5a, 0x3a, 0x51, 0x12, 0x7a, 0x08, 0x12, 0x5f, 0x7f, 0x35, 0x79, 0x41, 0xaf, 0xcc,
-	0x8a, 0xb4, 0xe5, 0x34, 0x08, 0xc1, 0x16, 0x0b, 0xe7, 0x91, 0xb9, 0x23, 0x2d, 0xf2, 0x8c, 0x9a,
-	0x50, 0x67, 0xab, 0x89, 0xac, 0x68, 0x1a, 0x9f, 0x99, 0xd5, 0xa6, 0xd6, 0x6a, 0x90, 0xbc, 0x4a,
-	0x64, 0x3b, 0xa3, 0xd1, 0x9c, 0x2f, 0xcc, 0x9a, 0x34, 0x2a, 0xc9, 0xfa, 0x0e, 0xa0, 0x7f, 0xdc,
-	0x3f, 0xa5, 0x8c, 0x05, 0x73, 0x8a, 0x5a, 0x50, 0x59, 0xc8, 0x4e, 0xc8, 0xc2, 0xeb, 0xc7, 0xc6,
-	0x53, 0xd9, 0xc3, 0xa7, 0xeb, 0x0e, 0x11, 0x65, 0x17, 0x28, 0x66, 0x01, 0x0f, 0x64, 0xf9, 0x3a,
-	0x91, 0x67, 0xcb, 0x87, 0xad, 0x7e, 0x18, 0xcd, 0xd1, 0xe7, 0xb0, 0x37, 0xa1, 0x8c, 0x8f, 0x65,
-	0xe3, 0xc7, 0x8b, 0x80, 0x2d, 0x64, 0x38, 0x9d, 0x34, 0x84, 0xfa, 0x44, 0x68, 0xbb, 0x01, 0x5b,
-	0xa0, 0xcf, 0xa0, 0x2e, 0xfd, 0x16, 0x34, 0x9c, 0x2f, 0xb8, 0x0c, 0xb5, 0x45, 0x40, 0xa8, 0xba,
-	0x52, 0x63, 0xf5, 0x60, 0xab, 0x1f, 0x47, 0x73, 0x31, 0x96, 0xc2, 0x97, 0xef, 0x0e, 0xf7, 0x10,
-	0x72, 0xdf, 0xbe, 0x23, 0xda, 0xdf, 0x25, 0xa8, 0x0c, 0x78, 0xc0, 0x57, 0x0c, 0x3d, 0x86, 0x0a,
-	0xa3, 0xd1, 0xa6, 0x4e, 0xa4, 0xea, 0xec, 0x53, 0x9a, 0xd8, 0xb3, 0x59, 0x42, 0x19, 0x23, 0xca,
-	0xe3, 0x66, 0xf2, 0xd2, 0xed, 0xc9, 0xcb, 0xd7, 0x93, 0x23, 0x13, 0x76, 0x24, 0x05, 0x3d, 0x47,
-	0xd2, 0x40, 0x27, 0x99, 0x88, 0x8e, 0xa0, 0x1a, 0xc5, 0xee, 0xe5, 0x32, 0x66, 0x54, 0x32, 0xa1,
-	0x4a, 0xd6, 0xb2, 0xf8, 0xea, 0x8d, 0x62, 0x62, 0x45, 0x12, 0x2a, 0x13, 0x85, 0x65, 0x4e, 0x23,
-	0xca, 0x42, 0xa6, 0x88, 0x90, 0x89, 0xe8, 0x5b, 0xd0, 0xa7, 0x34, 0xe1, 0xe1, 0xeb, 0x70, 0x1a,
-	0x70, 0xca, 0xcc, 0x6a, 0xb3, 0xdc, 0xaa, 0x1f, 0x1f, 0xaa, 0x0a, 0xed, 0x39, 0x8d, 0x78, 0x7b,
-	0x63, 0x27, 0x05, 0x67, 0xf4, 0x18, 0x8c, 0x90, 0xb1, 0x15, 0xcd, 0x79, 0x48, 0xc2, 0x54, 0xc9,
-	0x0d, 0xbd, 0xd5, 0x02, 0xbd, 0x13, 0xdb, 0x17, 0xc1, 0x15, 0x8e, 0x79, 0x38, 0x95, 0x60, 0xcf,
-	0x53, 0x1e, 0xa9, 0x6b, 0x93, 0x89, 0xd6, 0x2b, 0x30, 0x54, 0x57, 0x29, 0x23, 0xf4, 0x97, 0x15,
-	0x65, 0xfc, 0x3f, 0x8d, 0x40, 0x44, 0x0e, 0x2e, 0x07, 0xe1, 0x5b, 0x2a, 0x9b, 0xdf, 0x20, 0x99,
-	0x68, 0xfd, 0x04, 0xfb, 0xb9, 0xc8, 0x6c, 0x19, 0x47, 0x8c, 0xa2, 0xaf, 0xa0, 0xc2, 0xe4, 0x9c,
-	0x65, 0xe8, 0xdd, 0xe3, 0x03, 0x15, 0x9a, 0x50, 0xb6, 0x3a, 0xe3, 0x29, 0x05, 0x88, 0x72, 0x41,
-	0x2d, 0xd8, 0x16, 0x17, 0x8f, 0x99, 0x25, 0xd9, 0xa7, 0x77, 0xc1, 0x48, 0x1d, 0xac, 0x2e, 0xec,
-	0x62, 0x7a, 0x21, 0x47, 0xae, 0x2a, 0x7e, 0x00, 0xb5, 0xc9, 0x35, 0x4e, 0x6e, 0x14, 0x02, 0xf5,
-	0x24, 0x75, 0x56, 0x64, 0xcc, 0x44, 0x8b, 0xc1, 0x81, 0x0c, 0xd3, 0x4f, 0xe2, 0xd9, 0x6a, 0x4a,
-	0x67, 0x2a, 0xdc, 0x43, 0x80, 0x65, 0xaa, 0x11, 0x5b, 0x21, 0x8d, 0x97, 0xd3, 0xbc, 0x3f, 0x20,
-	0xb2, 0x60, 0x5b, 0x1e, 0x25, 0xf1, 0xea, 0xc7, 0xba, 0x2a, 0x42, 0x26, 0x21, 0xa9, 0xc9, 0xfa,
-	0x4d, 0x83, 0xfb, 0x1d, 0xaa, 0x28, 0x2b, 0x2f, 0xf1, 0x7a, 0x16, 0x08, 0xb6, 0x72, 0xb7, 0x54,
-	0x9e, 0xc5, 0xc2, 0x28, 0xdc, 0x4b, 0x25, 0x09, 0x7d, 0xfc, 0xfa, 0x35, 0xa3, 0x19, 0xc9, 0x95,
-	0x94, 0xae, 0xa5, 0xb7, 0x54, 0xb2, 0xbb, 0x41, 0xe4, 0x19, 0x19, 0x50, 0x0e, 0xd8, 0x54, 0xb1,
-	0x5a, 0x1c, 0xad, 0x3f, 0x35, 0x38, 0xbc, 0x01, 0xe2, 0x2e, 0x63, 0x13, 0xf0, 0x02, 0xb6, 0xa0,
-	0xe9, 0xdc, 0x74, 0xa2, 0x24, 0xf4, 0x04, 0x76, 0xd2, 0x0d, 0xc5, 0xcc, 0x72, 0x61, 0xa0, 0xb9,
-	0x94, 0x24, 0x73, 0x11, 0x1d, 0x5d, 0x04, 0x0c, 0xd3, 0x4b, 0xae, 0x96, 0x73, 0x26, 0x5a, 0x5f,
-	0xc2, 0x5e, 0x86, 0x33, 0xeb, 0xd2, 0x26, 0xa5, 0x96, 0x4f, 0x69, 0xfd, 0x0a, 0xc6, 0xc6, 0xf5,
-	0x2e, 0xb5, 0x3c, 0x82, 0x8a, 0x1c, 0x51, 0xc6, 0xc1, 0xe2, 0xf8, 0x94, 0x2d, 0x8f, 0xb5, 0x5c,
-	0xc4, 0xfa, 0x0c, 0xee, 0x61, 0x7a, 0x31, 0x4c, 0x82, 0x88, 0x05, 0x53, 0x1e, 0xc6, 0x11, 0x53,
-	0x84, 0x3a, 0x82, 0x2a, 0xbf, 0xec, 0xe6, 0x31, 0xaf, 0x65, 0xeb, 0x6b, 0xc9, 0x86, 0xfc, 0x47,
-	0xb7, 0xd5, 0xf9, 0x47, 0x3a, 0xbb, 0xe2, 0x27, 0x1f, 0x73, 0x76, 0x9f, 0x42, 0x99, 0x5f, 0x66,
-	0x73, 0xab, 0xa9, 0x08, 0xc3, 0x4b, 0x22, 0xb4, 0x1f, 0x18, 0x55, 0x07, 0xf6, 0x3b, 0x94, 0x9f,
-	0x86, 0x8c, 0x85, 0xd1, 0xfc, 0x96, 0x22, 0x44, 0x4b, 0x18, 0x8f, 0x97, 0x8b, 0xcd, 0x22, 0x5f,
-	0xcb, 0xd6, 0x13, 0x40, 0x1d, 0xca, 0xed, 0x68, 0x4a, 0x19, 0x8f, 0x93, 0xdb, 0xda, 0xf1, 0xbb,
-	0x06, 0x07, 0x05, 0xf7, 0xbb, 0xb4, 0xc2, 0x02, 0x3d, 0x50, 0x01, 0x72, 0x6f, 0x4b, 0x41, 0x27,
-	0xd6, 0x42, 0x26, 0xe3, 0x38, 0x7b, 0x5a, 0x36, 0x1a, 0xeb, 0x0b, 0xa8, 0x77, 0x28, 0x17, 0xae,
-	0x27, 0x57, 0x38, 0xce, 0x6f, 0x09, 0xad, 0xb8, 0x76, 0x7e, 0x94, 0x80, 0x33, 0xc7, 0xbb, 0x01,
-	0x2e, 0xac, 0xbc, 0xd2, 0xb5, 0x95, 0x67, 0x4d, 0xe4, 0x55, 0x48, 0x19, 0x96, 0xf5, 0xef, 0x08,
-	0xaa, 0xcb, 0x84, 0xbe, 0xc9, 0xed, 0xc8, 0xb5, 0x9c, 0x6e, 0x3c, 0xfa, 0x06, 0xaf, 0xce, 0x27,
-	0x34, 0xc9, 0x9e, 0xec, 0x8d, 0x66, 0xbd, 0x54, 0xd2, 0xa2, 0xe5, 0xd9, 0x4a, 0xe4, 0xb8, 0xb3,
-	0x1c, 0x1f, 0x93, 0x7f, 0xef, 0xbf, 0x61, 0x9f, 0xc0, 0xa1, 0x77, 0xed, 0xf9, 0x53, 0xe5, 0x89,
-	0xb5, 0x6a, 0xde, 0xb4, 0xdd, 0x05, 0xd6, 0x37, 0x50, 0xcf, 0xbd, 0xc5, 0xb2, 0x1b, 0x1f, 0x78,
-	0xb7, 0xf3, 0xbe, 0xd6, 0x08, 0xcc, 0x42, 0xfa, 0x88, 0x5e, 0xac, 0x5f, 0x95, 0xbb, 0x87, 0x7d,
-	0xfc, 0x57, 0x09, 0xf4, 0x3c, 0x54, 0x54, 0x81, 0x92, 0xff, 0xc2, 0xf8, 0x1f, 0xd2, 0xa1, 0xda,
-	0xb6, 0x71, 0xdb, 0xed, 0xb9, 0x8e, 0xa1, 0xa1, 0x3a, 0xec, 0x8c, 0xf0, 0x0b, 0xec, 0x7f, 0x8f,
-	0x8d, 0x12, 0xfa, 0x3f, 0x18, 0x1e, 0x7e, 0x69, 0xf7, 0x3c, 0x67, 0x6c, 0x93, 0xce, 0xe8, 0xd4,
-	0xc5, 0x43, 0xa3, 0x8c, 0xee, 0xc1, 0xbe, 0xe3, 0xda, 0x4e, 0xcf, 0xc3, 0xee, 0xd8, 0x7d, 0xd5,
-	0x76, 0x5d, 0xc7, 0x75, 0x8c, 0x2d, 0xd4, 0x80, 0x1a, 0xf6, 0x87, 0xe3, 0xe7, 0xfe, 0x08, 0x3b,
-	0xc6, 0x36, 0x42, 0xb0, 0x6b, 0xf7, 0x88, 0x6b, 0x3b, 0x3f, 0x8c, 0xdd, 0x57, 0xde, 0x60, 0x38,
-	0x30, 0x2a, 0xe2, 0xcb, 0xbe, 0x4b, 0x4e, 0xbd, 0xc1, 0xc0, 0xf3, 0xf1, 0xd8, 0x71, 0xb1, 0xe7,
-	0x3a, 0xc6, 0x0e, 0xba, 0x0f, 0x88, 0xb8, 0x03, 0x7f, 0x44, 0xda, 0x22, 0x60, 0xd7, 0x1e, 0x0d,
-	0x86, 0xae, 0x63, 0x54, 0xd1, 0x21, 0x1c, 0x3c, 0xb7, 0xbd, 0x9e, 0xeb, 0x8c, 0xfb, 0xc4, 0x6d,
-	0xfb, 0xd8, 0xf1, 0x86, 0x9e, 0x8f, 0x8d, 0x9a, 0x00, 0x69, 0x9f, 0xf8, 0x44, 0x78, 0x01, 0x32,
-	0x40, 0xf7, 0x47, 0xc3, 0xb1, 0xff, 0x7c, 0x4c, 0x6c, 0xdc, 0x71, 0x8d, 0x3a, 0xda, 0x87, 0xc6,
-	0x08, 0x7b, 0xa7, 0xfd, 0x9e, 0x2b, 0x10, 0xbb, 0x8e, 0xa1, 0x8b, 0x22, 0x3d, 0x3c, 0x74, 0x09,
-	0xb6, 0x7b, 0x46, 0x03, 0xed, 0x41, 0x7d, 0x84, 0xed, 0x97, 0xb6, 0xd7, 0xb3, 0x4f, 0x7a, 0xae,
-	0xb1, 0x2b, 0xb0, 0x3b, 0xf6, 0xd0, 0x1e, 0xf7, 0xfc, 0xc1, 0xc0, 0xd8, 0x43, 0x07, 0xb0, 0x37,
-	0xc2, 0xf6, 0x68, 0xd8, 0x75, 0xf1, 0xd0, 0x6b, 0xdb, 0x22, 0x84, 0x31, 0xa9, 0xc8, 0xff, 0xef,
-	0x67, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xcc, 0xa1, 0xfe, 0x94, 0x96, 0x0c, 0x00, 0x00,
+func init() { proto.RegisterFile("p2p.proto", fileDescriptor_p2p_0c19a8d2bd01fe02) }
+
+var fileDescriptor_p2p_0c19a8d2bd01fe02 = []byte{
+	// 1383 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdd, 0x6e, 0xdb, 0xc6,
+	0x12, 0x3e, 0x92, 0x6c, 0x59, 0x1a, 0x51, 0x36, 0xbd, 0x3a, 0x89, 0x79, 0x7c, 0x82, 0x1c, 0x81,
+	0x08, 0x4e, 0x55, 0x37, 0x08, 0x0a, 0x07, 0x28, 0x50, 0xf4, 0x8a, 0x16, 0x19, 0x89, 0x8d, 0x4c,
+	0x09, 0x2b, 0x2a, 0x4d, 0xaf, 0x54, 0x4a, 0xda, 0x48, 0x6c, 0x6d, 0x92, 0xe5, 0xae, 0x62, 0x39,
+	0x37, 0x05, 0x7a, 0xd1, 0x07, 0x28, 0xd0, 0x57, 0xe8, 0x63, 0xf4, 0x9d, 0xfa, 0x00, 0x05, 0x8a,
+	0x5d, 0x2e, 0x25, 0xd2, 0x4e, 0xe2, 0x56, 0xcd, 0xdd, 0xce, 0xec, 0xec, 0xec, 0xfc, 0x7c, 0xf3,
+	0x2d, 0x09, 0xd5, 0xe8, 0x34, 0x7a, 0x12, 0xc5, 0x21, 0x0b, 0xd1, 0x2e, 0xbb, 0x8e, 0x08, 0x3d,
+	0x56, 0x27, 0x17, 0xe1, 0xf4, 0xbb, 0xe9, 0xc2, 0xf3, 0x83, 0x64, 0xe3, 0x18, 0x82, 0x70, 0x46,
+	0x92, 0xb5, 0xfe, 0x47, 0x01, 0xaa, 0xe7, 0x74, 0xde, 0x25, 0xde, 0x8c, 0xc4, 0xe8, 0x11, 0xd4,
+	0xa7, 0x17, 0x3e, 0x09, 0xd8, 0x0b, 0x12, 0x53, 0x3f, 0x0c, 0xb4, 0x42, 0xb3, 0xd0, 0xaa, 0xe2,
+	0xbc, 0x12, 0x3d, 0x80, 0x2a, 0xf3, 0x2f, 0x09, 0x65, 0xde, 0x65, 0xa4, 0x15, 0x9b, 0x85, 0x56,
+	0x09, 0x6f, 0x14, 0x68, 0x1f, 0x8a, 0xfe, 0x4c, 0x2b, 0x89, 0x83, 0x45, 0x7f, 0x86, 0xee, 0x43,
+	0x79, 0x1e, 0x52, 0xea, 0x47, 0xda, 0x4e, 0xb3, 0xd0, 0xaa, 0x60, 0x29, 0x71, 0x7d, 0x44, 0x48,
+	0x6c, 0x9b, 0xda, 0x6e, 0xb3, 0xd0, 0x52, 0xb0, 0x94, 0xd0, 0x43, 0x10, 0xf1, 0x0d, 0x96, 0x93,
+	0xe7, 0xe4, 0x5a, 0x2b, 0x8b, 0xbd, 0x8c, 0x06, 0x21, 0xd8, 0xa1, 0xfe, 0x3c, 0xd0, 0xf6, 0xc4,
+	0x8e, 0x58, 0xa3, 0x26, 0xd4, 0xe8, 0x72, 0x22, 0x32, 0x9a, 0x86, 0x17, 0x5a, 0xa5, 0x59, 0x68,
+	0xd5, 0x71, 0x56, 0xc5, 0x6f, 0xbb, 0x20, 0xc1, 0x9c, 0x2d, 0xb4, 0xaa, 0xd8, 0x94, 0x92, 0xfe,
+	0x25, 0xc0, 0xe0, 0x74, 0x70, 0x4e, 0x28, 0xf5, 0xe6, 0x04, 0xb5, 0xa0, 0xbc, 0x10, 0x95, 0x10,
+	0x89, 0xd7, 0x4e, 0xd5, 0x27, 0xa2, 0x86, 0x4f, 0xd6, 0x15, 0xc2, 0x72, 0x9f, 0x47, 0x31, 0xf3,
+	0x98, 0x27, 0xd2, 0x57, 0xb0, 0x58, 0xeb, 0x7d, 0xd8, 0x19, 0xf8, 0xc1, 0x1c, 0xfd, 0x1f, 0x0e,
+	0x26, 0x84, 0xb2, 0xb1, 0x28, 0xfc, 0x78, 0xe1, 0xd1, 0x85, 0x70, 0xa7, 0xe0, 0x3a, 0x57, 0x9f,
+	0x71, 0x6d, 0xd7, 0xa3, 0x0b, 0xf4, 0x3f, 0xa8, 0x09, 0xbb, 0x05, 0xf1, 0xe7, 0x0b, 0x26, 0x5c,
+	0xed, 0x60, 0xe0, 0xaa, 0xae, 0xd0, 0xe8, 0x3d, 0xd8, 0x19, 0x84, 0xc1, 0x9c, 0xb7, 0x25, 0x77,
+	0xf2, 0xed, 0xee, 0x1e, 0x42, 0xe6, 0xec, 0x5b, 0xbc, 0xfd, 0x5e, 0x84, 0xf2, 0x90, 0x79, 0x6c,
+	0x49, 0xd1, 0x09, 0x94, 0x29, 0x09, 0x36, 0x79, 0x22, 0x99, 0xe7, 0x80, 0x90, 0xd8, 0x98, 0xcd,
+	0x62, 0x42, 0x29, 0x96, 0x16, 0xb7, 0x2f, 0x2f, 0xde, 0x7d, 0x79, 0xe9, 0xe6, 0xe5, 0x48, 0x83,
+	0x3d, 0x01, 0x41, 0xdb, 0x14, 0x30, 0x50, 0x70, 0x2a, 0xa2, 0x63, 0xa8, 0x04, 0xa1, 0xb5, 0x8a,
+	0x42, 0x4a, 0x04, 0x12, 0x2a, 0x78, 0x2d, 0xf3, 0x53, 0xaf, 0x25, 0x12, 0xcb, 0x02, 0x50, 0xa9,
+	0xc8, 0x77, 0xe6, 0x24, 0x20, 0xd4, 0xa7, 0x12, 0x08, 0xa9, 0x88, 0xbe, 0x00, 0x65, 0x4a, 0x62,
+	0xe6, 0xbf, 0xf2, 0xa7, 0x1e, 0x23, 0x54, 0xab, 0x34, 0x4b, 0xad, 0xda, 0xe9, 0x91, 0xcc, 0xd0,
+	0x98, 0x93, 0x80, 0xb5, 0x37, 0xfb, 0x38, 0x67, 0x8c, 0x4e, 0x40, 0xf5, 0x29, 0x5d, 0x92, 0x8c,
+	0x85, 0x00, 0x4c, 0x05, 0xdf, 0xd2, 0x23, 0x1d, 0x94, 0x69, 0x78, 0x19, 0xf1, 0x62, 0xf9, 0x61,
+	0x40, 0x35, 0x68, 0x96, 0x5a, 0x55, 0x9c, 0xd3, 0xe9, 0x2d, 0x50, 0x3a, 0xa1, 0x71, 0xe5, 0x5d,
+	0x3b, 0x21, 0xf3, 0xa7, 0x22, 0xa1, 0xcb, 0x04, 0x6b, 0x72, 0xb4, 0x52, 0x51, 0x7f, 0x09, 0xaa,
+	0xac, 0x3c, 0xa1, 0x98, 0x7c, 0xbf, 0x24, 0x94, 0xfd, 0xad, 0x36, 0x71, 0xcf, 0xde, 0x6a, 0xe8,
+	0xbf, 0x21, 0xa2, 0x41, 0x75, 0x9c, 0x8a, 0xfa, 0xb7, 0x70, 0x98, 0xf1, 0x4c, 0xa3, 0x30, 0xa0,
+	0x04, 0x7d, 0x02, 0x65, 0x2a, 0xb0, 0x20, 0x5c, 0xef, 0x9f, 0x36, 0xa4, 0x6b, 0x4c, 0xe8, 0xf2,
+	0x82, 0x25, 0x30, 0xc1, 0xd2, 0x04, 0xb5, 0x60, 0x97, 0x0f, 0x27, 0xd5, 0x8a, 0xcd, 0xd2, 0x3b,
+	0xc2, 0x48, 0x0c, 0xf4, 0x2e, 0xec, 0x3b, 0xe4, 0x4a, 0xc0, 0x42, 0x66, 0xfc, 0x00, 0xaa, 0x93,
+	0x1b, 0xb8, 0xdd, 0x28, 0x78, 0xd4, 0x93, 0xc4, 0x58, 0x02, 0x36, 0x15, 0x75, 0x0a, 0x0d, 0xe1,
+	0x66, 0x10, 0x87, 0xb3, 0xe5, 0x94, 0xcc, 0xa4, 0xbb, 0x87, 0x00, 0x51, 0xa2, 0xe1, 0xcc, 0x91,
+	0xf8, 0xcb, 0x68, 0xde, 0xed, 0x10, 0xe9, 0xb0, 0x2b, 0x96, 0x02, 0x9c, 0xb5, 0x53, 0x45, 0x26,
+	0x21, 0x2e, 0xc1, 0xc9, 0x96, 0xfe, 0x63, 0x01, 0xee, 0x77, 0x88, 0x84, 0xb5, 0x18, 0xf4, 0x75,
+	0x2f, 0x10, 0xec, 0x64, 0x26, 0x59, 0xac, 0x39, 0xa9, 0xe4, 0x66, 0x57, 0x4a, 0x5c, 0x1f, 0xbe,
+	0x7a, 0x45, 0x49, 0x3a, 0x08, 0x52, 0x4a, 0xa8, 0xeb, 0x0d, 0x11, 0x13, 0x50, 0xc7, 0x62, 0x8d,
+	0x54, 0x28, 0x79, 0x74, 0x2a, 0x91, 0xcf, 0x97, 0xfa, 0xaf, 0x05, 0x38, 0xba, 0x15, 0xc4, 0x36,
+	0x6d, 0xe3, 0xe1, 0x79, 0x74, 0x41, 0x92, 0xbe, 0x29, 0x58, 0x4a, 0xe8, 0x31, 0xec, 0x25, 0x2c,
+	0x46, 0xb5, 0x52, 0xae, 0xa1, 0x99, 0x2b, 0x71, 0x6a, 0xc2, 0x2b, 0xba, 0xf0, 0xa8, 0x43, 0x56,
+	0x4c, 0x12, 0x78, 0x2a, 0xea, 0x1f, 0xc3, 0x41, 0x1a, 0x67, 0x5a, 0xa5, 0xcd, 0x95, 0x85, 0xec,
+	0x95, 0xfa, 0x0f, 0xa0, 0x6e, 0x4c, 0xb7, 0xc9, 0xe5, 0x11, 0x94, 0x45, 0x8b, 0x52, 0x0c, 0xe6,
+	0xdb, 0x27, 0xf7, 0xb2, 0xb1, 0x96, 0xf2, 0xb1, 0x3e, 0x85, 0x7b, 0x0e, 0xb9, 0x72, 0x63, 0x2f,
+	0xa0, 0xde, 0x94, 0xf1, 0xd9, 0x94, 0x80, 0x3a, 0x86, 0x0a, 0x5b, 0x75, 0xb3, 0x31, 0xaf, 0x65,
+	0xfd, 0x53, 0x81, 0x86, 0xec, 0xa1, 0xbb, 0xf2, 0xfc, 0x25, 0xe9, 0x5d, 0xfe, 0xc8, 0x87, 0xec,
+	0xdd, 0x7f, 0xa1, 0xc4, 0x56, 0x69, 0xdf, 0xaa, 0xd2, 0x83, 0xbb, 0xc2, 0x5c, 0xfb, 0x9e, 0x56,
+	0x75, 0xe0, 0xb0, 0x43, 0xd8, 0xb9, 0x4f, 0xa9, 0x1f, 0xcc, 0xef, 0x48, 0x82, 0x97, 0x84, 0xb2,
+	0x30, 0x5a, 0x6c, 0xc8, 0x7e, 0x2d, 0xeb, 0x8f, 0x01, 0x75, 0x08, 0x33, 0x82, 0x29, 0xa1, 0x2c,
+	0x8c, 0xef, 0x2a, 0xc7, 0x4f, 0x05, 0x68, 0xe4, 0xcc, 0xb7, 0x29, 0x85, 0x0e, 0x8a, 0x27, 0x1d,
+	0x64, 0xde, 0x9f, 0x9c, 0x8e, 0xd3, 0x42, 0x2a, 0x3b, 0x61, 0xfa, 0xfc, 0x6c, 0x34, 0xfa, 0x47,
+	0x50, 0xeb, 0x10, 0xc6, 0x4d, 0xcf, 0xae, 0x9d, 0x30, 0xcb, 0x12, 0x85, 0x3c, 0xed, 0x7c, 0x03,
+	0x8d, 0x8c, 0xe1, 0x76, 0x01, 0xe7, 0x28, 0xaf, 0x78, 0x83, 0xf2, 0xf4, 0x89, 0x18, 0x85, 0x04,
+	0x61, 0x69, 0xfd, 0x8e, 0xa1, 0x12, 0xc5, 0xe4, 0x75, 0x86, 0x23, 0xd7, 0x72, 0xc2, 0x78, 0xe4,
+	0xb5, 0xb3, 0xbc, 0x9c, 0x90, 0x38, 0x7d, 0xd6, 0x37, 0x9a, 0x35, 0xa9, 0x24, 0x49, 0x8b, 0xb5,
+	0x1e, 0x8b, 0x76, 0xa7, 0x77, 0x7c, 0x48, 0xfc, 0xbd, 0x7b, 0xc2, 0xfe, 0x03, 0x47, 0xf6, 0x8d,
+	0x27, 0x52, 0xa6, 0xc7, 0x69, 0x55, 0xbb, 0xbd, 0xb7, 0x4d, 0x58, 0x9f, 0x43, 0x2d, 0xf3, 0x5e,
+	0x8b, 0x6a, 0xbc, 0xe7, 0x6d, 0xcf, 0xda, 0xea, 0x23, 0xd0, 0x72, 0xd7, 0x07, 0xe4, 0x6a, 0xfd,
+	0xaa, 0xfc, 0x03, 0xb7, 0x9f, 0x09, 0x8e, 0x68, 0x87, 0x97, 0x91, 0x37, 0xcd, 0x73, 0xe1, 0x7b,
+	0x5f, 0x3e, 0xfd, 0xe7, 0x84, 0x29, 0xf2, 0x07, 0xb7, 0x29, 0xc9, 0xc9, 0xfa, 0x9b, 0xb5, 0x98,
+	0xfb, 0x48, 0xc8, 0x92, 0xb9, 0xb4, 0xe0, 0x58, 0xa2, 0x8b, 0x30, 0x66, 0xee, 0xca, 0x36, 0x13,
+	0x12, 0x51, 0x70, 0x46, 0xa3, 0xf7, 0xc4, 0x74, 0x8b, 0x93, 0xee, 0x8a, 0xfe, 0xa5, 0x44, 0x38,
+	0x22, 0xfc, 0x60, 0x46, 0x56, 0x12, 0x2a, 0x75, 0x9c, 0x8a, 0xfa, 0x18, 0x1a, 0x39, 0x6f, 0xdb,
+	0x64, 0x27, 0xf9, 0xae, 0xf8, 0x36, 0xbe, 0x3b, 0xf9, 0xad, 0x08, 0x4a, 0xf6, 0x14, 0x2a, 0x43,
+	0xb1, 0xff, 0x5c, 0xfd, 0x17, 0x52, 0xa0, 0xd2, 0x36, 0x9c, 0xb6, 0xd5, 0xb3, 0x4c, 0xb5, 0x80,
+	0x6a, 0xb0, 0x37, 0x72, 0x9e, 0x3b, 0xfd, 0xaf, 0x1c, 0xb5, 0x88, 0xfe, 0x0d, 0xaa, 0xed, 0xbc,
+	0x30, 0x7a, 0xb6, 0x39, 0x36, 0x70, 0x67, 0x74, 0x6e, 0x39, 0xae, 0x5a, 0x42, 0xf7, 0xe0, 0xd0,
+	0xb4, 0x0c, 0xb3, 0x67, 0x3b, 0xd6, 0xd8, 0x7a, 0xd9, 0xb6, 0x2c, 0xd3, 0x32, 0xd5, 0x1d, 0x54,
+	0x87, 0xaa, 0xd3, 0x77, 0xc7, 0xcf, 0xfa, 0x23, 0xc7, 0x54, 0x77, 0x11, 0x82, 0x7d, 0xa3, 0x87,
+	0x2d, 0xc3, 0xfc, 0x7a, 0x6c, 0xbd, 0xb4, 0x87, 0xee, 0x50, 0x2d, 0xf3, 0x93, 0x03, 0x0b, 0x9f,
+	0xdb, 0xc3, 0xa1, 0xdd, 0x77, 0xc6, 0xa6, 0xe5, 0xd8, 0x96, 0xa9, 0xee, 0xa1, 0xfb, 0x80, 0xb0,
+	0x35, 0xec, 0x8f, 0x70, 0x9b, 0x3b, 0xec, 0x1a, 0xa3, 0xa1, 0x6b, 0x99, 0x6a, 0x05, 0x1d, 0x41,
+	0xe3, 0x99, 0x61, 0xf7, 0x2c, 0x73, 0x3c, 0xc0, 0x56, 0xbb, 0xef, 0x98, 0xb6, 0x6b, 0xf7, 0x1d,
+	0xb5, 0xca, 0x83, 0x34, 0xce, 0xfa, 0x98, 0x5b, 0x01, 0x52, 0x41, 0xe9, 0x8f, 0xdc, 0x71, 0xff,
+	0xd9, 0x18, 0x1b, 0x4e, 0xc7, 0x52, 0x6b, 0xe8, 0x10, 0xea, 0x23, 0xc7, 0x3e, 0x1f, 0xf4, 0x2c,
+	0x1e, 0xb1, 0x65, 0xaa, 0x0a, 0x4f, 0xd2, 0x76, 0x5c, 0x0b, 0x3b, 0x46, 0x4f, 0xad, 0xa3, 0x03,
+	0xa8, 0x8d, 0x1c, 0xe3, 0x85, 0x61, 0xf7, 0x8c, 0xb3, 0x9e, 0xa5, 0xee, 0xf3, 0xd8, 0x4d, 0xc3,
+	0x35, 0xc6, 0xbd, 0xfe, 0x70, 0xa8, 0x1e, 0xa0, 0x06, 0x1c, 0x8c, 0x1c, 0x63, 0xe4, 0x76, 0x2d,
+	0xc7, 0xb5, 0xdb, 0x06, 0x77, 0xa1, 0x4e, 0xca, 0xe2, 0xff, 0xe8, 0xe9, 0x9f, 0x03, 0x00, 0xe8,
+	0xb1, 0xfe, 0x51, 0x36, 0x0e, 0x00, 0x00,
 }
@@ -172,6 +172,22 @@ func (m *GetAncestorResponse) MarshalZerologObject(e *zerolog.Event) {
 	e.Str(LogRespStatus, m.Status.String()).Str(LogBlkHash, enc.ToString(m.AncestorHash)).Uint64(LogBlkNo, m.AncestorNo)
 }
 
+func (m *GetCompactBlockRequest) MarshalZerologObject(e *zerolog.Event) {
+	e.Str(LogBlkHash, enc.ToString(m.BlockHash))
+}
+
+func (m *GetCompactBlockResponse) MarshalZerologObject(e *zerolog.Event) {
+	e.Str(LogRespStatus, m.Status.String()).Uint64(LogBlkNo, m.Header.GetBlockNo()).Int("count", len(m.ShortTxIDs))
+}
+
+func (m *GetBlockTxsRequest) MarshalZerologObject(e *zerolog.Event) {
+	e.Str(LogBlkHash, enc.ToString(m.BlockHash)).Int("count", len(m.Indexes))
+}
+
+func (m *GetBlockTxsResponse) MarshalZerologObject(e *zerolog.Event) {
+	e.Str(LogRespStatus, m.Status.String()).Int("count", len(m.Txs))
+}
+
 func (m *GetClusterInfoRequest) MarshalZerologObject(e *zerolog.Event) {
 	e.Str("best_hash", enc.ToString(m.BestBlockHash))
 }
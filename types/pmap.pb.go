@@ -20,10 +20,16 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
 // query to polaris
 type MapQuery struct {
-	Status               *Status  `protobuf:"bytes,1,opt,name=status" json:"status,omitempty"`
-	AddMe                bool     `protobuf:"varint,2,opt,name=addMe" json:"addMe,omitempty"`
-	Size                 int32    `protobuf:"varint,3,opt,name=size" json:"size,omitempty"`
-	Excludes             [][]byte `protobuf:"bytes,4,rep,name=excludes,proto3" json:"excludes,omitempty"`
+	Status   *Status  `protobuf:"bytes,1,opt,name=status" json:"status,omitempty"`
+	AddMe    bool     `protobuf:"varint,2,opt,name=addMe" json:"addMe,omitempty"`
+	Size     int32    `protobuf:"varint,3,opt,name=size" json:"size,omitempty"`
+	Excludes [][]byte `protobuf:"bytes,4,rep,name=excludes,proto3" json:"excludes,omitempty"`
+	// roles of peers to return. all roles are returned if empty
+	Roles []PeerRole `protobuf:"varint,5,rep,packed,name=roles,enum=types.PeerRole" json:"roles,omitempty"`
+	// minimum aergo version of peers to return. no filtering if empty
+	MinVersion string `protobuf:"bytes,6,opt,name=minVersion" json:"minVersion,omitempty"`
+	// peers whose best block is behind the median best block of peers checked by polaris more than this are not returned. no filtering if 0
+	MaxBlockLag          uint64   `protobuf:"varint,7,opt,name=maxBlockLag" json:"maxBlockLag,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -33,7 +39,7 @@ func (m *MapQuery) Reset()         { *m = MapQuery{} }
 func (m *MapQuery) String() string { return proto.CompactTextString(m) }
 func (*MapQuery) ProtoMessage()    {}
 func (*MapQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pmap_34fd0f2e9b6a5bb5, []int{0}
+	return fileDescriptor_pmap_8af972fa5ab03081, []int{0}
 }
 func (m *MapQuery) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MapQuery.Unmarshal(m, b)
@@ -81,6 +87,27 @@ func (m *MapQuery) GetExcludes() [][]byte {
 	return nil
 }
 
+func (m *MapQuery) GetRoles() []PeerRole {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *MapQuery) GetMinVersion() string {
+	if m != nil {
+		return m.MinVersion
+	}
+	return ""
+}
+
+func (m *MapQuery) GetMaxBlockLag() uint64 {
+	if m != nil {
+		return m.MaxBlockLag
+	}
+	return 0
+}
+
 type MapResponse struct {
 	Status               ResultStatus   `protobuf:"varint,1,opt,name=status,enum=types.ResultStatus" json:"status,omitempty"`
 	Addresses            []*PeerAddress `protobuf:"bytes,2,rep,name=addresses" json:"addresses,omitempty"`
@@ -94,7 +121,7 @@ func (m *MapResponse) Reset()         { *m = MapResponse{} }
 func (m *MapResponse) String() string { return proto.CompactTextString(m) }
 func (*MapResponse) ProtoMessage()    {}
 func (*MapResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pmap_34fd0f2e9b6a5bb5, []int{1}
+	return fileDescriptor_pmap_8af972fa5ab03081, []int{1}
 }
 func (m *MapResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MapResponse.Unmarshal(m, b)
@@ -140,23 +167,26 @@ func init() {
 	proto.RegisterType((*MapResponse)(nil), "types.MapResponse")
 }
 
-func init() { proto.RegisterFile("pmap.proto", fileDescriptor_pmap_34fd0f2e9b6a5bb5) }
-
-var fileDescriptor_pmap_34fd0f2e9b6a5bb5 = []byte{
-	// 228 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0x51, 0x4a, 0xc4, 0x40,
-	0x10, 0x44, 0x99, 0xcd, 0x66, 0x4d, 0x3a, 0xea, 0x47, 0xeb, 0xc7, 0x90, 0xaf, 0x61, 0x41, 0x18,
-	0x10, 0x82, 0xc4, 0x13, 0x78, 0x80, 0x80, 0xb6, 0x27, 0x18, 0x9d, 0x46, 0x84, 0xdd, 0x4d, 0x93,
-	0x9e, 0xa0, 0xeb, 0x01, 0x3c, 0xb7, 0x90, 0x44, 0x45, 0xff, 0xa6, 0xea, 0xd5, 0x50, 0x45, 0x03,
-	0xc8, 0x3e, 0x48, 0x23, 0x43, 0x9f, 0x7a, 0xcc, 0xd3, 0x51, 0x58, 0x6b, 0x38, 0xf4, 0x91, 0x67,
-	0xab, 0x2e, 0xa5, 0x5d, 0xe8, 0xf6, 0x0d, 0x8a, 0x2e, 0xc8, 0xc3, 0xc8, 0xc3, 0x11, 0xaf, 0x60,
-	0xa3, 0x29, 0xa4, 0x51, 0xad, 0x71, 0xc6, 0x57, 0xed, 0x59, 0x33, 0x7d, 0x6d, 0x1e, 0x27, 0x93,
-	0x16, 0x88, 0x97, 0x90, 0x87, 0x18, 0x3b, 0xb6, 0x2b, 0x67, 0x7c, 0x41, 0xb3, 0x40, 0x84, 0xb5,
-	0xbe, 0x7e, 0xb0, 0xcd, 0x9c, 0xf1, 0x39, 0x4d, 0x6f, 0xac, 0xa1, 0xe0, 0xf7, 0xe7, 0xdd, 0x18,
-	0x59, 0xed, 0xda, 0x65, 0xfe, 0x94, 0x7e, 0xf4, 0xf6, 0xd3, 0x40, 0xd5, 0x05, 0x21, 0x56, 0xe9,
-	0x0f, 0xca, 0x78, 0xfd, 0xa7, 0xfc, 0xbc, 0xbd, 0x58, 0xca, 0x89, 0x75, 0xdc, 0xa5, 0x7f, 0x13,
-	0x6e, 0xa0, 0x0c, 0x31, 0x0e, 0xac, 0xca, 0x6a, 0x57, 0x2e, 0xf3, 0x55, 0x8b, 0x4b, 0xfe, 0x9e,
-	0x79, 0xb8, 0x9b, 0x19, 0xfd, 0x86, 0xd0, 0xc2, 0xc9, 0x9e, 0x55, 0xc3, 0xcb, 0xbc, 0xb0, 0xa4,
-	0x6f, 0xf9, 0xb4, 0x99, 0x0e, 0x71, 0xfb, 0x15, 0x00, 0x00, 0xff, 0xff, 0x62, 0x00, 0xba, 0x72,
-	0x34, 0x01, 0x00, 0x00,
+func init() { proto.RegisterFile("pmap.proto", fileDescriptor_pmap_8af972fa5ab03081) }
+
+var fileDescriptor_pmap_8af972fa5ab03081 = []byte{
+	// 285 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xd1, 0x4a, 0xeb, 0x40,
+	0x10, 0x86, 0xd9, 0xa6, 0x69, 0x9b, 0xc9, 0x39, 0x15, 0x46, 0x2f, 0x96, 0x5e, 0xc8, 0x52, 0x28,
+	0x2c, 0x08, 0x45, 0xe2, 0x13, 0xe8, 0xb5, 0x05, 0x1d, 0xc1, 0xfb, 0xb5, 0x19, 0x4a, 0x31, 0xc9,
+	0x2e, 0x99, 0x04, 0x5a, 0x1f, 0xc0, 0xe7, 0xf4, 0x51, 0x84, 0x24, 0x6a, 0xf5, 0x6e, 0xff, 0xef,
+	0xdb, 0x65, 0x76, 0x7e, 0x80, 0x50, 0xba, 0xb0, 0x0e, 0xb5, 0x6f, 0x3c, 0xc6, 0xcd, 0x31, 0xb0,
+	0x2c, 0xa0, 0xf2, 0x39, 0xf7, 0x68, 0x91, 0x84, 0x6c, 0xb0, 0xcb, 0x0f, 0x05, 0xb3, 0x8d, 0x0b,
+	0x8f, 0x2d, 0xd7, 0x47, 0x5c, 0xc1, 0x44, 0x1a, 0xd7, 0xb4, 0xa2, 0x95, 0x51, 0x36, 0xcd, 0xfe,
+	0xaf, 0xbb, 0xb7, 0xeb, 0xa7, 0x0e, 0xd2, 0x20, 0xf1, 0x02, 0x62, 0x97, 0xe7, 0x1b, 0xd6, 0x23,
+	0xa3, 0xec, 0x8c, 0xfa, 0x80, 0x08, 0x63, 0xd9, 0xbf, 0xb1, 0x8e, 0x8c, 0xb2, 0x31, 0x75, 0x67,
+	0x5c, 0xc0, 0x8c, 0x0f, 0xdb, 0xa2, 0xcd, 0x59, 0xf4, 0xd8, 0x44, 0xf6, 0x1f, 0x7d, 0x67, 0x5c,
+	0x41, 0x5c, 0xfb, 0x82, 0x45, 0xc7, 0x26, 0xb2, 0xf3, 0xec, 0x6c, 0x98, 0xf5, 0xc0, 0x5c, 0x93,
+	0x2f, 0x98, 0x7a, 0x8b, 0x97, 0x00, 0xe5, 0xbe, 0x7a, 0xe6, 0x5a, 0xf6, 0xbe, 0xd2, 0x13, 0xa3,
+	0x6c, 0x42, 0x27, 0x04, 0x0d, 0xa4, 0xa5, 0x3b, 0xdc, 0x15, 0x7e, 0xfb, 0x7a, 0xef, 0x76, 0x7a,
+	0x6a, 0x94, 0x1d, 0xd3, 0x29, 0x5a, 0xbe, 0x2b, 0x48, 0x37, 0x2e, 0x10, 0x4b, 0xf0, 0x95, 0x30,
+	0x5e, 0xfd, 0xda, 0x72, 0x9e, 0x9d, 0x0f, 0x93, 0x89, 0xa5, 0x2d, 0x9a, 0x3f, 0xbb, 0x5e, 0x43,
+	0xe2, 0xf2, 0xbc, 0x66, 0x11, 0x16, 0x3d, 0x32, 0x91, 0x4d, 0x33, 0x3c, 0xf9, 0xe9, 0x6d, 0xef,
+	0xe8, 0xe7, 0x12, 0x6a, 0x98, 0x96, 0x2c, 0xe2, 0x76, 0x7d, 0x15, 0x09, 0x7d, 0xc5, 0x97, 0x49,
+	0x57, 0xf9, 0xcd, 0xe7, 0x00, 0xe6, 0x86, 0x68, 0xd2, 0x9e, 0x01, 0x00, 0x00,
 }
@@ -24,6 +24,16 @@ const (
 	recreatedStatus
 )
 
+const (
+	feeDelegationFlag = 1 << iota
+	revertFlag
+	indexedEventFlag
+)
+
+// MaxIndexedEventArgs is the maximum number of the indexed arguments of an
+// event.
+const MaxIndexedEventArgs = 3
+
 func NewReceipt(contractAddress []byte, status string, jsonRet string) *Receipt {
 	return &Receipt{
 		ContractAddress: contractAddress[:33],
@@ -108,10 +118,26 @@ func (r *Receipt) marshalBodyV2(b *bytes.Buffer, isMerkle bool) error {
 	binary.LittleEndian.PutUint64(l[:], r.GasUsed)
 	b.Write(l)
 
+	// the revert reason is not a part of the receipt merkle like the error message
+	var flags byte
 	if r.FeeDelegation {
-		b.WriteByte(1)
-	} else {
-		b.WriteByte(0)
+		flags |= feeDelegationFlag
+	}
+	hasRevert := !isMerkle && r.HasRevert()
+	if hasRevert {
+		flags |= revertFlag
+	}
+	// the hashes of the indexed arguments are derived from the event arguments
+	if !isMerkle && r.hasIndexedEvents() {
+		flags |= indexedEventFlag
+	}
+	b.WriteByte(flags)
+	if hasRevert {
+		for _, v := range []string{r.RevertCode, r.RevertMessage, r.RevertData} {
+			binary.LittleEndian.PutUint32(l[:4], uint32(len(v)))
+			b.Write(l[:4])
+			b.WriteString(v)
+		}
 	}
 	if len(r.Bloom) == 0 {
 		b.WriteByte(0)
@@ -150,12 +176,16 @@ func (r *Receipt) marshalStoreBinaryV2() ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+	hasIndexed := r.hasIndexedEvents()
 	for _, ev := range r.Events {
 		evB, err := ev.marshalStoreBinary(r)
 		if err != nil {
 			return nil, err
 		}
 		b.Write(evB)
+		if hasIndexed {
+			ev.marshalIndexed(&b)
+		}
 	}
 
 	return b.Bytes(), nil
@@ -201,7 +231,7 @@ func (r *Receipt) unmarshalBody(data []byte) ([]byte, uint32) {
 	return data[pos+4:], evCount
 }
 
-func (r *Receipt) unmarshalBodyV2(data []byte) ([]byte, uint32) {
+func (r *Receipt) unmarshalBodyV2(data []byte) ([]byte, uint32, bool) {
 	r.ContractAddress = data[:33]
 	status := data[33]
 	switch status {
@@ -232,10 +262,21 @@ func (r *Receipt) unmarshalBodyV2(data []byte) ([]byte, uint32) {
 	ll := binary.LittleEndian.Uint64(data[pos:])
 	r.GasUsed = ll
 	pos += 8
-	if data[pos] == 1 {
+	flags := data[pos]
+	if flags&feeDelegationFlag != 0 {
 		r.FeeDelegation = true
 	}
 	pos += 1
+	if flags&revertFlag != 0 {
+		var fields [3]string
+		for i := range fields {
+			vl := binary.LittleEndian.Uint32(data[pos:])
+			pos += 4
+			fields[i] = string(data[pos : pos+vl])
+			pos += vl
+		}
+		r.RevertCode, r.RevertMessage, r.RevertData = fields[0], fields[1], fields[2]
+	}
 	bloomCheck := data[pos]
 	pos += 1
 	if bloomCheck == 1 {
@@ -245,7 +286,7 @@ func (r *Receipt) unmarshalBodyV2(data []byte) ([]byte, uint32) {
 	pos += l
 	evCount := binary.LittleEndian.Uint32(data[pos:])
 
-	return data[pos+4:], evCount
+	return data[pos+4:], evCount, flags&indexedEventFlag != 0
 }
 
 func (r *Receipt) unmarshalStoreBinary(data []byte) ([]byte, error) {
@@ -265,7 +306,7 @@ func (r *Receipt) unmarshalStoreBinary(data []byte) ([]byte, error) {
 }
 
 func (r *Receipt) unmarshalStoreBinaryV2(data []byte) ([]byte, error) {
-	evData, evCount := r.unmarshalBodyV2(data)
+	evData, evCount, hasIndexed := r.unmarshalBodyV2(data)
 
 	r.Events = make([]*Event, evCount)
 	var err error
@@ -275,6 +316,9 @@ func (r *Receipt) unmarshalStoreBinaryV2(data []byte) ([]byte, error) {
 		if err != nil {
 			return nil, err
 		}
+		if hasIndexed {
+			evData = ev.unmarshalIndexed(evData)
+		}
 		r.Events[i] = &ev
 	}
 	return evData, nil
@@ -367,6 +411,19 @@ func (r *Receipt) MarshalJSON() ([]byte, error) {
 		b.WriteString(`","ret": `)
 		b.WriteString(r.Ret)
 	}
+	if r.HasRevert() {
+		code, _ := json.Marshal(r.RevertCode)
+		msg, _ := json.Marshal(r.RevertMessage)
+		b.WriteString(`,"revert":{"code":`)
+		b.Write(code)
+		b.WriteString(`,"message":`)
+		b.Write(msg)
+		if len(r.RevertData) != 0 {
+			b.WriteString(`,"data":`)
+			b.WriteString(r.RevertData)
+		}
+		b.WriteString(`}`)
+	}
 	b.WriteString(`,"txHash":"`)
 	b.WriteString(enc.ToString(r.TxHash))
 	b.WriteString(`","txIndex":`)
@@ -400,6 +457,20 @@ func (r *Receipt) MarshalJSON() ([]byte, error) {
 	return b.Bytes(), nil
 }
 
+func (r *Receipt) hasIndexedEvents() bool {
+	for _, ev := range r.Events {
+		if len(ev.Indexed) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// HasRevert reports whether the contract failed with a structured revert reason.
+func (r *Receipt) HasRevert() bool {
+	return len(r.RevertCode) != 0 || len(r.RevertMessage) != 0 || len(r.RevertData) != 0
+}
+
 func (r *Receipt) GetHash() []byte {
 	h := sha256.New()
 	b, _ := r.MarshalMerkleBinary()
@@ -407,7 +478,7 @@ func (r *Receipt) GetHash() []byte {
 	return h.Sum(nil)
 }
 
-func (r *Receipt) BloomFilter(fi *FilterInfo) bool {
+func (r *Receipt) BloomFilter(fi *FilterInfo, indexed []IndexedFilter) bool {
 	if r.Bloom == nil {
 		return false
 	}
@@ -427,11 +498,21 @@ func (r *Receipt) BloomFilter(fi *FilterInfo) bool {
 	if err != nil {
 		return true
 	}
+	return bloomTest(&bf, fi, indexed)
+}
 
-	if bf.Test(fi.ContractAddress) || bf.Test([]byte(fi.EventName)) {
-		return true
+// bloomTest reports whether bf may include the events matched by fi and
+// all of the indexed arguments.
+func bloomTest(bf *bloom.BloomFilter, fi *FilterInfo, indexed []IndexedFilter) bool {
+	if !bf.Test(fi.ContractAddress) && !bf.Test([]byte(fi.EventName)) {
+		return false
 	}
-	return false
+	for _, f := range indexed {
+		if !bf.Test(f.hash) {
+			return false
+		}
+	}
+	return true
 }
 
 func (r *Receipt) SetMemoryInfo(blkHash []byte, blkNo BlockNo, txIdx int32) {
@@ -506,15 +587,11 @@ func (rs *Receipts) MergeBloom(bf *bloom.BloomFilter) error {
 	return (*bloom.BloomFilter)(rs.bloom).Merge(bf)
 }
 
-func (rs *Receipts) BloomFilter(fi *FilterInfo) bool {
+func (rs *Receipts) BloomFilter(fi *FilterInfo, indexed []IndexedFilter) bool {
 	if rs.bloom == nil {
 		return false
 	}
-	bf := (*bloom.BloomFilter)(rs.bloom)
-	if bf.Test(fi.ContractAddress) || bf.Test([]byte(fi.EventName)) {
-		return true
-	}
-	return false
+	return bloomTest((*bloom.BloomFilter)(rs.bloom), fi, indexed)
 }
 
 func (rs *Receipts) MerkleRoot() []byte {
@@ -660,6 +737,24 @@ func (ev *Event) marshalStoreBinary(r *Receipt) ([]byte, error) {
 	return b.Bytes(), nil
 }
 
+func (ev *Event) marshalIndexed(b *bytes.Buffer) {
+	b.WriteByte(byte(len(ev.Indexed)))
+	for _, h := range ev.Indexed {
+		b.Write(h)
+	}
+}
+
+func (ev *Event) unmarshalIndexed(data []byte) []byte {
+	n := int(data[0])
+	pos := 1
+	ev.Indexed = nil
+	for i := 0; i < n; i++ {
+		ev.Indexed = append(ev.Indexed, data[pos:pos+sha256.Size])
+		pos += sha256.Size
+	}
+	return data[pos:]
+}
+
 func (ev *Event) unmarshalStoreBinary(data []byte, r *Receipt) ([]byte, error) {
 	var pos uint32
 	if data[0] == 0 {
@@ -740,6 +835,18 @@ func (ev *Event) MarshalJSON() ([]byte, error) {
 	b.WriteString(fmt.Sprintf("%d", ev.BlockNo))
 	b.WriteString(`,"TxIndex":`)
 	b.WriteString(fmt.Sprintf("%d", ev.TxIndex))
+	if len(ev.Indexed) > 0 {
+		b.WriteString(`,"Indexed":[`)
+		for i, h := range ev.Indexed {
+			if i > 0 {
+				b.WriteString(`,`)
+			}
+			b.WriteString(`"`)
+			b.WriteString(enc.ToString(h))
+			b.WriteString(`"`)
+		}
+		b.WriteString(`]`)
+	}
 	b.WriteString(`}`)
 	return b.Bytes(), nil
 }
@@ -809,13 +916,18 @@ func checkValue(value interface{}, check interface{}) bool {
 	return true
 }
 
-func (ev *Event) Filter(filter *FilterInfo, argFilter []ArgFilter) bool {
+func (ev *Event) Filter(filter *FilterInfo, argFilter []ArgFilter, indexed []IndexedFilter) bool {
 	if filter.ContractAddress != nil && !bytes.Equal(ev.ContractAddress, filter.ContractAddress) {
 		return false
 	}
 	if len(filter.EventName) != 0 && ev.EventName != filter.EventName {
 		return false
 	}
+	for _, f := range indexed {
+		if f.argNo >= len(ev.Indexed) || !bytes.Equal(ev.Indexed[f.argNo], f.hash) {
+			return false
+		}
+	}
 	if argFilter != nil {
 		var args []interface{}
 		err := json.Unmarshal([]byte(ev.JsonArgs), &args)
@@ -843,6 +955,23 @@ type ArgFilter struct {
 	value interface{}
 }
 
+// IndexedFilter matches the hash of an indexed argument of events.
+type IndexedFilter struct {
+	argNo int
+	hash  []byte
+}
+
+// EventArgHash returns the hash of an event argument decoded from JSON, which
+// is stored for the indexed arguments of events.
+func EventArgHash(v interface{}) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	h := sha256.Sum256(b)
+	return h[:], nil
+}
+
 const MAXBLOCKRANGE = 10000
 const padprefix = 0x80
 
@@ -910,3 +1039,32 @@ func (fi *FilterInfo) GetExArgFilter() ([]ArgFilter, error) {
 	}
 	return nil, nil
 }
+
+// GetExIndexedFilter returns the filters of the indexed arguments, which are
+// given as a JSON object mapping the argument number to its value.
+func (fi *FilterInfo) GetExIndexedFilter() ([]IndexedFilter, error) {
+	if len(fi.IndexedFilter) == 0 {
+		return nil, nil
+	}
+
+	var argMap map[string]interface{}
+	d := json.NewDecoder(bytes.NewReader(fi.IndexedFilter))
+	d.UseNumber()
+	if err := d.Decode(&argMap); err != nil {
+		return nil, errors.New("invalid json format:" + err.Error())
+	}
+
+	var indexed []IndexedFilter
+	for key, value := range argMap {
+		idx, err := strconv.ParseInt(key, 10, 32)
+		if err != nil || idx < 0 || idx >= MaxIndexedEventArgs {
+			return nil, errors.New("invalid indexed argument number:" + key)
+		}
+		h, err := EventArgHash(value)
+		if err != nil {
+			return nil, err
+		}
+		indexed = append(indexed, IndexedFilter{argNo: int(idx), hash: h})
+	}
+	return indexed, nil
+}
@@ -0,0 +1,132 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestReceiptRevert(t *testing.T) {
+	newReceipt := func() *Receipt {
+		r := NewReceipt(make([]byte, 33), "ERROR", "E01: insufficient funds")
+		r.TxHash = make([]byte, 32)
+		r.FeeUsed = []byte{1}
+		r.FeeDelegation = true
+		return r
+	}
+	plain := newReceipt()
+	r := newReceipt()
+	r.RevertCode = "E01"
+	r.RevertMessage = "insufficient funds"
+	r.RevertData = `{"need":10}`
+
+	b, err := r.MarshalBinaryTest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded Receipt
+	if err := decoded.UnmarshalBinaryTest(b); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.RevertCode != r.RevertCode || decoded.RevertMessage != r.RevertMessage ||
+		decoded.RevertData != r.RevertData || !decoded.FeeDelegation || decoded.Ret != r.Ret {
+		t.Errorf("unexpected receipt: %v", decoded.String())
+	}
+
+	b, err = plain.MarshalBinaryTest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded = Receipt{}
+	if err := decoded.UnmarshalBinaryTest(b); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.HasRevert() || !decoded.FeeDelegation {
+		t.Errorf("unexpected receipt: %v", decoded.String())
+	}
+
+	m1, _ := r.MarshalMerkleBinaryV2()
+	m2, _ := plain.MarshalMerkleBinaryV2()
+	if !bytes.Equal(m1, m2) {
+		t.Error("revert reason must not change the receipt merkle")
+	}
+
+	js, err := r.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(js), `"revert":{"code":"E01","message":"insufficient funds","data":{"need":10}}`) {
+		t.Errorf("unexpected json: %s", js)
+	}
+}
+
+func TestEventIndexed(t *testing.T) {
+	to, _ := EventArgHash("AmPNYHyzyh9zweLwDyuoiUuTVCdrdksxkRWDjVJS76WQLExa2Jr4")
+	amount, _ := EventArgHash(json.Number("100"))
+	r := NewReceipt(make([]byte, 33), "SUCCESS", "")
+	r.TxHash = make([]byte, 32)
+	r.Events = []*Event{
+		{
+			ContractAddress: r.ContractAddress,
+			EventName:       "transfer",
+			JsonArgs:        `["AmPNYHyzyh9zweLwDyuoiUuTVCdrdksxkRWDjVJS76WQLExa2Jr4",100,"memo"]`,
+			Indexed:         [][]byte{to, amount},
+		},
+		{ContractAddress: r.ContractAddress, EventName: "other", JsonArgs: `[]`},
+	}
+
+	b, err := r.MarshalBinaryTest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded Receipt
+	if err := decoded.UnmarshalBinaryTest(b); err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded.Events) != 2 || len(decoded.Events[0].Indexed) != 2 || len(decoded.Events[1].Indexed) != 0 ||
+		!bytes.Equal(decoded.Events[0].Indexed[0], to) || !bytes.Equal(decoded.Events[0].Indexed[1], amount) {
+		t.Fatalf("unexpected events: %v", decoded.Events)
+	}
+
+	plain := NewReceipt(make([]byte, 33), "SUCCESS", "")
+	plain.TxHash = r.TxHash
+	plain.Events = []*Event{
+		{ContractAddress: r.ContractAddress, EventName: "transfer", JsonArgs: r.Events[0].JsonArgs},
+		r.Events[1],
+	}
+	m1, _ := r.MarshalMerkleBinaryV2()
+	m2, _ := plain.MarshalMerkleBinaryV2()
+	if !bytes.Equal(m1, m2) {
+		t.Error("indexed hashes must not change the receipt merkle")
+	}
+
+	tests := []struct {
+		filter string
+		match  bool
+		err    bool
+	}{
+		{`{"0":"AmPNYHyzyh9zweLwDyuoiUuTVCdrdksxkRWDjVJS76WQLExa2Jr4"}`, true, false},
+		{`{"0":"AmPNYHyzyh9zweLwDyuoiUuTVCdrdksxkRWDjVJS76WQLExa2Jr4","1":100}`, true, false},
+		{`{"1":101}`, false, false},
+		{`{"2":"memo"}`, false, false},
+		{`{"3":"memo"}`, false, true},
+		{`{"a":1}`, false, true},
+	}
+	for _, tt := range tests {
+		fi := &FilterInfo{IndexedFilter: []byte(tt.filter)}
+		indexed, err := fi.GetExIndexedFilter()
+		if tt.err {
+			if err == nil {
+				t.Errorf("%s: expected error", tt.filter)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: %v", tt.filter, err)
+		}
+		if m := r.Events[0].Filter(fi, nil, indexed); m != tt.match {
+			t.Errorf("%s: expected %v, got %v", tt.filter, tt.match, m)
+		}
+	}
+}
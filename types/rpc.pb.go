@@ -64,7 +64,7 @@ func (x CommitStatus) String() string {
 	return proto.EnumName(CommitStatus_name, int32(x))
 }
 func (CommitStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{0}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{0}
 }
 
 type VerifyStatus int32
@@ -90,7 +90,7 @@ func (x VerifyStatus) String() string {
 	return proto.EnumName(VerifyStatus_name, int32(x))
 }
 func (VerifyStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{1}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{1}
 }
 
 // BlockchainStatus is current status of blockchain
@@ -109,7 +109,7 @@ func (m *BlockchainStatus) Reset()         { *m = BlockchainStatus{} }
 func (m *BlockchainStatus) String() string { return proto.CompactTextString(m) }
 func (*BlockchainStatus) ProtoMessage()    {}
 func (*BlockchainStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{0}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{0}
 }
 func (m *BlockchainStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockchainStatus.Unmarshal(m, b)
@@ -179,7 +179,7 @@ func (m *ChainId) Reset()         { *m = ChainId{} }
 func (m *ChainId) String() string { return proto.CompactTextString(m) }
 func (*ChainId) ProtoMessage()    {}
 func (*ChainId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{1}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{1}
 }
 func (m *ChainId) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChainId.Unmarshal(m, b)
@@ -255,7 +255,7 @@ func (m *ChainInfo) Reset()         { *m = ChainInfo{} }
 func (m *ChainInfo) String() string { return proto.CompactTextString(m) }
 func (*ChainInfo) ProtoMessage()    {}
 func (*ChainInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{2}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{2}
 }
 func (m *ChainInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChainInfo.Unmarshal(m, b)
@@ -357,7 +357,7 @@ func (m *ChainStats) Reset()         { *m = ChainStats{} }
 func (m *ChainStats) String() string { return proto.CompactTextString(m) }
 func (*ChainStats) ProtoMessage()    {}
 func (*ChainStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{3}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{3}
 }
 func (m *ChainStats) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChainStats.Unmarshal(m, b)
@@ -398,7 +398,7 @@ func (m *Input) Reset()         { *m = Input{} }
 func (m *Input) String() string { return proto.CompactTextString(m) }
 func (*Input) ProtoMessage()    {}
 func (*Input) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{4}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{4}
 }
 func (m *Input) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Input.Unmarshal(m, b)
@@ -460,7 +460,7 @@ func (m *Output) Reset()         { *m = Output{} }
 func (m *Output) String() string { return proto.CompactTextString(m) }
 func (*Output) ProtoMessage()    {}
 func (*Output) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{5}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{5}
 }
 func (m *Output) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Output.Unmarshal(m, b)
@@ -518,7 +518,7 @@ func (m *Empty) Reset()         { *m = Empty{} }
 func (m *Empty) String() string { return proto.CompactTextString(m) }
 func (*Empty) ProtoMessage()    {}
 func (*Empty) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{6}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{6}
 }
 func (m *Empty) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Empty.Unmarshal(m, b)
@@ -549,7 +549,7 @@ func (m *SingleBytes) Reset()         { *m = SingleBytes{} }
 func (m *SingleBytes) String() string { return proto.CompactTextString(m) }
 func (*SingleBytes) ProtoMessage()    {}
 func (*SingleBytes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{7}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{7}
 }
 func (m *SingleBytes) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SingleBytes.Unmarshal(m, b)
@@ -587,7 +587,7 @@ func (m *SingleString) Reset()         { *m = SingleString{} }
 func (m *SingleString) String() string { return proto.CompactTextString(m) }
 func (*SingleString) ProtoMessage()    {}
 func (*SingleString) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{8}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{8}
 }
 func (m *SingleString) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SingleString.Unmarshal(m, b)
@@ -625,7 +625,7 @@ func (m *AccountAddress) Reset()         { *m = AccountAddress{} }
 func (m *AccountAddress) String() string { return proto.CompactTextString(m) }
 func (*AccountAddress) ProtoMessage()    {}
 func (*AccountAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{9}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{9}
 }
 func (m *AccountAddress) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountAddress.Unmarshal(m, b)
@@ -665,7 +665,7 @@ func (m *AccountAndRoot) Reset()         { *m = AccountAndRoot{} }
 func (m *AccountAndRoot) String() string { return proto.CompactTextString(m) }
 func (*AccountAndRoot) ProtoMessage()    {}
 func (*AccountAndRoot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{10}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{10}
 }
 func (m *AccountAndRoot) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountAndRoot.Unmarshal(m, b)
@@ -716,6 +716,7 @@ type Peer struct {
 	Version              string              `protobuf:"bytes,7,opt,name=version" json:"version,omitempty"`
 	Certificates         []*AgentCertificate `protobuf:"bytes,8,rep,name=certificates" json:"certificates,omitempty"`
 	AcceptedRole         PeerRole            `protobuf:"varint,9,opt,name=acceptedRole,enum=types.PeerRole" json:"acceptedRole,omitempty"`
+	Reputation           int32               `protobuf:"varint,10,opt,name=reputation" json:"reputation,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -725,7 +726,7 @@ func (m *Peer) Reset()         { *m = Peer{} }
 func (m *Peer) String() string { return proto.CompactTextString(m) }
 func (*Peer) ProtoMessage()    {}
 func (*Peer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{11}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{11}
 }
 func (m *Peer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Peer.Unmarshal(m, b)
@@ -808,6 +809,13 @@ func (m *Peer) GetAcceptedRole() PeerRole {
 	return PeerRole_LegacyVersion
 }
 
+func (m *Peer) GetReputation() int32 {
+	if m != nil {
+		return m.Reputation
+	}
+	return 0
+}
+
 type PeerList struct {
 	Peers                []*Peer  `protobuf:"bytes,1,rep,name=peers" json:"peers,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -819,7 +827,7 @@ func (m *PeerList) Reset()         { *m = PeerList{} }
 func (m *PeerList) String() string { return proto.CompactTextString(m) }
 func (*PeerList) ProtoMessage()    {}
 func (*PeerList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{12}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{12}
 }
 func (m *PeerList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PeerList.Unmarshal(m, b)
@@ -861,7 +869,7 @@ func (m *ListParams) Reset()         { *m = ListParams{} }
 func (m *ListParams) String() string { return proto.CompactTextString(m) }
 func (*ListParams) ProtoMessage()    {}
 func (*ListParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{13}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{13}
 }
 func (m *ListParams) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListParams.Unmarshal(m, b)
@@ -928,7 +936,7 @@ func (m *PageParams) Reset()         { *m = PageParams{} }
 func (m *PageParams) String() string { return proto.CompactTextString(m) }
 func (*PageParams) ProtoMessage()    {}
 func (*PageParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{14}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{14}
 }
 func (m *PageParams) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PageParams.Unmarshal(m, b)
@@ -976,7 +984,7 @@ func (m *BlockBodyPaged) Reset()         { *m = BlockBodyPaged{} }
 func (m *BlockBodyPaged) String() string { return proto.CompactTextString(m) }
 func (*BlockBodyPaged) ProtoMessage()    {}
 func (*BlockBodyPaged) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{15}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{15}
 }
 func (m *BlockBodyPaged) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockBodyPaged.Unmarshal(m, b)
@@ -1036,7 +1044,7 @@ func (m *BlockBodyParams) Reset()         { *m = BlockBodyParams{} }
 func (m *BlockBodyParams) String() string { return proto.CompactTextString(m) }
 func (*BlockBodyParams) ProtoMessage()    {}
 func (*BlockBodyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{16}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{16}
 }
 func (m *BlockBodyParams) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockBodyParams.Unmarshal(m, b)
@@ -1081,7 +1089,7 @@ func (m *BlockHeaderList) Reset()         { *m = BlockHeaderList{} }
 func (m *BlockHeaderList) String() string { return proto.CompactTextString(m) }
 func (*BlockHeaderList) ProtoMessage()    {}
 func (*BlockHeaderList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{17}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{17}
 }
 func (m *BlockHeaderList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockHeaderList.Unmarshal(m, b)
@@ -1122,7 +1130,7 @@ func (m *BlockMetadata) Reset()         { *m = BlockMetadata{} }
 func (m *BlockMetadata) String() string { return proto.CompactTextString(m) }
 func (*BlockMetadata) ProtoMessage()    {}
 func (*BlockMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{18}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{18}
 }
 func (m *BlockMetadata) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockMetadata.Unmarshal(m, b)
@@ -1181,7 +1189,7 @@ func (m *BlockMetadataList) Reset()         { *m = BlockMetadataList{} }
 func (m *BlockMetadataList) String() string { return proto.CompactTextString(m) }
 func (*BlockMetadataList) ProtoMessage()    {}
 func (*BlockMetadataList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{19}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{19}
 }
 func (m *BlockMetadataList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockMetadataList.Unmarshal(m, b)
@@ -1221,7 +1229,7 @@ func (m *CommitResult) Reset()         { *m = CommitResult{} }
 func (m *CommitResult) String() string { return proto.CompactTextString(m) }
 func (*CommitResult) ProtoMessage()    {}
 func (*CommitResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{20}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{20}
 }
 func (m *CommitResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CommitResult.Unmarshal(m, b)
@@ -1273,7 +1281,7 @@ func (m *CommitResultList) Reset()         { *m = CommitResultList{} }
 func (m *CommitResultList) String() string { return proto.CompactTextString(m) }
 func (*CommitResultList) ProtoMessage()    {}
 func (*CommitResultList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{21}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{21}
 }
 func (m *CommitResultList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CommitResultList.Unmarshal(m, b)
@@ -1312,7 +1320,7 @@ func (m *VerifyResult) Reset()         { *m = VerifyResult{} }
 func (m *VerifyResult) String() string { return proto.CompactTextString(m) }
 func (*VerifyResult) ProtoMessage()    {}
 func (*VerifyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{22}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{22}
 }
 func (m *VerifyResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VerifyResult.Unmarshal(m, b)
@@ -1358,7 +1366,7 @@ func (m *Personal) Reset()         { *m = Personal{} }
 func (m *Personal) String() string { return proto.CompactTextString(m) }
 func (*Personal) ProtoMessage()    {}
 func (*Personal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{23}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{23}
 }
 func (m *Personal) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Personal.Unmarshal(m, b)
@@ -1406,7 +1414,7 @@ func (m *ImportFormat) Reset()         { *m = ImportFormat{} }
 func (m *ImportFormat) String() string { return proto.CompactTextString(m) }
 func (*ImportFormat) ProtoMessage()    {}
 func (*ImportFormat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{24}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{24}
 }
 func (m *ImportFormat) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ImportFormat.Unmarshal(m, b)
@@ -1455,18 +1463,19 @@ func (m *ImportFormat) GetKeystore() *SingleBytes {
 }
 
 type Staking struct {
-	Amount               []byte   `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	When                 uint64   `protobuf:"varint,2,opt,name=when" json:"when,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Amount               []byte       `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	When                 uint64       `protobuf:"varint,2,opt,name=when" json:"when,omitempty"`
+	Unbonding            []*Unbonding `protobuf:"bytes,3,rep,name=unbonding" json:"unbonding,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *Staking) Reset()         { *m = Staking{} }
 func (m *Staking) String() string { return proto.CompactTextString(m) }
 func (*Staking) ProtoMessage()    {}
 func (*Staking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{25}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{25}
 }
 func (m *Staking) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Staking.Unmarshal(m, b)
@@ -1500,6 +1509,59 @@ func (m *Staking) GetWhen() uint64 {
 	return 0
 }
 
+func (m *Staking) GetUnbonding() []*Unbonding {
+	if m != nil {
+		return m.Unbonding
+	}
+	return nil
+}
+
+type Unbonding struct {
+	Amount               []byte   `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Release              uint64   `protobuf:"varint,2,opt,name=release" json:"release,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Unbonding) Reset()         { *m = Unbonding{} }
+func (m *Unbonding) String() string { return proto.CompactTextString(m) }
+func (*Unbonding) ProtoMessage()    {}
+func (*Unbonding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{26}
+}
+func (m *Unbonding) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Unbonding.Unmarshal(m, b)
+}
+func (m *Unbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Unbonding.Marshal(b, m, deterministic)
+}
+func (dst *Unbonding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Unbonding.Merge(dst, src)
+}
+func (m *Unbonding) XXX_Size() int {
+	return xxx_messageInfo_Unbonding.Size(m)
+}
+func (m *Unbonding) XXX_DiscardUnknown() {
+	xxx_messageInfo_Unbonding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Unbonding proto.InternalMessageInfo
+
+func (m *Unbonding) GetAmount() []byte {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *Unbonding) GetRelease() uint64 {
+	if m != nil {
+		return m.Release
+	}
+	return 0
+}
+
 type Vote struct {
 	Candidate            []byte   `protobuf:"bytes,1,opt,name=candidate,proto3" json:"candidate,omitempty"`
 	Amount               []byte   `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
@@ -1512,7 +1574,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{26}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{27}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Vote.Unmarshal(m, b)
@@ -1558,7 +1620,7 @@ func (m *VoteParams) Reset()         { *m = VoteParams{} }
 func (m *VoteParams) String() string { return proto.CompactTextString(m) }
 func (*VoteParams) ProtoMessage()    {}
 func (*VoteParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{27}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{28}
 }
 func (m *VoteParams) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VoteParams.Unmarshal(m, b)
@@ -1604,7 +1666,7 @@ func (m *AccountVoteInfo) Reset()         { *m = AccountVoteInfo{} }
 func (m *AccountVoteInfo) String() string { return proto.CompactTextString(m) }
 func (*AccountVoteInfo) ProtoMessage()    {}
 func (*AccountVoteInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{28}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{29}
 }
 func (m *AccountVoteInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountVoteInfo.Unmarshal(m, b)
@@ -1651,7 +1713,7 @@ func (m *VoteInfo) Reset()         { *m = VoteInfo{} }
 func (m *VoteInfo) String() string { return proto.CompactTextString(m) }
 func (*VoteInfo) ProtoMessage()    {}
 func (*VoteInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{29}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{30}
 }
 func (m *VoteInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VoteInfo.Unmarshal(m, b)
@@ -1704,7 +1766,7 @@ func (m *VoteList) Reset()         { *m = VoteList{} }
 func (m *VoteList) String() string { return proto.CompactTextString(m) }
 func (*VoteList) ProtoMessage()    {}
 func (*VoteList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{30}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{31}
 }
 func (m *VoteList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VoteList.Unmarshal(m, b)
@@ -1750,7 +1812,7 @@ func (m *NodeReq) Reset()         { *m = NodeReq{} }
 func (m *NodeReq) String() string { return proto.CompactTextString(m) }
 func (*NodeReq) ProtoMessage()    {}
 func (*NodeReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{31}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{32}
 }
 func (m *NodeReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeReq.Unmarshal(m, b)
@@ -1796,7 +1858,7 @@ func (m *Name) Reset()         { *m = Name{} }
 func (m *Name) String() string { return proto.CompactTextString(m) }
 func (*Name) ProtoMessage()    {}
 func (*Name) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{32}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{33}
 }
 func (m *Name) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Name.Unmarshal(m, b)
@@ -1834,6 +1896,7 @@ type NameInfo struct {
 	Name                 *Name    `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
 	Owner                []byte   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
 	Destination          []byte   `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	Expire               uint64   `protobuf:"varint,4,opt,name=expire" json:"expire,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1843,7 +1906,7 @@ func (m *NameInfo) Reset()         { *m = NameInfo{} }
 func (m *NameInfo) String() string { return proto.CompactTextString(m) }
 func (*NameInfo) ProtoMessage()    {}
 func (*NameInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{33}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{34}
 }
 func (m *NameInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NameInfo.Unmarshal(m, b)
@@ -1884,6 +1947,13 @@ func (m *NameInfo) GetDestination() []byte {
 	return nil
 }
 
+func (m *NameInfo) GetExpire() uint64 {
+	if m != nil {
+		return m.Expire
+	}
+	return 0
+}
+
 type PeersParams struct {
 	NoHidden             bool     `protobuf:"varint,1,opt,name=noHidden" json:"noHidden,omitempty"`
 	ShowSelf             bool     `protobuf:"varint,2,opt,name=showSelf" json:"showSelf,omitempty"`
@@ -1896,7 +1966,7 @@ func (m *PeersParams) Reset()         { *m = PeersParams{} }
 func (m *PeersParams) String() string { return proto.CompactTextString(m) }
 func (*PeersParams) ProtoMessage()    {}
 func (*PeersParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{34}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{35}
 }
 func (m *PeersParams) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PeersParams.Unmarshal(m, b)
@@ -1941,7 +2011,7 @@ func (m *KeyParams) Reset()         { *m = KeyParams{} }
 func (m *KeyParams) String() string { return proto.CompactTextString(m) }
 func (*KeyParams) ProtoMessage()    {}
 func (*KeyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{35}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{36}
 }
 func (m *KeyParams) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_KeyParams.Unmarshal(m, b)
@@ -1980,7 +2050,7 @@ func (m *ServerInfo) Reset()         { *m = ServerInfo{} }
 func (m *ServerInfo) String() string { return proto.CompactTextString(m) }
 func (*ServerInfo) ProtoMessage()    {}
 func (*ServerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{36}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{37}
 }
 func (m *ServerInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ServerInfo.Unmarshal(m, b)
@@ -2025,7 +2095,7 @@ func (m *ConfigItem) Reset()         { *m = ConfigItem{} }
 func (m *ConfigItem) String() string { return proto.CompactTextString(m) }
 func (*ConfigItem) ProtoMessage()    {}
 func (*ConfigItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{37}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{38}
 }
 func (m *ConfigItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigItem.Unmarshal(m, b)
@@ -2063,7 +2133,7 @@ func (m *EventList) Reset()         { *m = EventList{} }
 func (m *EventList) String() string { return proto.CompactTextString(m) }
 func (*EventList) ProtoMessage()    {}
 func (*EventList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{38}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{39}
 }
 func (m *EventList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EventList.Unmarshal(m, b)
@@ -2104,7 +2174,7 @@ func (m *ConsensusInfo) Reset()         { *m = ConsensusInfo{} }
 func (m *ConsensusInfo) String() string { return proto.CompactTextString(m) }
 func (*ConsensusInfo) ProtoMessage()    {}
 func (*ConsensusInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{39}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{40}
 }
 func (m *ConsensusInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConsensusInfo.Unmarshal(m, b)
@@ -2156,7 +2226,7 @@ func (m *EnterpriseConfigKey) Reset()         { *m = EnterpriseConfigKey{} }
 func (m *EnterpriseConfigKey) String() string { return proto.CompactTextString(m) }
 func (*EnterpriseConfigKey) ProtoMessage()    {}
 func (*EnterpriseConfigKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{40}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{41}
 }
 func (m *EnterpriseConfigKey) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EnterpriseConfigKey.Unmarshal(m, b)
@@ -2196,7 +2266,7 @@ func (m *EnterpriseConfig) Reset()         { *m = EnterpriseConfig{} }
 func (m *EnterpriseConfig) String() string { return proto.CompactTextString(m) }
 func (*EnterpriseConfig) ProtoMessage()    {}
 func (*EnterpriseConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_8d86ee9ecec344df, []int{41}
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{42}
 }
 func (m *EnterpriseConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EnterpriseConfig.Unmarshal(m, b)
@@ -2237,6 +2307,456 @@ func (m *EnterpriseConfig) GetValues() []string {
 	return nil
 }
 
+type ProposalInfo struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	Proposer             []byte   `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Target               string   `protobuf:"bytes,4,opt,name=target" json:"target,omitempty"`
+	Value                string   `protobuf:"bytes,5,opt,name=value" json:"value,omitempty"`
+	Blockfrom            uint64   `protobuf:"varint,6,opt,name=blockfrom" json:"blockfrom,omitempty"`
+	Blockto              uint64   `protobuf:"varint,7,opt,name=blockto" json:"blockto,omitempty"`
+	Quorum               uint32   `protobuf:"varint,8,opt,name=quorum" json:"quorum,omitempty"`
+	Timelock             uint64   `protobuf:"varint,9,opt,name=timelock" json:"timelock,omitempty"`
+	Executed             bool     `protobuf:"varint,10,opt,name=executed" json:"executed,omitempty"`
+	Candidates           []string `protobuf:"bytes,11,rep,name=candidates" json:"candidates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProposalInfo) Reset()         { *m = ProposalInfo{} }
+func (m *ProposalInfo) String() string { return proto.CompactTextString(m) }
+func (*ProposalInfo) ProtoMessage()    {}
+func (*ProposalInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{43}
+}
+func (m *ProposalInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProposalInfo.Unmarshal(m, b)
+}
+func (m *ProposalInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProposalInfo.Marshal(b, m, deterministic)
+}
+func (dst *ProposalInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalInfo.Merge(dst, src)
+}
+func (m *ProposalInfo) XXX_Size() int {
+	return xxx_messageInfo_ProposalInfo.Size(m)
+}
+func (m *ProposalInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalInfo proto.InternalMessageInfo
+
+func (m *ProposalInfo) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ProposalInfo) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ProposalInfo) GetProposer() []byte {
+	if m != nil {
+		return m.Proposer
+	}
+	return nil
+}
+
+func (m *ProposalInfo) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *ProposalInfo) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *ProposalInfo) GetBlockfrom() uint64 {
+	if m != nil {
+		return m.Blockfrom
+	}
+	return 0
+}
+
+func (m *ProposalInfo) GetBlockto() uint64 {
+	if m != nil {
+		return m.Blockto
+	}
+	return 0
+}
+
+func (m *ProposalInfo) GetQuorum() uint32 {
+	if m != nil {
+		return m.Quorum
+	}
+	return 0
+}
+
+func (m *ProposalInfo) GetTimelock() uint64 {
+	if m != nil {
+		return m.Timelock
+	}
+	return 0
+}
+
+func (m *ProposalInfo) GetExecuted() bool {
+	if m != nil {
+		return m.Executed
+	}
+	return false
+}
+
+func (m *ProposalInfo) GetCandidates() []string {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
+type ProposalList struct {
+	Proposals            []*ProposalInfo `protobuf:"bytes,1,rep,name=proposals" json:"proposals,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ProposalList) Reset()         { *m = ProposalList{} }
+func (m *ProposalList) String() string { return proto.CompactTextString(m) }
+func (*ProposalList) ProtoMessage()    {}
+func (*ProposalList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{44}
+}
+func (m *ProposalList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProposalList.Unmarshal(m, b)
+}
+func (m *ProposalList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProposalList.Marshal(b, m, deterministic)
+}
+func (dst *ProposalList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalList.Merge(dst, src)
+}
+func (m *ProposalList) XXX_Size() int {
+	return xxx_messageInfo_ProposalList.Size(m)
+}
+func (m *ProposalList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalList proto.InternalMessageInfo
+
+func (m *ProposalList) GetProposals() []*ProposalInfo {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+type ContractSource struct {
+	ContractAddress      []byte   `protobuf:"bytes,1,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
+	Source               string   `protobuf:"bytes,2,opt,name=source" json:"source,omitempty"`
+	CompilerVersion      string   `protobuf:"bytes,3,opt,name=compilerVersion" json:"compilerVersion,omitempty"`
+	CodeHash             []byte   `protobuf:"bytes,4,opt,name=codeHash,proto3" json:"codeHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContractSource) Reset()         { *m = ContractSource{} }
+func (m *ContractSource) String() string { return proto.CompactTextString(m) }
+func (*ContractSource) ProtoMessage()    {}
+func (*ContractSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{45}
+}
+func (m *ContractSource) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractSource.Unmarshal(m, b)
+}
+func (m *ContractSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractSource.Marshal(b, m, deterministic)
+}
+func (dst *ContractSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractSource.Merge(dst, src)
+}
+func (m *ContractSource) XXX_Size() int {
+	return xxx_messageInfo_ContractSource.Size(m)
+}
+func (m *ContractSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractSource proto.InternalMessageInfo
+
+func (m *ContractSource) GetContractAddress() []byte {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return nil
+}
+
+func (m *ContractSource) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *ContractSource) GetCompilerVersion() string {
+	if m != nil {
+		return m.CompilerVersion
+	}
+	return ""
+}
+
+func (m *ContractSource) GetCodeHash() []byte {
+	if m != nil {
+		return m.CodeHash
+	}
+	return nil
+}
+
+type TableStats struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Rows                 uint64   `protobuf:"varint,2,opt,name=rows" json:"rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TableStats) Reset()         { *m = TableStats{} }
+func (m *TableStats) String() string { return proto.CompactTextString(m) }
+func (*TableStats) ProtoMessage()    {}
+func (*TableStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{46}
+}
+func (m *TableStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TableStats.Unmarshal(m, b)
+}
+func (m *TableStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TableStats.Marshal(b, m, deterministic)
+}
+func (dst *TableStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TableStats.Merge(dst, src)
+}
+func (m *TableStats) XXX_Size() int {
+	return xxx_messageInfo_TableStats.Size(m)
+}
+func (m *TableStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_TableStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TableStats proto.InternalMessageInfo
+
+func (m *TableStats) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TableStats) GetRows() uint64 {
+	if m != nil {
+		return m.Rows
+	}
+	return 0
+}
+
+type ContractDBStats struct {
+	ContractAddress      []byte        `protobuf:"bytes,1,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
+	Size                 uint64        `protobuf:"varint,2,opt,name=size" json:"size,omitempty"`
+	PageCount            uint64        `protobuf:"varint,3,opt,name=pageCount" json:"pageCount,omitempty"`
+	PageSize             uint64        `protobuf:"varint,4,opt,name=pageSize" json:"pageSize,omitempty"`
+	Tables               []*TableStats `protobuf:"bytes,5,rep,name=tables" json:"tables,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ContractDBStats) Reset()         { *m = ContractDBStats{} }
+func (m *ContractDBStats) String() string { return proto.CompactTextString(m) }
+func (*ContractDBStats) ProtoMessage()    {}
+func (*ContractDBStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{47}
+}
+func (m *ContractDBStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractDBStats.Unmarshal(m, b)
+}
+func (m *ContractDBStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractDBStats.Marshal(b, m, deterministic)
+}
+func (dst *ContractDBStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractDBStats.Merge(dst, src)
+}
+func (m *ContractDBStats) XXX_Size() int {
+	return xxx_messageInfo_ContractDBStats.Size(m)
+}
+func (m *ContractDBStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractDBStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractDBStats proto.InternalMessageInfo
+
+func (m *ContractDBStats) GetContractAddress() []byte {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return nil
+}
+
+func (m *ContractDBStats) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *ContractDBStats) GetPageCount() uint64 {
+	if m != nil {
+		return m.PageCount
+	}
+	return 0
+}
+
+func (m *ContractDBStats) GetPageSize() uint64 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ContractDBStats) GetTables() []*TableStats {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
+type SQLQuery struct {
+	ContractAddress      []byte   `protobuf:"bytes,1,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
+	Query                string   `protobuf:"bytes,2,opt,name=query" json:"query,omitempty"`
+	BlockNo              uint64   `protobuf:"varint,3,opt,name=blockNo" json:"blockNo,omitempty"`
+	Limit                uint32   `protobuf:"varint,4,opt,name=limit" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SQLQuery) Reset()         { *m = SQLQuery{} }
+func (m *SQLQuery) String() string { return proto.CompactTextString(m) }
+func (*SQLQuery) ProtoMessage()    {}
+func (*SQLQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{48}
+}
+func (m *SQLQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SQLQuery.Unmarshal(m, b)
+}
+func (m *SQLQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SQLQuery.Marshal(b, m, deterministic)
+}
+func (dst *SQLQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SQLQuery.Merge(dst, src)
+}
+func (m *SQLQuery) XXX_Size() int {
+	return xxx_messageInfo_SQLQuery.Size(m)
+}
+func (m *SQLQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_SQLQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SQLQuery proto.InternalMessageInfo
+
+func (m *SQLQuery) GetContractAddress() []byte {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return nil
+}
+
+func (m *SQLQuery) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *SQLQuery) GetBlockNo() uint64 {
+	if m != nil {
+		return m.BlockNo
+	}
+	return 0
+}
+
+func (m *SQLQuery) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type SQLQueryResult struct {
+	Columns              []string `protobuf:"bytes,1,rep,name=columns" json:"columns,omitempty"`
+	Rows                 []string `protobuf:"bytes,2,rep,name=rows" json:"rows,omitempty"`
+	Truncated            bool     `protobuf:"varint,3,opt,name=truncated" json:"truncated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SQLQueryResult) Reset()         { *m = SQLQueryResult{} }
+func (m *SQLQueryResult) String() string { return proto.CompactTextString(m) }
+func (*SQLQueryResult) ProtoMessage()    {}
+func (*SQLQueryResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_0045ed5d8b4b9394, []int{49}
+}
+func (m *SQLQueryResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SQLQueryResult.Unmarshal(m, b)
+}
+func (m *SQLQueryResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SQLQueryResult.Marshal(b, m, deterministic)
+}
+func (dst *SQLQueryResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SQLQueryResult.Merge(dst, src)
+}
+func (m *SQLQueryResult) XXX_Size() int {
+	return xxx_messageInfo_SQLQueryResult.Size(m)
+}
+func (m *SQLQueryResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SQLQueryResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SQLQueryResult proto.InternalMessageInfo
+
+func (m *SQLQueryResult) GetColumns() []string {
+	if m != nil {
+		return m.Columns
+	}
+	return nil
+}
+
+func (m *SQLQueryResult) GetRows() []string {
+	if m != nil {
+		return m.Rows
+	}
+	return nil
+}
+
+func (m *SQLQueryResult) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*BlockchainStatus)(nil), "types.BlockchainStatus")
 	proto.RegisterType((*ChainId)(nil), "types.ChainId")
@@ -2264,6 +2784,7 @@ func init() {
 	proto.RegisterType((*Personal)(nil), "types.Personal")
 	proto.RegisterType((*ImportFormat)(nil), "types.ImportFormat")
 	proto.RegisterType((*Staking)(nil), "types.Staking")
+	proto.RegisterType((*Unbonding)(nil), "types.Unbonding")
 	proto.RegisterType((*Vote)(nil), "types.Vote")
 	proto.RegisterType((*VoteParams)(nil), "types.VoteParams")
 	proto.RegisterType((*AccountVoteInfo)(nil), "types.AccountVoteInfo")
@@ -2283,6 +2804,13 @@ func init() {
 	proto.RegisterType((*ConsensusInfo)(nil), "types.ConsensusInfo")
 	proto.RegisterType((*EnterpriseConfigKey)(nil), "types.EnterpriseConfigKey")
 	proto.RegisterType((*EnterpriseConfig)(nil), "types.EnterpriseConfig")
+	proto.RegisterType((*ProposalInfo)(nil), "types.ProposalInfo")
+	proto.RegisterType((*ProposalList)(nil), "types.ProposalList")
+	proto.RegisterType((*ContractSource)(nil), "types.ContractSource")
+	proto.RegisterType((*TableStats)(nil), "types.TableStats")
+	proto.RegisterType((*ContractDBStats)(nil), "types.ContractDBStats")
+	proto.RegisterType((*SQLQuery)(nil), "types.SQLQuery")
+	proto.RegisterType((*SQLQueryResult)(nil), "types.SQLQueryResult")
 	proto.RegisterEnum("types.CommitStatus", CommitStatus_name, CommitStatus_value)
 	proto.RegisterEnum("types.VerifyStatus", VerifyStatus_name, VerifyStatus_value)
 }
@@ -2382,6 +2910,18 @@ type AergoRPCServiceClient interface {
 	GetEnterpriseConfig(ctx context.Context, in *EnterpriseConfigKey, opts ...grpc.CallOption) (*EnterpriseConfig, error)
 	// Return a status of changeCluster enterprise tx,  queried by requestID
 	GetConfChangeProgress(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*ConfChangeProgress, error)
+	// Return the governance proposals created by the stakers
+	ListProposals(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ProposalList, error)
+	// Return the code history of an upgradeable contract
+	GetCodeHistory(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*CodeHistory, error)
+	// Compile the source and persist it if it matches the deployed code
+	VerifyContractSource(ctx context.Context, in *ContractSource, opts ...grpc.CallOption) (*ContractSource, error)
+	// Return the verified source of a contract
+	GetContractSource(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*ContractSource, error)
+	// Returns the size of the sql database of a contract and the row counts of its tables
+	GetContractDBStats(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*ContractDBStats, error)
+	// Run a read-only sql query against the database of a contract
+	QueryContractSQL(ctx context.Context, in *SQLQuery, opts ...grpc.CallOption) (*SQLQueryResult, error)
 }
 
 type aergoRPCServiceClient struct {
@@ -2839,6 +3379,60 @@ func (c *aergoRPCServiceClient) GetConfChangeProgress(ctx context.Context, in *S
 	return out, nil
 }
 
+func (c *aergoRPCServiceClient) ListProposals(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ProposalList, error) {
+	out := new(ProposalList)
+	err := grpc.Invoke(ctx, "/types.AergoRPCService/ListProposals", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetCodeHistory(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*CodeHistory, error) {
+	out := new(CodeHistory)
+	err := grpc.Invoke(ctx, "/types.AergoRPCService/GetCodeHistory", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) VerifyContractSource(ctx context.Context, in *ContractSource, opts ...grpc.CallOption) (*ContractSource, error) {
+	out := new(ContractSource)
+	err := grpc.Invoke(ctx, "/types.AergoRPCService/VerifyContractSource", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetContractSource(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*ContractSource, error) {
+	out := new(ContractSource)
+	err := grpc.Invoke(ctx, "/types.AergoRPCService/GetContractSource", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetContractDBStats(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*ContractDBStats, error) {
+	out := new(ContractDBStats)
+	err := grpc.Invoke(ctx, "/types.AergoRPCService/GetContractDBStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) QueryContractSQL(ctx context.Context, in *SQLQuery, opts ...grpc.CallOption) (*SQLQueryResult, error) {
+	out := new(SQLQueryResult)
+	err := grpc.Invoke(ctx, "/types.AergoRPCService/QueryContractSQL", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for AergoRPCService service
 
 type AergoRPCServiceServer interface {
@@ -2926,6 +3520,18 @@ type AergoRPCServiceServer interface {
 	GetEnterpriseConfig(context.Context, *EnterpriseConfigKey) (*EnterpriseConfig, error)
 	// Return a status of changeCluster enterprise tx,  queried by requestID
 	GetConfChangeProgress(context.Context, *SingleBytes) (*ConfChangeProgress, error)
+	// Return the governance proposals created by the stakers
+	ListProposals(context.Context, *Empty) (*ProposalList, error)
+	// Return the code history of an upgradeable contract
+	GetCodeHistory(context.Context, *SingleBytes) (*CodeHistory, error)
+	// Compile the source and persist it if it matches the deployed code
+	VerifyContractSource(context.Context, *ContractSource) (*ContractSource, error)
+	// Return the verified source of a contract
+	GetContractSource(context.Context, *SingleBytes) (*ContractSource, error)
+	// Returns the size of the sql database of a contract and the row counts of its tables
+	GetContractDBStats(context.Context, *SingleBytes) (*ContractDBStats, error)
+	// Run a read-only sql query against the database of a contract
+	QueryContractSQL(context.Context, *SQLQuery) (*SQLQueryResult, error)
 }
 
 func RegisterAergoRPCServiceServer(s *grpc.Server, srv AergoRPCServiceServer) {
@@ -3697,6 +4303,114 @@ func _AergoRPCService_GetConfChangeProgress_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AergoRPCService_ListProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).ListProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/ListProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).ListProposals(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetCodeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SingleBytes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetCodeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetCodeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetCodeHistory(ctx, req.(*SingleBytes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_VerifyContractSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractSource)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).VerifyContractSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/VerifyContractSource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).VerifyContractSource(ctx, req.(*ContractSource))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetContractSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SingleBytes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetContractSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetContractSource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetContractSource(ctx, req.(*SingleBytes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetContractDBStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SingleBytes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetContractDBStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetContractDBStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetContractDBStats(ctx, req.(*SingleBytes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_QueryContractSQL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SQLQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).QueryContractSQL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/QueryContractSQL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).QueryContractSQL(ctx, req.(*SQLQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AergoRPCService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "types.AergoRPCService",
 	HandlerType: (*AergoRPCServiceServer)(nil),
@@ -3857,6 +4571,30 @@ var _AergoRPCService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetConfChangeProgress",
 			Handler:    _AergoRPCService_GetConfChangeProgress_Handler,
 		},
+		{
+			MethodName: "ListProposals",
+			Handler:    _AergoRPCService_ListProposals_Handler,
+		},
+		{
+			MethodName: "GetCodeHistory",
+			Handler:    _AergoRPCService_GetCodeHistory_Handler,
+		},
+		{
+			MethodName: "VerifyContractSource",
+			Handler:    _AergoRPCService_VerifyContractSource_Handler,
+		},
+		{
+			MethodName: "GetContractSource",
+			Handler:    _AergoRPCService_GetContractSource_Handler,
+		},
+		{
+			MethodName: "GetContractDBStats",
+			Handler:    _AergoRPCService_GetContractDBStats_Handler,
+		},
+		{
+			MethodName: "QueryContractSQL",
+			Handler:    _AergoRPCService_QueryContractSQL_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -3878,172 +4616,200 @@ var _AergoRPCService_serviceDesc = grpc.ServiceDesc{
 	Metadata: "rpc.proto",
 }
 
-func init() { proto.RegisterFile("rpc.proto", fileDescriptor_rpc_8d86ee9ecec344df) }
-
-var fileDescriptor_rpc_8d86ee9ecec344df = []byte{
-	// 2612 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x59, 0xdd, 0x73, 0x1b, 0xb7,
-	0x11, 0x27, 0x29, 0x51, 0x22, 0x97, 0xa4, 0x44, 0xc1, 0xb2, 0xad, 0xb0, 0x89, 0xa3, 0xa2, 0x6e,
-	0xa2, 0xb8, 0x89, 0x1a, 0xd3, 0x49, 0x9a, 0x7e, 0x25, 0xa5, 0x18, 0xda, 0xe2, 0x58, 0xa6, 0x54,
-	0x90, 0x71, 0x95, 0x97, 0xb2, 0xa7, 0x3b, 0x90, 0xbc, 0x11, 0x79, 0x77, 0xb9, 0x03, 0xf5, 0x91,
-	0x99, 0x3e, 0xf5, 0xa9, 0xff, 0x41, 0x67, 0xfa, 0x5f, 0xf5, 0xbd, 0xd3, 0xfe, 0x29, 0x1d, 0x2c,
-	0x80, 0xfb, 0xa0, 0xce, 0x9d, 0xba, 0x6f, 0xb7, 0x8b, 0xdf, 0x7e, 0x01, 0x8b, 0xc5, 0x02, 0x07,
-	0xd5, 0x30, 0xb0, 0x0f, 0x83, 0xd0, 0x17, 0x3e, 0x29, 0x8b, 0xdb, 0x80, 0x47, 0xad, 0xe6, 0xc5,
-	0xdc, 0xb7, 0x2f, 0xed, 0x99, 0xe5, 0x7a, 0x6a, 0xa0, 0xd5, 0xb0, 0x6c, 0xdb, 0x5f, 0x7a, 0x42,
-	0x93, 0xe0, 0xf9, 0x0e, 0xd7, 0xdf, 0xd5, 0xa0, 0x1d, 0xe8, 0xcf, 0xfa, 0x82, 0x8b, 0xd0, 0xb5,
-	0x0d, 0x28, 0xb4, 0x26, 0x5a, 0x80, 0xfe, 0xbb, 0x08, 0xcd, 0xa3, 0x58, 0xe9, 0x50, 0x58, 0x62,
-	0x19, 0x91, 0x0f, 0x60, 0xfb, 0x82, 0x47, 0x62, 0x8c, 0xd6, 0xc6, 0x33, 0x2b, 0x9a, 0xed, 0x15,
-	0xf7, 0x8b, 0x07, 0x75, 0xd6, 0x90, 0x6c, 0x84, 0x1f, 0x5b, 0xd1, 0x8c, 0xbc, 0x0f, 0x35, 0xc4,
-	0xcd, 0xb8, 0x3b, 0x9d, 0x89, 0xbd, 0xd2, 0x7e, 0xf1, 0x60, 0x9d, 0x81, 0x64, 0x1d, 0x23, 0x87,
-	0xfc, 0x14, 0xb6, 0x6c, 0xdf, 0x8b, 0xb8, 0x17, 0x2d, 0xa3, 0xb1, 0xeb, 0x4d, 0xfc, 0xbd, 0xb5,
-	0xfd, 0xe2, 0x41, 0x95, 0x35, 0x62, 0x6e, 0xdf, 0x9b, 0xf8, 0xe4, 0x67, 0x40, 0x50, 0x0f, 0xfa,
-	0x30, 0x76, 0x1d, 0x65, 0x72, 0x1d, 0x4d, 0xa2, 0x27, 0x5d, 0x39, 0xd0, 0x77, 0xd0, 0xe8, 0xcf,
-	0x01, 0x34, 0x4e, 0xea, 0x2b, 0xef, 0x17, 0x0f, 0x6a, 0xed, 0xe6, 0x21, 0xce, 0xcf, 0xa1, 0xc2,
-	0x79, 0x13, 0x9f, 0x55, 0x6d, 0xf3, 0x49, 0xff, 0x5a, 0x84, 0x4d, 0xad, 0x80, 0xec, 0x42, 0x79,
-	0x61, 0x4d, 0x5d, 0x1b, 0xe3, 0xa9, 0x32, 0x45, 0x90, 0x07, 0xb0, 0x11, 0x2c, 0x2f, 0xe6, 0xae,
-	0x8d, 0x21, 0x54, 0x98, 0xa6, 0xc8, 0x1e, 0x6c, 0x2e, 0x2c, 0xd7, 0xf3, 0xb8, 0x40, 0xbf, 0x2b,
-	0xcc, 0x90, 0xe4, 0x5d, 0xa8, 0xc6, 0x21, 0xa0, 0xa3, 0x55, 0x96, 0x30, 0xa4, 0xdc, 0x15, 0x0f,
-	0x23, 0xd7, 0xf7, 0xd0, 0xbf, 0x32, 0x33, 0x24, 0xfd, 0x57, 0x09, 0xaa, 0xb1, 0x93, 0xe4, 0x11,
-	0x94, 0x5c, 0x07, 0x5d, 0xa9, 0xb5, 0xb7, 0x32, 0x21, 0x38, 0xac, 0xe4, 0x3a, 0xa4, 0x05, 0x95,
-	0x8b, 0x60, 0xb0, 0x5c, 0x5c, 0xf0, 0x10, 0x3d, 0x6b, 0xb0, 0x98, 0x26, 0x14, 0xea, 0x0b, 0xeb,
-	0x06, 0x57, 0x28, 0x72, 0x7f, 0xe0, 0xe8, 0xe0, 0x3a, 0xcb, 0xf0, 0xa4, 0x97, 0x0b, 0xeb, 0x46,
-	0xf8, 0x97, 0xdc, 0x8b, 0xf4, 0x74, 0x26, 0x0c, 0xf2, 0x01, 0x6c, 0x45, 0xc2, 0xba, 0x74, 0xbd,
-	0xe9, 0xc2, 0xf5, 0xdc, 0xc5, 0x72, 0x81, 0xce, 0xd6, 0xd9, 0x0a, 0x57, 0x5a, 0x12, 0xbe, 0xb0,
-	0xe6, 0x9a, 0xbd, 0xb7, 0x81, 0xa8, 0x0c, 0x4f, 0x7a, 0x3a, 0xb5, 0xa2, 0x20, 0x74, 0x6d, 0xbe,
-	0xb7, 0x89, 0xe3, 0x31, 0x2d, 0xbd, 0xf0, 0xac, 0x05, 0x57, 0x83, 0x15, 0xe5, 0x45, 0xcc, 0x20,
-	0x4f, 0xa0, 0x89, 0x9a, 0xae, 0x7c, 0xe1, 0x7a, 0xd3, 0xc0, 0xbf, 0xe6, 0xe1, 0x5e, 0x15, 0x41,
-	0x77, 0xf8, 0xd2, 0x13, 0x45, 0x86, 0xfc, 0xda, 0x0a, 0x9d, 0x3d, 0x50, 0x9e, 0xa4, 0x79, 0xf4,
-	0x31, 0x40, 0xd7, 0xa4, 0x72, 0x24, 0x57, 0x36, 0xe4, 0x81, 0x1f, 0x0a, 0xbd, 0xe0, 0x9a, 0xa2,
-	0x36, 0x94, 0xfb, 0x5e, 0xb0, 0x14, 0x84, 0xc0, 0x7a, 0x2a, 0xbf, 0xf1, 0x5b, 0x2e, 0x9f, 0xe5,
-	0x38, 0x21, 0x8f, 0xa2, 0xbd, 0xd2, 0xfe, 0xda, 0x41, 0x9d, 0x19, 0x52, 0xa6, 0xcf, 0x95, 0x35,
-	0x5f, 0xaa, 0xd9, 0xae, 0x33, 0x45, 0x48, 0x23, 0x91, 0x1d, 0xba, 0x81, 0xd0, 0x73, 0xac, 0x29,
-	0x3a, 0x81, 0x8d, 0xd3, 0xa5, 0x90, 0x56, 0x76, 0xa1, 0xec, 0x7a, 0x0e, 0xbf, 0x41, 0x33, 0x0d,
-	0xa6, 0x88, 0xac, 0x9d, 0xe2, 0xff, 0x6f, 0x67, 0x13, 0xca, 0xbd, 0x45, 0x20, 0x6e, 0xe9, 0x4f,
-	0xa0, 0x36, 0x74, 0xbd, 0xe9, 0x9c, 0x1f, 0xdd, 0x0a, 0x9e, 0xd2, 0x52, 0x4c, 0x69, 0xa1, 0x8f,
-	0xa1, 0xae, 0x40, 0x43, 0x11, 0xca, 0xa5, 0xcb, 0xa0, 0xaa, 0x06, 0xf5, 0x01, 0x6c, 0x75, 0x54,
-	0x65, 0xe9, 0xac, 0xfa, 0x94, 0xd1, 0xf6, 0xc7, 0x04, 0xe7, 0x39, 0xcc, 0xf7, 0x85, 0x8c, 0x4a,
-	0x73, 0x34, 0xd2, 0x90, 0x72, 0xae, 0x25, 0x42, 0x07, 0x8b, 0xdf, 0xe4, 0x11, 0x40, 0xd7, 0x5f,
-	0x04, 0xd2, 0x02, 0x77, 0xf4, 0x2e, 0x4b, 0x71, 0xe8, 0x3f, 0x4b, 0xb0, 0x7e, 0xc6, 0x79, 0x48,
-	0x3e, 0x4e, 0x26, 0x4b, 0x6d, 0x18, 0xa2, 0x37, 0x8c, 0x1c, 0xd5, 0x3e, 0x26, 0x13, 0xf8, 0x0c,
-	0xaa, 0xb2, 0x6e, 0xe0, 0x56, 0x40, 0x7b, 0xb5, 0xf6, 0x7d, 0x8d, 0x1f, 0xf0, 0x6b, 0xac, 0x60,
-	0x03, 0x5f, 0xb8, 0x36, 0x67, 0x09, 0x4e, 0x46, 0x18, 0x09, 0x4b, 0xa8, 0x59, 0x2f, 0x33, 0x45,
-	0xc8, 0x59, 0x9f, 0xb9, 0x8e, 0xc3, 0x3d, 0x9c, 0xf5, 0x0a, 0xd3, 0x94, 0x4c, 0xeb, 0xb9, 0x15,
-	0xcd, 0xba, 0x33, 0x6e, 0x5f, 0xe2, 0xce, 0x59, 0x63, 0x09, 0x43, 0x6e, 0x88, 0x88, 0xcf, 0x27,
-	0x01, 0xe7, 0x21, 0x6e, 0x98, 0x0a, 0x8b, 0xe9, 0x74, 0x79, 0xd8, 0xc4, 0x39, 0x37, 0x24, 0xf9,
-	0x35, 0xd4, 0x6d, 0x1e, 0x0a, 0x77, 0xe2, 0xda, 0x96, 0xe0, 0xd1, 0x5e, 0x65, 0x7f, 0xed, 0xa0,
-	0xd6, 0x7e, 0xa8, 0x3d, 0xef, 0x4c, 0xb9, 0x27, 0xba, 0xc9, 0x38, 0xcb, 0x80, 0xc9, 0x33, 0xa8,
-	0x5b, 0xb6, 0xcd, 0x03, 0xc1, 0x1d, 0xe6, 0xcf, 0x39, 0xee, 0xa2, 0xad, 0xf6, 0x76, 0x6a, 0x9a,
-	0x24, 0x9b, 0x65, 0x40, 0xf4, 0x13, 0xa8, 0xc8, 0x91, 0x13, 0x37, 0x12, 0xe4, 0xc7, 0x50, 0x96,
-	0xfe, 0xc9, 0x09, 0x96, 0x66, 0x6b, 0x69, 0x49, 0x35, 0x42, 0xaf, 0x00, 0x24, 0xf4, 0xcc, 0x0a,
-	0xad, 0x45, 0x94, 0xbb, 0x79, 0xe4, 0x74, 0xa5, 0x8f, 0x03, 0x4d, 0x49, 0x6c, 0x5c, 0xa7, 0x1a,
-	0x0c, 0xbf, 0x25, 0xd6, 0x9f, 0x4c, 0x22, 0xae, 0x12, 0xba, 0xc1, 0x34, 0x45, 0x9a, 0xb0, 0x66,
-	0x45, 0x36, 0x4e, 0x6a, 0x85, 0xc9, 0x4f, 0xfa, 0x25, 0xc0, 0x99, 0x35, 0xe5, 0xda, 0x6e, 0x22,
-	0x57, 0xcc, 0xc8, 0x19, 0x1b, 0xa5, 0xc4, 0x06, 0xbd, 0x81, 0x2d, 0x5c, 0xee, 0x23, 0xdf, 0xb9,
-	0x95, 0x2a, 0xf0, 0x0c, 0xc0, 0xca, 0x62, 0x36, 0x23, 0x12, 0x29, 0x9d, 0xa5, 0x5c, 0x9d, 0x69,
-	0xbf, 0x1f, 0xc3, 0xfa, 0x85, 0xef, 0xdc, 0xa2, 0xd7, 0xc9, 0xe1, 0x13, 0x9b, 0x61, 0x38, 0x4a,
-	0xff, 0x04, 0xdb, 0x29, 0xcb, 0xe8, 0x38, 0x85, 0xba, 0x9c, 0x24, 0x3f, 0xf4, 0x54, 0x51, 0x57,
-	0x13, 0x97, 0xe1, 0x91, 0x8f, 0x60, 0x23, 0xb0, 0xa6, 0xb2, 0xd0, 0xaa, 0xbc, 0xdd, 0x31, 0xcb,
-	0x10, 0xc7, 0xcf, 0x34, 0x80, 0xfe, 0x42, 0x5b, 0x38, 0xe6, 0x96, 0xa3, 0xd7, 0xf0, 0x31, 0x6c,
-	0xa8, 0xfa, 0xaf, 0x17, 0xb1, 0x9e, 0x76, 0x8e, 0xe9, 0x31, 0xfa, 0x67, 0x68, 0x20, 0xe3, 0x15,
-	0x17, 0x96, 0x63, 0x09, 0x2b, 0x77, 0x25, 0x9f, 0xc8, 0x95, 0x94, 0x8a, 0xb5, 0x23, 0x24, 0xad,
-	0x4a, 0x99, 0x64, 0x1a, 0x21, 0x53, 0x5a, 0xdc, 0xa8, 0x4d, 0xaf, 0x36, 0x8f, 0x21, 0xe3, 0xf9,
-	0x5b, 0xc7, 0x1d, 0xa2, 0xd6, 0xa4, 0x03, 0x3b, 0x19, 0xf3, 0xe8, 0xf9, 0xc7, 0x2b, 0x9e, 0xef,
-	0xa6, 0xcd, 0x19, 0x64, 0x1c, 0x01, 0x87, 0x7a, 0xd7, 0x5f, 0x2c, 0x5c, 0xc1, 0x78, 0xb4, 0x9c,
-	0xe7, 0xd7, 0xf1, 0x8f, 0xa0, 0xcc, 0xc3, 0xd0, 0x57, 0xfe, 0x6f, 0xb5, 0xef, 0x99, 0x13, 0x16,
-	0xe5, 0x54, 0xab, 0xc3, 0x14, 0x42, 0xae, 0xbe, 0xc3, 0x85, 0xe5, 0xce, 0x75, 0x83, 0xa2, 0x29,
-	0xda, 0x81, 0x66, 0xda, 0x0c, 0x3a, 0xfa, 0x09, 0x6c, 0x86, 0x48, 0x19, 0x4f, 0xb3, 0x8a, 0x15,
-	0x92, 0x19, 0x0c, 0x1d, 0x41, 0xfd, 0x35, 0x0f, 0xdd, 0xc9, 0xad, 0xf6, 0xf4, 0x1d, 0x28, 0x89,
-	0x1b, 0x5d, 0xc3, 0xaa, 0x5a, 0x72, 0x74, 0xc3, 0x4a, 0xe2, 0xe6, 0x4d, 0x0e, 0x2b, 0xf1, 0x8c,
-	0xc3, 0x74, 0x24, 0xf7, 0x6d, 0x18, 0xf9, 0x9e, 0x35, 0x97, 0x35, 0x34, 0xb0, 0xa2, 0x28, 0x98,
-	0x85, 0x56, 0x64, 0xca, 0x78, 0x8a, 0x43, 0x0e, 0x60, 0x53, 0x77, 0x89, 0x7a, 0x25, 0x4d, 0xaf,
-	0xa1, 0x0b, 0x33, 0x33, 0xc3, 0xf4, 0x6f, 0x45, 0xa8, 0xf7, 0x17, 0xf2, 0x84, 0x7c, 0xee, 0x87,
-	0x0b, 0x4b, 0xa6, 0xd3, 0xda, 0xb5, 0x3b, 0x59, 0xa9, 0xb8, 0xa9, 0x33, 0x86, 0xc9, 0x61, 0xb9,
-	0xfa, 0xfe, 0xdc, 0x91, 0x16, 0xd1, 0x40, 0x95, 0x19, 0x52, 0x8e, 0x78, 0xfc, 0x1a, 0x47, 0xd4,
-	0xc4, 0x1a, 0x92, 0x1c, 0x42, 0xe5, 0x92, 0xdf, 0x46, 0xc2, 0x0f, 0xb9, 0xde, 0x47, 0x79, 0xea,
-	0x63, 0x0c, 0xfd, 0x1c, 0x36, 0x87, 0xba, 0xd9, 0x78, 0x00, 0x1b, 0xd6, 0x22, 0x75, 0xc0, 0x68,
-	0x4a, 0xe6, 0xc0, 0xf5, 0x8c, 0x7b, 0xba, 0xf0, 0xe0, 0x37, 0xfd, 0x0d, 0xac, 0xbf, 0xf6, 0x05,
-	0x36, 0x21, 0xb6, 0xe5, 0x39, 0xae, 0x23, 0xeb, 0xbb, 0x12, 0x4b, 0x18, 0x29, 0x8d, 0xa5, 0xb4,
-	0x46, 0xda, 0x06, 0x90, 0xd2, 0x7a, 0xf7, 0x6e, 0xc5, 0xed, 0x5a, 0x15, 0xdb, 0xb3, 0x5d, 0x28,
-	0x27, 0xb3, 0xda, 0x60, 0x8a, 0xa0, 0x0e, 0x6c, 0xeb, 0x79, 0x95, 0xa2, 0xd8, 0xe7, 0x1d, 0xc0,
-	0xa6, 0x69, 0x9e, 0xb2, 0xcd, 0x9e, 0x8e, 0x88, 0x99, 0x61, 0xf2, 0x21, 0x6c, 0xa8, 0x6e, 0x06,
-	0x3b, 0x8f, 0x5a, 0x5c, 0xbd, 0x8d, 0x2a, 0xa6, 0x87, 0x29, 0x83, 0x4a, 0xac, 0x7e, 0xd5, 0xaf,
-	0x47, 0x00, 0x71, 0x68, 0xaa, 0x85, 0xa9, 0xb2, 0x14, 0x27, 0x15, 0xad, 0x4e, 0x76, 0x1d, 0xed,
-	0x6f, 0x95, 0x4e, 0x73, 0x16, 0x5c, 0xf9, 0x52, 0x3c, 0x7b, 0x16, 0xc8, 0x71, 0xa6, 0x46, 0xb4,
-	0xd9, 0x92, 0x31, 0x4b, 0x3b, 0xb0, 0x39, 0xf0, 0x1d, 0xce, 0xf8, 0xf7, 0x58, 0x0e, 0xdc, 0x05,
-	0xf7, 0x97, 0x71, 0x0f, 0xa0, 0x49, 0xd5, 0x38, 0x2f, 0x02, 0xdf, 0xe3, 0xf1, 0x64, 0x27, 0x0c,
-	0xfa, 0x19, 0xac, 0x0f, 0xac, 0x05, 0x97, 0x2b, 0x29, 0x3b, 0x44, 0x1d, 0x13, 0x7e, 0x4b, 0x9d,
-	0x17, 0xea, 0xdc, 0xd6, 0x0b, 0x6c, 0x48, 0x6a, 0x43, 0x45, 0x4a, 0xe1, 0x5c, 0xbc, 0x9f, 0x92,
-	0x4c, 0xdc, 0x96, 0xc3, 0x5a, 0xcd, 0x2e, 0x94, 0xfd, 0x6b, 0x4f, 0x17, 0xb5, 0x3a, 0x53, 0x04,
-	0xd9, 0x87, 0x9a, 0xc3, 0x23, 0xe1, 0x7a, 0x96, 0x90, 0xc7, 0xb2, 0x6a, 0xbb, 0xd2, 0x2c, 0xda,
-	0x83, 0x9a, 0x3c, 0x08, 0x23, 0x9d, 0x0b, 0x2d, 0xa8, 0x78, 0xfe, 0xb1, 0xea, 0x0b, 0x8a, 0xea,
-	0x7c, 0x37, 0x34, 0x9e, 0xfd, 0x33, 0xff, 0x7a, 0xc8, 0xe7, 0x13, 0x7d, 0xa1, 0x88, 0x69, 0xfa,
-	0x1e, 0x54, 0x5f, 0x72, 0x73, 0x1c, 0x34, 0x61, 0xed, 0x92, 0xdf, 0xe2, 0x14, 0x57, 0x99, 0xfc,
-	0xa4, 0x7f, 0x29, 0x01, 0x0c, 0x79, 0x78, 0xc5, 0x43, 0x8c, 0xe6, 0x73, 0xd8, 0x88, 0x70, 0xdb,
-	0xeb, 0x65, 0x78, 0xcf, 0xe4, 0x4d, 0x0c, 0x39, 0x54, 0x65, 0xa1, 0xe7, 0x89, 0xf0, 0x96, 0x69,
-	0xb0, 0x14, 0xb3, 0x7d, 0x6f, 0xe2, 0x9a, 0x2c, 0xca, 0x11, 0xeb, 0xe2, 0xb8, 0x16, 0x53, 0xe0,
-	0xd6, 0x2f, 0xa1, 0x96, 0xd2, 0x96, 0x78, 0x57, 0xd4, 0xde, 0x25, 0x2d, 0x60, 0x29, 0xd5, 0x2a,
-	0xfe, 0xaa, 0xf4, 0x65, 0xb1, 0x75, 0x02, 0xb5, 0x94, 0xc6, 0x1c, 0xd1, 0x0f, 0xd3, 0xa2, 0xc9,
-	0xa1, 0xa6, 0x84, 0xfa, 0x82, 0x2f, 0x52, 0xda, 0xe8, 0x0f, 0xb2, 0x29, 0x34, 0x03, 0xa4, 0x0d,
-	0xe5, 0x20, 0xf4, 0x83, 0x48, 0x07, 0xf3, 0xee, 0x1d, 0xd1, 0xc3, 0x33, 0x39, 0xac, 0x62, 0x51,
-	0xd0, 0x96, 0xec, 0x17, 0x62, 0xe6, 0xdb, 0x44, 0x42, 0x9f, 0x42, 0xb5, 0x77, 0xc5, 0x3d, 0x61,
-	0x4e, 0x53, 0x2e, 0x89, 0xd5, 0xd3, 0x14, 0x11, 0x4c, 0x8f, 0xd1, 0x3e, 0x34, 0xba, 0x99, 0xfb,
-	0x2c, 0x81, 0x75, 0x89, 0x33, 0xe9, 0x2b, 0xbf, 0x25, 0x0f, 0x2f, 0xac, 0xca, 0x20, 0x7e, 0x4b,
-	0xbf, 0x2e, 0x02, 0x59, 0x19, 0x71, 0xfd, 0x2f, 0x82, 0x88, 0x7e, 0x08, 0xf7, 0x7a, 0x9e, 0xe0,
-	0x61, 0x10, 0xba, 0x11, 0x57, 0x11, 0xbe, 0xe4, 0x39, 0x01, 0xd0, 0x13, 0x68, 0xae, 0x02, 0x73,
-	0xc2, 0xdc, 0x82, 0x92, 0xef, 0xe9, 0x1c, 0x2c, 0xf9, 0x9e, 0xdc, 0xf9, 0x18, 0xa9, 0xb1, 0xa9,
-	0xa9, 0x27, 0xff, 0x28, 0x9a, 0xe3, 0x54, 0xbf, 0x00, 0x54, 0xa1, 0x3c, 0x3a, 0x1f, 0x9f, 0xbe,
-	0x6c, 0x16, 0xc8, 0x2e, 0x34, 0x47, 0xe7, 0xe3, 0xc1, 0xe9, 0xa0, 0xdb, 0x1b, 0x8f, 0x4e, 0x4f,
-	0xc7, 0x27, 0xa7, 0x7f, 0x68, 0x16, 0xc9, 0x7d, 0xd8, 0x19, 0x9d, 0x8f, 0x3b, 0x27, 0xac, 0xd7,
-	0xf9, 0xe6, 0xbb, 0x71, 0xef, 0xbc, 0x3f, 0x1c, 0x0d, 0x9b, 0x25, 0x72, 0x0f, 0xb6, 0x47, 0xe7,
-	0xe3, 0xfe, 0xe0, 0x75, 0xe7, 0xa4, 0xff, 0xcd, 0xf8, 0xb8, 0x33, 0x3c, 0x6e, 0xae, 0xad, 0x30,
-	0x87, 0xfd, 0x17, 0x83, 0xe6, 0xba, 0x56, 0x60, 0x98, 0xcf, 0x4f, 0xd9, 0xab, 0xce, 0xa8, 0x59,
-	0x26, 0x3f, 0x82, 0x87, 0xc8, 0x1e, 0x7e, 0xfb, 0xfc, 0x79, 0xbf, 0xdb, 0xef, 0x0d, 0x46, 0xe3,
-	0xa3, 0xce, 0x49, 0x67, 0xd0, 0xed, 0x35, 0x37, 0xb4, 0xcc, 0x71, 0x67, 0x38, 0x1e, 0x76, 0x5e,
-	0xf5, 0x94, 0x4f, 0xcd, 0xcd, 0x58, 0xd5, 0xa8, 0xc7, 0x06, 0x9d, 0x93, 0x71, 0x8f, 0xb1, 0x53,
-	0xd6, 0xac, 0x3e, 0x99, 0x98, 0x83, 0x57, 0xc7, 0xb4, 0x0b, 0xcd, 0xd7, 0x3d, 0xd6, 0x7f, 0xfe,
-	0xdd, 0x78, 0x38, 0xea, 0x8c, 0xbe, 0x1d, 0xaa, 0xf0, 0xf6, 0xe1, 0xdd, 0x2c, 0x57, 0xfa, 0x37,
-	0x1e, 0x9c, 0x8e, 0xc6, 0xaf, 0x3a, 0xa3, 0xee, 0x71, 0xb3, 0x48, 0x1e, 0x41, 0x2b, 0x8b, 0xc8,
-	0x84, 0x57, 0x6a, 0xff, 0x9d, 0xc0, 0x76, 0x87, 0x87, 0x53, 0x9f, 0x9d, 0x75, 0xe5, 0x0e, 0x93,
-	0xb7, 0xda, 0xa7, 0x50, 0x95, 0xb5, 0x70, 0x88, 0x37, 0x08, 0x53, 0xed, 0x75, 0x75, 0x6c, 0xe5,
-	0x1c, 0x74, 0xb4, 0x40, 0x9e, 0xc2, 0xc6, 0x2b, 0x7c, 0xa5, 0x21, 0xe6, 0xa6, 0xa2, 0xc8, 0x88,
-	0xf1, 0xef, 0x97, 0x3c, 0x12, 0xad, 0xad, 0x2c, 0x9b, 0x16, 0xc8, 0xe7, 0x00, 0xc9, 0xdb, 0x0d,
-	0x89, 0x93, 0x53, 0xde, 0x05, 0x5b, 0x0f, 0xd3, 0xed, 0x53, 0xea, 0x71, 0x87, 0x16, 0xc8, 0xa7,
-	0x50, 0x7f, 0xc1, 0x45, 0xf2, 0x0c, 0x91, 0x15, 0xbc, 0xf3, 0x96, 0x42, 0x0b, 0xe4, 0x50, 0xbf,
-	0x5a, 0x48, 0x15, 0x2b, 0xf0, 0x9d, 0x34, 0x1c, 0x2f, 0xdd, 0xb4, 0x40, 0xbe, 0x86, 0xa6, 0xdc,
-	0x3f, 0xa9, 0x4e, 0x31, 0x22, 0x06, 0x98, 0xdc, 0x1f, 0x5a, 0x0f, 0xee, 0x76, 0x94, 0x72, 0x94,
-	0x16, 0xc8, 0x11, 0xec, 0xc4, 0x0a, 0xe2, 0x26, 0x35, 0x47, 0xc3, 0x5e, 0x5e, 0x93, 0xa8, 0x75,
-	0x3c, 0x85, 0xed, 0x58, 0xc7, 0x50, 0x84, 0xdc, 0x5a, 0xac, 0xb8, 0x9e, 0xe9, 0x8d, 0x69, 0xe1,
-	0xd3, 0x22, 0xe9, 0xc0, 0xc3, 0x3b, 0x66, 0x73, 0x45, 0x73, 0x9b, 0x53, 0x54, 0x71, 0x08, 0x95,
-	0x17, 0x5c, 0x69, 0x20, 0x39, 0x0b, 0xbd, 0x6a, 0x94, 0x7c, 0x05, 0x4d, 0x83, 0x4f, 0xba, 0xf1,
-	0x1c, 0xb9, 0x37, 0x58, 0x24, 0x5f, 0xe3, 0x62, 0xc6, 0x17, 0x0d, 0xf2, 0x60, 0xf5, 0x36, 0xa2,
-	0x67, 0xea, 0xfe, 0x5d, 0xfe, 0x94, 0x3b, 0xb4, 0x40, 0x0e, 0xa0, 0xfc, 0x82, 0x8b, 0xd1, 0x79,
-	0xae, 0xd5, 0xa4, 0x41, 0xa5, 0x05, 0xf2, 0x19, 0x80, 0x31, 0xf5, 0x06, 0x78, 0x33, 0x86, 0xf7,
-	0x3d, 0x13, 0x60, 0x1b, 0xa5, 0x18, 0xb7, 0xb9, 0x1b, 0x88, 0x5c, 0x29, 0x93, 0xd8, 0x1a, 0x43,
-	0x0b, 0xf2, 0xea, 0xf1, 0x82, 0x8b, 0xce, 0x51, 0x3f, 0x17, 0x0f, 0xa6, 0x7d, 0x3d, 0xea, 0x2b,
-	0xec, 0x90, 0x7b, 0xce, 0xe8, 0x9c, 0x24, 0xce, 0xb6, 0xf2, 0x5a, 0x72, 0x2a, 0x37, 0xfb, 0xc6,
-	0xd0, 0x9d, 0x7a, 0x59, 0x6c, 0x26, 0xc6, 0x8f, 0xa1, 0xa2, 0x8a, 0x46, 0xbe, 0xbe, 0x74, 0x27,
-	0x8f, 0x33, 0x52, 0x51, 0x16, 0x46, 0xe7, 0xa4, 0x11, 0xa3, 0x65, 0x0a, 0xc5, 0xfb, 0x6f, 0xf5,
-	0xfa, 0x80, 0xbb, 0x49, 0xa6, 0x88, 0xaa, 0x0d, 0xff, 0x2d, 0x45, 0x10, 0x41, 0x0b, 0xe4, 0x77,
-	0x98, 0x22, 0x48, 0x75, 0x3c, 0xe7, 0x2c, 0xf4, 0xfd, 0x49, 0x5c, 0x23, 0xb2, 0x8f, 0x2f, 0xb1,
-	0x9f, 0x9a, 0x8d, 0x58, 0x5c, 0x83, 0x46, 0x37, 0xe4, 0x52, 0x5e, 0x3f, 0xc5, 0x24, 0xaf, 0x02,
-	0xea, 0x0e, 0xd1, 0x5a, 0xb9, 0x12, 0xe0, 0xf6, 0xa9, 0xc9, 0x35, 0x50, 0x74, 0xb4, 0x92, 0xff,
-	0x24, 0x0b, 0xd7, 0x81, 0x7d, 0x0a, 0xb5, 0x13, 0xdf, 0xbe, 0x7c, 0x0b, 0x23, 0x6d, 0x68, 0x7c,
-	0xeb, 0xcd, 0xdf, 0x4e, 0xe6, 0x0b, 0x68, 0xa8, 0x3b, 0x8a, 0x91, 0x31, 0x41, 0xa7, 0x6f, 0x2e,
-	0xf9, 0x72, 0xbd, 0x9b, 0xb4, 0xdc, 0x1d, 0x5b, 0xf9, 0x85, 0xf9, 0x2b, 0xb8, 0x9f, 0x91, 0x7b,
-	0xa9, 0xaf, 0x24, 0xff, 0xab, 0xfc, 0x33, 0x68, 0xfc, 0x7e, 0xc9, 0xc3, 0xdb, 0xae, 0xef, 0x89,
-	0xd0, 0xb2, 0x93, 0x02, 0x8a, 0xdc, 0x37, 0x08, 0x75, 0x80, 0x64, 0x84, 0x54, 0xb6, 0xec, 0xa4,
-	0x33, 0x43, 0x89, 0x3f, 0xb8, 0xc3, 0x32, 0x8b, 0xfe, 0x14, 0xd3, 0x0c, 0x9b, 0x56, 0x92, 0x7e,
-	0x2c, 0xd3, 0x2d, 0x6c, 0x2b, 0xfd, 0x32, 0x14, 0x2f, 0xa0, 0x14, 0x79, 0x8d, 0xed, 0xfd, 0x4e,
-	0xaa, 0xe5, 0x5f, 0x91, 0x30, 0xb7, 0x04, 0x2c, 0xd4, 0xdb, 0x49, 0x96, 0x28, 0xc1, 0xd5, 0xd4,
-	0x54, 0x4f, 0x72, 0xb1, 0xa3, 0x2b, 0x97, 0x23, 0x75, 0x8c, 0xa9, 0xfc, 0xc6, 0x2b, 0xd0, 0x1b,
-	0xc4, 0x57, 0xae, 0x4c, 0xb4, 0x40, 0x3e, 0xc1, 0x04, 0x8d, 0x3b, 0xff, 0x74, 0xaf, 0x1f, 0x7b,
-	0x6a, 0x46, 0x71, 0xf9, 0xf1, 0x38, 0xc0, 0xd6, 0x4d, 0xd7, 0x74, 0x13, 0xe2, 0x73, 0x77, 0x2e,
-	0x54, 0x5f, 0xdc, 0xca, 0x74, 0x78, 0x58, 0xd0, 0x9f, 0xa9, 0x27, 0x2f, 0x64, 0x44, 0x79, 0x22,
-	0xcd, 0xb4, 0x88, 0x9e, 0x96, 0x2f, 0xa0, 0x21, 0x43, 0x4a, 0x3a, 0x79, 0x03, 0x8a, 0x9b, 0xff,
-	0xf8, 0xe0, 0x4c, 0x40, 0xb4, 0x40, 0xbe, 0xc4, 0xad, 0x9e, 0xed, 0x26, 0xf3, 0x4f, 0x9e, 0x0c,
-	0x86, 0x16, 0xc8, 0x09, 0xdc, 0x7b, 0xc1, 0xc5, 0x9d, 0x9e, 0xb0, 0x65, 0x84, 0xef, 0x76, 0x95,
-	0x71, 0x89, 0x5a, 0x1d, 0xa3, 0x05, 0x72, 0x0c, 0xf7, 0x95, 0x1f, 0x93, 0xee, 0xcc, 0xf2, 0xa6,
-	0xfc, 0x2c, 0xf4, 0xa7, 0xf8, 0xb0, 0x9a, 0x57, 0xaf, 0xde, 0x49, 0x75, 0xe4, 0x59, 0x38, 0x2d,
-	0x5c, 0x6c, 0xe0, 0x7f, 0xa6, 0x67, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0xc7, 0x7d, 0x4d, 0x37,
-	0xcd, 0x1a, 0x00, 0x00,
+func init() { proto.RegisterFile("rpc.proto", fileDescriptor_rpc_0045ed5d8b4b9394) }
+
+var fileDescriptor_rpc_0045ed5d8b4b9394 = []byte{
+	// 3069 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x3a, 0x6d, 0x73, 0xdb, 0xc6,
+	0xd1, 0x0f, 0x29, 0x92, 0x22, 0x97, 0xa4, 0x44, 0x9d, 0x65, 0x9b, 0xe1, 0xe3, 0x38, 0x7a, 0xf0,
+	0xb8, 0x8e, 0xe2, 0x38, 0xb2, 0x2d, 0xbb, 0x6d, 0x9a, 0xc6, 0x49, 0x68, 0x9a, 0xb6, 0x58, 0xcb,
+	0x92, 0x73, 0xa4, 0x5d, 0x65, 0xa6, 0x53, 0x16, 0x02, 0x8e, 0x14, 0xc6, 0x24, 0x00, 0x03, 0x47,
+	0x4b, 0x4a, 0xa7, 0x9f, 0xfa, 0xa9, 0xff, 0xa0, 0xbf, 0xa3, 0xd3, 0x2f, 0xfd, 0x1b, 0xfd, 0x03,
+	0xed, 0x1f, 0xe9, 0x4c, 0x67, 0xef, 0x05, 0x38, 0x50, 0x50, 0x27, 0xe9, 0x37, 0xec, 0xde, 0xbe,
+	0xdf, 0xde, 0xde, 0xee, 0x0d, 0xa0, 0x16, 0x85, 0xce, 0x4e, 0x18, 0x05, 0x3c, 0x20, 0x65, 0x7e,
+	0x1e, 0xb2, 0xb8, 0xd3, 0x3a, 0x9e, 0x05, 0xce, 0x5b, 0xe7, 0xc4, 0xf6, 0x7c, 0xb9, 0xd0, 0x69,
+	0xda, 0x8e, 0x13, 0x2c, 0x7c, 0xae, 0x40, 0xf0, 0x03, 0x97, 0xa9, 0xef, 0x5a, 0xb8, 0x1b, 0xaa,
+	0xcf, 0xc6, 0x9c, 0xf1, 0xc8, 0x73, 0x34, 0x51, 0x64, 0x4f, 0x14, 0x83, 0xf5, 0xcf, 0x02, 0xb4,
+	0x9e, 0x24, 0x42, 0x87, 0xdc, 0xe6, 0x8b, 0x98, 0xdc, 0x86, 0xf5, 0x63, 0x16, 0xf3, 0xb1, 0xd0,
+	0x36, 0x3e, 0xb1, 0xe3, 0x93, 0x76, 0x61, 0xab, 0xb0, 0xdd, 0xa0, 0x4d, 0x44, 0x0b, 0xf2, 0x3d,
+	0x3b, 0x3e, 0x21, 0x1f, 0x41, 0x5d, 0xd0, 0x9d, 0x30, 0x6f, 0x7a, 0xc2, 0xdb, 0xc5, 0xad, 0xc2,
+	0x76, 0x89, 0x02, 0xa2, 0xf6, 0x04, 0x86, 0xfc, 0x04, 0xd6, 0x9c, 0xc0, 0x8f, 0x99, 0x1f, 0x2f,
+	0xe2, 0xb1, 0xe7, 0x4f, 0x82, 0xf6, 0xca, 0x56, 0x61, 0xbb, 0x46, 0x9b, 0x09, 0x76, 0xe0, 0x4f,
+	0x02, 0xf2, 0x29, 0x10, 0x21, 0x47, 0xd8, 0x30, 0xf6, 0x5c, 0xa9, 0xb2, 0x24, 0x54, 0x0a, 0x4b,
+	0x7a, 0xb8, 0x30, 0x70, 0x85, 0xd2, 0x7b, 0x00, 0x8a, 0x0e, 0xe5, 0x95, 0xb7, 0x0a, 0xdb, 0xf5,
+	0xdd, 0xd6, 0x8e, 0x88, 0xcf, 0x8e, 0xa4, 0xf3, 0x27, 0x01, 0xad, 0x39, 0xfa, 0xd3, 0xfa, 0x53,
+	0x01, 0x56, 0x95, 0x00, 0xb2, 0x09, 0xe5, 0xb9, 0x3d, 0xf5, 0x1c, 0xe1, 0x4f, 0x8d, 0x4a, 0x80,
+	0x5c, 0x83, 0x4a, 0xb8, 0x38, 0x9e, 0x79, 0x8e, 0x70, 0xa1, 0x4a, 0x15, 0x44, 0xda, 0xb0, 0x3a,
+	0xb7, 0x3d, 0xdf, 0x67, 0x5c, 0xd8, 0x5d, 0xa5, 0x1a, 0x24, 0x37, 0xa0, 0x96, 0xb8, 0x20, 0x0c,
+	0xad, 0xd1, 0x14, 0x81, 0x7c, 0xef, 0x59, 0x14, 0x7b, 0x81, 0x2f, 0xec, 0x2b, 0x53, 0x0d, 0x5a,
+	0xff, 0x28, 0x42, 0x2d, 0x31, 0x92, 0xdc, 0x84, 0xa2, 0xe7, 0x0a, 0x53, 0xea, 0xbb, 0x6b, 0x19,
+	0x17, 0x5c, 0x5a, 0xf4, 0x5c, 0xd2, 0x81, 0xea, 0x71, 0x78, 0xb0, 0x98, 0x1f, 0xb3, 0x48, 0x58,
+	0xd6, 0xa4, 0x09, 0x4c, 0x2c, 0x68, 0xcc, 0xed, 0x33, 0xb1, 0x43, 0xb1, 0xf7, 0x3d, 0x13, 0x06,
+	0x96, 0x68, 0x06, 0x87, 0x56, 0xce, 0xed, 0x33, 0x1e, 0xbc, 0x65, 0x7e, 0xac, 0xc2, 0x99, 0x22,
+	0xc8, 0x6d, 0x58, 0x8b, 0xb9, 0xfd, 0xd6, 0xf3, 0xa7, 0x73, 0xcf, 0xf7, 0xe6, 0x8b, 0xb9, 0x30,
+	0xb6, 0x41, 0x97, 0xb0, 0xa8, 0x89, 0x07, 0xdc, 0x9e, 0x29, 0x74, 0xbb, 0x22, 0xa8, 0x32, 0x38,
+	0xb4, 0x74, 0x6a, 0xc7, 0x61, 0xe4, 0x39, 0xac, 0xbd, 0x2a, 0xd6, 0x13, 0x18, 0xad, 0xf0, 0xed,
+	0x39, 0x93, 0x8b, 0x55, 0x69, 0x45, 0x82, 0x20, 0x77, 0xa0, 0x25, 0x24, 0xbd, 0x0f, 0xb8, 0xe7,
+	0x4f, 0xc3, 0xe0, 0x94, 0x45, 0xed, 0x9a, 0x20, 0xba, 0x80, 0x47, 0x4b, 0x24, 0x18, 0xb1, 0x53,
+	0x3b, 0x72, 0xdb, 0x20, 0x2d, 0x31, 0x71, 0xd6, 0x2d, 0x80, 0x9e, 0x4e, 0xe5, 0x18, 0x77, 0x36,
+	0x62, 0x61, 0x10, 0x71, 0xb5, 0xe1, 0x0a, 0xb2, 0x1c, 0x28, 0x0f, 0xfc, 0x70, 0xc1, 0x09, 0x81,
+	0x92, 0x91, 0xdf, 0xe2, 0x1b, 0xb7, 0xcf, 0x76, 0xdd, 0x88, 0xc5, 0x71, 0xbb, 0xb8, 0xb5, 0xb2,
+	0xdd, 0xa0, 0x1a, 0xc4, 0xf4, 0x79, 0x6f, 0xcf, 0x16, 0x32, 0xda, 0x0d, 0x2a, 0x01, 0x54, 0x12,
+	0x3b, 0x91, 0x17, 0x72, 0x15, 0x63, 0x05, 0x59, 0x13, 0xa8, 0x1c, 0x2e, 0x38, 0x6a, 0xd9, 0x84,
+	0xb2, 0xe7, 0xbb, 0xec, 0x4c, 0xa8, 0x69, 0x52, 0x09, 0x64, 0xf5, 0x14, 0xfe, 0x7b, 0x3d, 0xab,
+	0x50, 0xee, 0xcf, 0x43, 0x7e, 0x6e, 0xfd, 0x3f, 0xd4, 0x87, 0x9e, 0x3f, 0x9d, 0xb1, 0x27, 0xe7,
+	0x9c, 0x19, 0x52, 0x0a, 0x86, 0x14, 0xeb, 0x16, 0x34, 0x24, 0xd1, 0x90, 0x47, 0xb8, 0x75, 0x19,
+	0xaa, 0x9a, 0xa6, 0xba, 0x0d, 0x6b, 0x5d, 0x59, 0x59, 0xba, 0xcb, 0x36, 0x65, 0xa4, 0xfd, 0x36,
+	0xa5, 0xf3, 0x5d, 0x1a, 0x04, 0x1c, 0xbd, 0x52, 0x18, 0x45, 0xa9, 0x41, 0x8c, 0x35, 0x52, 0x28,
+	0x67, 0xc5, 0x37, 0xb9, 0x09, 0xd0, 0x0b, 0xe6, 0x21, 0x6a, 0x60, 0xae, 0x3a, 0x65, 0x06, 0xc6,
+	0xfa, 0x57, 0x11, 0x4a, 0xaf, 0x18, 0x8b, 0xc8, 0xdd, 0x34, 0x58, 0xf2, 0xc0, 0x10, 0x75, 0x60,
+	0x70, 0x55, 0xd9, 0x98, 0x06, 0xf0, 0x21, 0xd4, 0xb0, 0x6e, 0x88, 0xa3, 0x20, 0xf4, 0xd5, 0x77,
+	0xaf, 0x2a, 0xfa, 0x03, 0x76, 0x2a, 0x2a, 0xd8, 0x41, 0xc0, 0x3d, 0x87, 0xd1, 0x94, 0x0e, 0x3d,
+	0x8c, 0xb9, 0xcd, 0x65, 0xd4, 0xcb, 0x54, 0x02, 0x18, 0xf5, 0x13, 0xcf, 0x75, 0x99, 0x2f, 0xa2,
+	0x5e, 0xa5, 0x0a, 0xc2, 0xb4, 0x9e, 0xd9, 0xf1, 0x49, 0xef, 0x84, 0x39, 0x6f, 0xc5, 0xc9, 0x59,
+	0xa1, 0x29, 0x02, 0x0f, 0x44, 0xcc, 0x66, 0x93, 0x90, 0xb1, 0x48, 0x1c, 0x98, 0x2a, 0x4d, 0x60,
+	0xb3, 0x3c, 0xac, 0x8a, 0x98, 0x6b, 0x90, 0xfc, 0x12, 0x1a, 0x0e, 0x8b, 0xb8, 0x37, 0xf1, 0x1c,
+	0x9b, 0xb3, 0xb8, 0x5d, 0xdd, 0x5a, 0xd9, 0xae, 0xef, 0x5e, 0x57, 0x96, 0x77, 0xa7, 0xcc, 0xe7,
+	0xbd, 0x74, 0x9d, 0x66, 0x88, 0xc9, 0x43, 0x68, 0xd8, 0x8e, 0xc3, 0x42, 0xce, 0x5c, 0x1a, 0xcc,
+	0x98, 0x38, 0x45, 0x6b, 0xbb, 0xeb, 0x46, 0x98, 0x10, 0x4d, 0x33, 0x44, 0x18, 0xff, 0x88, 0x85,
+	0x0b, 0x6e, 0x73, 0x34, 0x07, 0x84, 0xe3, 0x06, 0xc6, 0xfa, 0x0c, 0xaa, 0xc8, 0xb9, 0xef, 0xc5,
+	0x9c, 0xfc, 0x1f, 0x94, 0xd1, 0x7e, 0xdc, 0x00, 0x34, 0xab, 0x6e, 0x4a, 0x96, 0x2b, 0xd6, 0x7b,
+	0x00, 0x24, 0x7d, 0x65, 0x47, 0xf6, 0x3c, 0xce, 0x3d, 0x5c, 0x18, 0x4e, 0xf3, 0xba, 0x50, 0x10,
+	0xd2, 0x26, 0x75, 0xac, 0x49, 0xc5, 0x37, 0xd2, 0x06, 0x93, 0x49, 0xcc, 0x64, 0xc2, 0x37, 0xa9,
+	0x82, 0x48, 0x0b, 0x56, 0xec, 0xd8, 0x11, 0x41, 0xaf, 0x52, 0xfc, 0xb4, 0x3e, 0x07, 0x78, 0x65,
+	0x4f, 0x99, 0xd2, 0x9b, 0xf2, 0x15, 0x32, 0x7c, 0x5a, 0x47, 0x31, 0xd5, 0x61, 0x9d, 0xc1, 0x9a,
+	0x48, 0x87, 0x27, 0x81, 0x7b, 0x8e, 0x22, 0xc4, 0x1d, 0x21, 0x2a, 0x8f, 0x3e, 0xac, 0x02, 0x30,
+	0x64, 0x16, 0x73, 0x65, 0x9a, 0x76, 0xdf, 0x82, 0xd2, 0x71, 0xe0, 0x9e, 0xb7, 0x4b, 0x99, 0xcb,
+	0x29, 0x51, 0x43, 0xc5, 0xaa, 0xf5, 0x3b, 0x58, 0x37, 0x34, 0x0b, 0xc3, 0x2d, 0x68, 0x60, 0x90,
+	0x82, 0xc8, 0x97, 0x45, 0x5f, 0x06, 0x2e, 0x83, 0x23, 0x9f, 0x40, 0x25, 0xb4, 0xa7, 0x58, 0x88,
+	0x65, 0x5e, 0x6f, 0xe8, 0x6d, 0x48, 0xfc, 0xa7, 0x8a, 0xc0, 0xfa, 0xb9, 0xd2, 0xb0, 0xc7, 0x6c,
+	0x57, 0xed, 0xe1, 0x2d, 0xa8, 0xc8, 0xfb, 0x41, 0x6d, 0x62, 0xc3, 0x34, 0x8e, 0xaa, 0x35, 0xeb,
+	0x0f, 0xd0, 0x14, 0x88, 0x97, 0x8c, 0xdb, 0xae, 0xcd, 0xed, 0xdc, 0x9d, 0xbc, 0x83, 0x3b, 0x89,
+	0x82, 0xdb, 0xc5, 0xcc, 0x81, 0x34, 0x54, 0x52, 0x45, 0x81, 0x29, 0xcf, 0xcf, 0x64, 0x51, 0x90,
+	0x87, 0x4b, 0x83, 0x49, 0xfc, 0x4a, 0xe2, 0x04, 0xc9, 0x3d, 0xe9, 0xc2, 0x46, 0x46, 0xbd, 0xb0,
+	0xfc, 0xee, 0x92, 0xe5, 0x9b, 0xa6, 0x3a, 0x4d, 0x99, 0x78, 0xc0, 0xa0, 0xd1, 0x0b, 0xe6, 0x73,
+	0x8f, 0x53, 0x16, 0x2f, 0x66, 0xf9, 0x75, 0xfe, 0x13, 0x28, 0xb3, 0x28, 0x0a, 0xa4, 0xfd, 0x6b,
+	0xbb, 0x57, 0xf4, 0x0d, 0x2c, 0xf8, 0x64, 0x2b, 0x44, 0x25, 0x05, 0xee, 0xbe, 0xcb, 0xb8, 0xed,
+	0xcd, 0x54, 0x03, 0xa3, 0x20, 0xab, 0x0b, 0x2d, 0x53, 0x8d, 0x30, 0xf4, 0x33, 0x58, 0x8d, 0x04,
+	0xa4, 0x2d, 0xcd, 0x0a, 0x96, 0x94, 0x54, 0xd3, 0x58, 0x23, 0x68, 0xbc, 0x61, 0x91, 0x37, 0x39,
+	0x57, 0x96, 0x7e, 0x00, 0x45, 0x7e, 0xa6, 0x6a, 0x5c, 0x4d, 0x71, 0x8e, 0xce, 0x68, 0x91, 0x9f,
+	0x5d, 0x66, 0xb0, 0x64, 0xcf, 0x18, 0x6c, 0x8d, 0xf0, 0xdc, 0x46, 0x71, 0xe0, 0xdb, 0x33, 0x3c,
+	0xe3, 0xa1, 0x1d, 0xc7, 0xe1, 0x49, 0x64, 0xc7, 0xba, 0xcc, 0x1b, 0x18, 0xb2, 0x0d, 0xab, 0xaa,
+	0x8b, 0x6c, 0x17, 0x33, 0xbd, 0x88, 0x2a, 0xdc, 0x54, 0x2f, 0x5b, 0x7f, 0x2e, 0x40, 0x63, 0x30,
+	0xc7, 0x1b, 0xf4, 0x59, 0x10, 0xcd, 0x6d, 0x4c, 0xa7, 0x95, 0x53, 0x6f, 0xb2, 0x54, 0x91, 0x8d,
+	0x3b, 0x88, 0xe2, 0x32, 0xee, 0x7e, 0x30, 0x73, 0x51, 0xa3, 0x50, 0x50, 0xa3, 0x1a, 0xc4, 0x15,
+	0x9f, 0x9d, 0x8a, 0x15, 0x19, 0x58, 0x0d, 0x92, 0x1d, 0xa8, 0xbe, 0x65, 0xe7, 0x31, 0x0f, 0x22,
+	0xd6, 0x2e, 0x5d, 0x2a, 0x3e, 0xa1, 0xb1, 0x18, 0xac, 0x0e, 0x55, 0x33, 0x72, 0x0d, 0x2a, 0xf6,
+	0xdc, 0xb8, 0x80, 0x14, 0x84, 0x39, 0x70, 0x7a, 0xc2, 0x7c, 0x55, 0x78, 0xc4, 0x37, 0xd9, 0x81,
+	0xda, 0xc2, 0x3f, 0x0e, 0x7c, 0x17, 0x0f, 0xd4, 0xca, 0xd6, 0x8a, 0x71, 0x5e, 0x5f, 0x6b, 0x3c,
+	0x4d, 0x49, 0xac, 0xc7, 0x50, 0x4b, 0xf0, 0x97, 0x2a, 0x6a, 0x63, 0x06, 0xcc, 0x18, 0x46, 0x5b,
+	0xea, 0xd2, 0xa0, 0xf5, 0x25, 0x94, 0xde, 0x04, 0x5c, 0xf4, 0x44, 0x8e, 0xed, 0xbb, 0x9e, 0x8b,
+	0xd7, 0x8d, 0x64, 0x4e, 0x11, 0x86, 0xdc, 0xa2, 0x29, 0xd7, 0xda, 0x05, 0x40, 0x6e, 0x55, 0x2c,
+	0xd6, 0x92, 0xee, 0xb1, 0x26, 0xba, 0xc5, 0x4d, 0x28, 0xa7, 0x9b, 0xd8, 0xa4, 0x12, 0xb0, 0x5c,
+	0x58, 0x57, 0xdb, 0x88, 0xac, 0xa2, 0xed, 0xdc, 0x86, 0x55, 0xdd, 0xcb, 0x65, 0x7b, 0x4f, 0x15,
+	0x40, 0xaa, 0x97, 0xc9, 0xc7, 0x50, 0x91, 0xcd, 0x95, 0x68, 0x84, 0xea, 0xc9, 0x65, 0xa2, 0x45,
+	0x51, 0xb5, 0x6c, 0x51, 0xa8, 0x26, 0xe2, 0x97, 0xed, 0xba, 0x09, 0x90, 0xb8, 0x26, 0x3b, 0xaa,
+	0x1a, 0x35, 0x30, 0x86, 0xb7, 0xea, 0x6c, 0x29, 0x6f, 0x1f, 0x4b, 0x99, 0xfa, 0xea, 0x79, 0x1f,
+	0x70, 0xa6, 0x4f, 0x54, 0xdd, 0xb0, 0x83, 0xca, 0x15, 0xa5, 0xb6, 0xa8, 0xd5, 0x5a, 0x5d, 0x58,
+	0x3d, 0x08, 0x5c, 0x46, 0xd9, 0x3b, 0x51, 0x7d, 0xbc, 0x39, 0x0b, 0x16, 0x49, 0x4b, 0xa2, 0x40,
+	0xd9, 0xc7, 0xcf, 0xc3, 0xc0, 0x67, 0x49, 0xb0, 0x53, 0x84, 0xf5, 0x08, 0x4a, 0x07, 0xf6, 0x9c,
+	0x61, 0xe2, 0x60, 0xc3, 0xaa, 0x7c, 0x12, 0xdf, 0x28, 0xf3, 0x58, 0xb6, 0x11, 0x7a, 0x8f, 0x15,
+	0x68, 0xfd, 0x1e, 0xaa, 0xc8, 0x25, 0x62, 0xf1, 0x91, 0xc1, 0x99, 0x9a, 0x8d, 0xcb, 0x4a, 0xcc,
+	0x26, 0x94, 0x83, 0x53, 0x5f, 0xd5, 0xd0, 0x06, 0x95, 0x00, 0xd9, 0x82, 0xba, 0xcb, 0x62, 0xee,
+	0xf9, 0xf2, 0x5a, 0x96, 0x5d, 0xa0, 0x89, 0xc2, 0xa0, 0xb1, 0xb3, 0xd0, 0x53, 0x87, 0xa3, 0x44,
+	0x15, 0x64, 0xf5, 0xa1, 0x8e, 0xf7, 0x71, 0xac, 0x72, 0xa4, 0x03, 0x55, 0x3f, 0xd8, 0x93, 0xed,
+	0x4b, 0x41, 0xb6, 0x21, 0x1a, 0xc6, 0xb5, 0xf8, 0x24, 0x38, 0x1d, 0xb2, 0xd9, 0x44, 0xcd, 0x3d,
+	0x09, 0x6c, 0x7d, 0x08, 0xb5, 0x17, 0x4c, 0xdf, 0x4a, 0x2d, 0x58, 0x79, 0xcb, 0xce, 0x45, 0xe8,
+	0x6b, 0x14, 0x3f, 0xad, 0x3f, 0x16, 0x01, 0x86, 0x2c, 0x7a, 0xcf, 0x22, 0xe1, 0xe5, 0x4f, 0xa1,
+	0x12, 0x8b, 0xea, 0xa3, 0xb6, 0xe7, 0x43, 0x9d, 0x4f, 0x09, 0xc9, 0x8e, 0xac, 0x4e, 0x7d, 0x9f,
+	0x47, 0xe7, 0x54, 0x11, 0x23, 0x9b, 0x13, 0xf8, 0x13, 0x4f, 0x67, 0x57, 0x0e, 0x5b, 0x4f, 0xac,
+	0x2b, 0x36, 0x49, 0xdc, 0xf9, 0x05, 0xd4, 0x0d, 0x69, 0xa9, 0x75, 0x05, 0x65, 0x5d, 0xda, 0xa9,
+	0x16, 0x8d, 0x8e, 0xf6, 0x8b, 0xe2, 0xe7, 0x85, 0xce, 0x3e, 0xd4, 0x0d, 0x89, 0x39, 0xac, 0x1f,
+	0x9b, 0xac, 0xe9, 0xdd, 0x2a, 0x99, 0x06, 0x9c, 0xcd, 0x0d, 0x69, 0xd6, 0xf7, 0x00, 0xe9, 0x02,
+	0xd9, 0x85, 0x72, 0x18, 0x05, 0x61, 0xac, 0x9c, 0xb9, 0x71, 0x81, 0x75, 0xe7, 0x15, 0x2e, 0x4b,
+	0x5f, 0x24, 0x69, 0x07, 0xdb, 0x96, 0x04, 0xf9, 0x63, 0x3c, 0xb1, 0x1e, 0x40, 0xad, 0xff, 0x9e,
+	0xf9, 0x5c, 0x5f, 0xea, 0x0c, 0x81, 0xe5, 0x4b, 0x5d, 0x50, 0x50, 0xb5, 0x66, 0x0d, 0xa0, 0xd9,
+	0xcb, 0x8c, 0xdd, 0x04, 0x4a, 0x48, 0xa7, 0xd3, 0x1a, 0xbf, 0x11, 0x27, 0xe6, 0x6a, 0xa9, 0x50,
+	0x7c, 0xa3, 0x5d, 0xc7, 0x61, 0x2c, 0xaa, 0x63, 0x8d, 0xe2, 0xa7, 0xf5, 0x31, 0x5c, 0xe9, 0xfb,
+	0x9c, 0x45, 0x61, 0xe4, 0xc5, 0x4c, 0x7a, 0xf8, 0x82, 0xe5, 0x38, 0x60, 0xed, 0x43, 0x6b, 0x99,
+	0x30, 0xc7, 0xcd, 0x35, 0x28, 0x06, 0xbe, 0xca, 0xc1, 0xa2, 0x4c, 0x6e, 0xe1, 0xa9, 0xd6, 0xa9,
+	0x20, 0xeb, 0x2f, 0x45, 0x68, 0x60, 0xbc, 0x82, 0xd8, 0x9e, 0xe5, 0x96, 0x1a, 0x79, 0x6e, 0xc4,
+	0x54, 0xe4, 0x29, 0x89, 0x35, 0x6a, 0xa2, 0x30, 0xe9, 0x43, 0x21, 0x81, 0x45, 0xea, 0x58, 0x25,
+	0x30, 0xaa, 0xe5, 0x76, 0x34, 0x55, 0xed, 0x66, 0x8d, 0x2a, 0x28, 0xdd, 0x85, 0xb2, 0xb1, 0x0b,
+	0x58, 0x3a, 0xc4, 0x89, 0x9f, 0x44, 0xc1, 0x5c, 0xb4, 0xf8, 0x25, 0x9a, 0x22, 0x92, 0xf2, 0xc0,
+	0x83, 0xf6, 0xaa, 0x51, 0x1e, 0x78, 0x80, 0x5a, 0xde, 0x2d, 0x82, 0x68, 0x31, 0x17, 0xb3, 0x70,
+	0x93, 0x2a, 0x08, 0x2d, 0xc3, 0xaa, 0x84, 0x54, 0xa2, 0x75, 0x2f, 0xd1, 0x04, 0xc6, 0x35, 0x76,
+	0xc6, 0x9c, 0x05, 0x67, 0x72, 0xe8, 0xad, 0xd2, 0x04, 0x5e, 0x2a, 0xaf, 0xf5, 0xe5, 0xf2, 0x6a,
+	0x75, 0xd3, 0x98, 0x89, 0x64, 0x79, 0x00, 0xb5, 0x50, 0xc1, 0xcb, 0x0d, 0x8a, 0x19, 0x5b, 0x9a,
+	0x52, 0xe1, 0xb5, 0xbf, 0xd6, 0x0b, 0x7c, 0x1e, 0xd9, 0x0e, 0x1f, 0x06, 0x8b, 0xc8, 0xc1, 0x9e,
+	0x61, 0xdd, 0x51, 0x98, 0xae, 0x31, 0x96, 0x35, 0xe8, 0x32, 0x1a, 0xfd, 0x8d, 0x05, 0x8f, 0xda,
+	0x8e, 0x4a, 0x6c, 0x48, 0x98, 0x87, 0xde, 0x8c, 0x45, 0x6f, 0xd4, 0x34, 0x24, 0xeb, 0xff, 0x32,
+	0x1a, 0xbd, 0x77, 0x02, 0x97, 0xed, 0xa5, 0x8f, 0x42, 0x09, 0x6c, 0x3d, 0x02, 0x18, 0xd9, 0xc7,
+	0x33, 0x26, 0xc7, 0xfd, 0xbc, 0x42, 0x4d, 0xa0, 0x14, 0x05, 0xa7, 0xb1, 0xbe, 0xf5, 0xf1, 0xdb,
+	0xfa, 0x6b, 0x01, 0xd6, 0xb5, 0x43, 0x4f, 0x9f, 0x48, 0xde, 0x1f, 0xee, 0x91, 0x39, 0x46, 0x94,
+	0x54, 0xcb, 0x7f, 0x03, 0x6a, 0xa1, 0x3d, 0x65, 0xbd, 0xe4, 0x1e, 0x2b, 0xd1, 0x14, 0x21, 0xb2,
+	0xce, 0x9e, 0xb2, 0xa1, 0x6e, 0x74, 0x4b, 0x34, 0x81, 0xb1, 0x9f, 0xe7, 0xe8, 0x41, 0xdc, 0x2e,
+	0x6f, 0xad, 0x18, 0x35, 0x27, 0x75, 0x8b, 0x2a, 0x02, 0xeb, 0x7b, 0xa8, 0x0e, 0xbf, 0xdd, 0xff,
+	0x76, 0xc1, 0xa2, 0xf3, 0x1f, 0x61, 0xee, 0x26, 0x94, 0xdf, 0x21, 0x8b, 0x2e, 0x22, 0x02, 0x30,
+	0xef, 0xaf, 0x95, 0xcc, 0xfd, 0x85, 0xf4, 0x33, 0x6f, 0xee, 0xe9, 0xa1, 0x4b, 0x02, 0xd6, 0x6f,
+	0x60, 0x4d, 0xeb, 0x56, 0x8d, 0x6a, 0x1b, 0x56, 0x9d, 0x60, 0xb6, 0x98, 0xfb, 0xb1, 0xba, 0x1a,
+	0x34, 0x68, 0x84, 0x1c, 0xd1, 0xe2, 0x1b, 0x03, 0xc4, 0xa3, 0x85, 0x8f, 0xa3, 0xaa, 0x9e, 0xf3,
+	0x53, 0xc4, 0x9d, 0xbf, 0x17, 0x74, 0xbf, 0xae, 0x9e, 0x20, 0x6b, 0x50, 0x1e, 0x1d, 0x8d, 0x0f,
+	0x5f, 0xb4, 0xfe, 0x87, 0x6c, 0x42, 0x6b, 0x74, 0x34, 0x3e, 0x38, 0x3c, 0xe8, 0xf5, 0xc7, 0xa3,
+	0xc3, 0xc3, 0xf1, 0xfe, 0xe1, 0xaf, 0x5b, 0x05, 0x72, 0x15, 0x36, 0x46, 0x47, 0xe3, 0xee, 0x3e,
+	0xed, 0x77, 0x9f, 0x7e, 0x37, 0xee, 0x1f, 0x0d, 0x86, 0xa3, 0x61, 0xab, 0x48, 0xae, 0xc0, 0xfa,
+	0xe8, 0x68, 0x3c, 0x38, 0x78, 0xd3, 0xdd, 0x1f, 0x3c, 0x1d, 0xef, 0x75, 0x87, 0x7b, 0xad, 0x95,
+	0x25, 0xe4, 0x70, 0xf0, 0xfc, 0xa0, 0x55, 0x52, 0x02, 0x34, 0xf2, 0xd9, 0x21, 0x7d, 0xd9, 0x1d,
+	0xb5, 0xca, 0xe4, 0x7f, 0xe1, 0xba, 0x40, 0x0f, 0x5f, 0x3f, 0x7b, 0x36, 0xe8, 0x0d, 0xfa, 0x07,
+	0xa3, 0xf1, 0x93, 0xee, 0x7e, 0xf7, 0xa0, 0xd7, 0x6f, 0x55, 0x14, 0xcf, 0x5e, 0x77, 0x38, 0x1e,
+	0x76, 0x5f, 0xf6, 0xa5, 0x4d, 0xad, 0xd5, 0x44, 0xd4, 0xa8, 0x4f, 0x0f, 0xba, 0xfb, 0xe3, 0x3e,
+	0xa5, 0x87, 0xb4, 0x55, 0xbb, 0x33, 0xd1, 0x9d, 0xbd, 0xf2, 0x69, 0x13, 0x5a, 0x6f, 0xfa, 0x74,
+	0xf0, 0xec, 0xbb, 0xf1, 0x70, 0xd4, 0x1d, 0xbd, 0x1e, 0x4a, 0xf7, 0xb6, 0xe0, 0x46, 0x16, 0x8b,
+	0xf6, 0x8d, 0x0f, 0x0e, 0x47, 0xe3, 0x97, 0xdd, 0x51, 0x6f, 0xaf, 0x55, 0x20, 0x37, 0xa1, 0x93,
+	0xa5, 0xc8, 0xb8, 0x57, 0xdc, 0xfd, 0xdb, 0x26, 0xac, 0x77, 0x59, 0x34, 0x0d, 0xe8, 0xab, 0x1e,
+	0xde, 0x9d, 0xf8, 0xac, 0x76, 0x0f, 0x6a, 0xd8, 0xfd, 0x0c, 0xc5, 0x13, 0x86, 0xee, 0xef, 0x54,
+	0x3f, 0xd4, 0xc9, 0xe9, 0xa4, 0xc9, 0x3d, 0xa8, 0xbc, 0x14, 0x8f, 0xc4, 0x44, 0x3f, 0x94, 0x48,
+	0x30, 0xa6, 0xec, 0xdd, 0x82, 0xc5, 0xbc, 0xb3, 0x96, 0x45, 0x93, 0x87, 0x00, 0xe9, 0xc3, 0x31,
+	0x49, 0xae, 0x1c, 0x7c, 0x88, 0xea, 0x5c, 0x37, 0x67, 0x33, 0xf3, 0x65, 0x79, 0x07, 0x1a, 0xcf,
+	0x19, 0x4f, 0x5f, 0x40, 0xb3, 0x6c, 0x17, 0x9e, 0x71, 0xc9, 0x5d, 0xf5, 0x5c, 0x8a, 0xec, 0x4b,
+	0xc4, 0x1b, 0x26, 0xb1, 0x3c, 0xc2, 0x8f, 0xa1, 0x85, 0x25, 0xce, 0x18, 0x40, 0x63, 0xa2, 0xc9,
+	0xd2, 0x67, 0x89, 0xce, 0xb5, 0x8b, 0x83, 0x2a, 0xae, 0x92, 0x6f, 0x60, 0x23, 0x61, 0x4f, 0x26,
+	0xdf, 0x1c, 0xfe, 0x76, 0xde, 0xe4, 0x29, 0x24, 0xdc, 0x83, 0xf5, 0x44, 0xc2, 0x90, 0x47, 0xcc,
+	0x9e, 0x2f, 0x19, 0x9d, 0x19, 0xb7, 0xef, 0x17, 0xc8, 0xd7, 0x70, 0xfd, 0x82, 0xca, 0x5c, 0xc6,
+	0xdc, 0x69, 0xf7, 0x7e, 0x81, 0xdc, 0x85, 0xea, 0x73, 0x26, 0xf9, 0x49, 0xce, 0xb6, 0x66, 0x15,
+	0x92, 0x2f, 0xa1, 0xa5, 0xa9, 0xd3, 0xd1, 0x3e, 0x87, 0x2b, 0x57, 0x1b, 0x79, 0x2c, 0x36, 0x2f,
+	0x79, 0xb3, 0x20, 0xd7, 0x96, 0x1f, 0x36, 0x54, 0x7c, 0xae, 0x5e, 0xc4, 0xe3, 0xbb, 0xca, 0x6d,
+	0x28, 0x3f, 0x67, 0x7c, 0x74, 0x94, 0xab, 0x31, 0x9d, 0x74, 0xc9, 0x2e, 0x80, 0x56, 0x73, 0x09,
+	0x71, 0x2b, 0x21, 0x1e, 0xf8, 0xd2, 0xb1, 0xfb, 0x82, 0x87, 0x32, 0x87, 0x79, 0x21, 0xcf, 0xe5,
+	0xd1, 0xe9, 0xab, 0x69, 0xb6, 0xa1, 0xf2, 0x9c, 0xf1, 0xee, 0x93, 0x41, 0x2e, 0x35, 0x28, 0x1c,
+	0xae, 0x6f, 0x43, 0x65, 0xc8, 0x7c, 0x77, 0x74, 0x44, 0x52, 0x23, 0x3b, 0x79, 0x33, 0x3d, 0xb9,
+	0x09, 0x95, 0xa1, 0x37, 0xf5, 0xb3, 0x94, 0xe9, 0x27, 0xb9, 0x03, 0x55, 0x59, 0x10, 0xf2, 0x65,
+	0x65, 0x9e, 0x01, 0x76, 0xa1, 0x2a, 0x65, 0x8f, 0x8e, 0x48, 0x33, 0xa1, 0xc5, 0x64, 0x49, 0x4e,
+	0xd7, 0x85, 0x97, 0x07, 0x99, 0x0c, 0xf2, 0xcc, 0xff, 0xa7, 0x64, 0x90, 0x14, 0x5f, 0x89, 0x64,
+	0x10, 0xdf, 0x5d, 0xdf, 0x7d, 0x15, 0x05, 0xc1, 0x24, 0x39, 0xfb, 0xd9, 0x37, 0xdd, 0xce, 0x95,
+	0x2c, 0x5a, 0xd2, 0xde, 0x87, 0x66, 0x2f, 0x62, 0xc8, 0x2d, 0xb1, 0x24, 0x7d, 0x6a, 0x94, 0x0f,
+	0x0f, 0x9d, 0xa5, 0x77, 0x04, 0x72, 0x0f, 0xea, 0x18, 0x73, 0x09, 0xc5, 0x4b, 0x19, 0x4e, 0xb2,
+	0xc4, 0xc2, 0xa1, 0x1d, 0xa8, 0xef, 0x07, 0xce, 0xdb, 0x1f, 0xac, 0xe0, 0x3e, 0x34, 0x5f, 0xfb,
+	0xb3, 0x1f, 0xc3, 0xf1, 0x08, 0x9a, 0xf2, 0x41, 0x43, 0x23, 0xb4, 0xab, 0xe6, 0x33, 0x47, 0x1e,
+	0x57, 0xff, 0xcc, 0xe4, 0xba, 0xa0, 0x27, 0xaf, 0xc4, 0x7e, 0x09, 0x57, 0x33, 0x5c, 0x2f, 0xd4,
+	0xdb, 0xc5, 0x0f, 0xe3, 0x7e, 0x00, 0x4d, 0x71, 0xfb, 0xea, 0xbe, 0x25, 0x09, 0x9f, 0xc0, 0xe6,
+	0xb2, 0x7c, 0x0d, 0x24, 0xc3, 0x22, 0xf7, 0x7d, 0xc3, 0xcc, 0x02, 0xc9, 0x7c, 0xed, 0x02, 0x4a,
+	0x6e, 0xf1, 0x3d, 0x91, 0x50, 0x62, 0xa0, 0x24, 0xe6, 0x7b, 0xbb, 0x1a, 0x2f, 0x3b, 0xe6, 0xe3,
+	0xb2, 0xda, 0x30, 0x64, 0x78, 0x23, 0x06, 0xf2, 0x0d, 0x63, 0x48, 0x5f, 0xa2, 0x4f, 0xe6, 0xfa,
+	0x6f, 0x60, 0x3d, 0xcd, 0x08, 0xc9, 0xb6, 0x9c, 0x82, 0xb2, 0x8b, 0xe9, 0x5c, 0xcb, 0xa2, 0x93,
+	0xd7, 0x86, 0x87, 0xe2, 0xe4, 0xeb, 0xa7, 0x9f, 0x4b, 0x98, 0x97, 0x1e, 0x38, 0xc8, 0xa7, 0x22,
+	0x11, 0x93, 0x29, 0xdd, 0x9c, 0xcb, 0x3b, 0xeb, 0x06, 0x20, 0x56, 0x1f, 0xc9, 0xa2, 0x2e, 0x86,
+	0x29, 0x55, 0x9b, 0xb5, 0x6b, 0xcf, 0xbc, 0x19, 0x97, 0x93, 0x6a, 0x27, 0x33, 0x73, 0xdd, 0x2f,
+	0x90, 0x07, 0xf2, 0x25, 0x5c, 0x80, 0x71, 0x1e, 0x43, 0xcb, 0x64, 0x10, 0xc1, 0x78, 0x04, 0x4d,
+	0x74, 0x25, 0x9d, 0xab, 0x35, 0x49, 0x32, 0x8a, 0x27, 0x97, 0x9e, 0x41, 0xf4, 0x33, 0x71, 0x8c,
+	0xb3, 0x93, 0x5d, 0xfe, 0xdd, 0x91, 0xa5, 0xf9, 0x15, 0x5c, 0x79, 0xce, 0xf8, 0x85, 0xe9, 0xac,
+	0xa3, 0x59, 0x2f, 0xce, 0x77, 0x9d, 0xeb, 0x97, 0xac, 0x91, 0x67, 0x70, 0x55, 0xda, 0x30, 0xe9,
+	0x9d, 0xd8, 0xfe, 0x94, 0xbd, 0x8a, 0x82, 0xa9, 0x6c, 0x95, 0x73, 0xaa, 0xd0, 0x07, 0xc6, 0x64,
+	0xbc, 0x44, 0xbe, 0x0b, 0x4d, 0x71, 0xcf, 0xea, 0xc9, 0x63, 0xc9, 0x91, 0xe5, 0x39, 0x45, 0x44,
+	0xed, 0x73, 0x58, 0x13, 0xba, 0x5d, 0xb6, 0xe7, 0xe1, 0x71, 0x3a, 0xcf, 0x55, 0x4a, 0x12, 0xa5,
+	0x29, 0xdd, 0x53, 0xd8, 0x94, 0x25, 0x77, 0x69, 0xb6, 0xb9, 0x9a, 0x1a, 0x68, 0xa0, 0x3b, 0xf9,
+	0x68, 0xf2, 0x15, 0x6c, 0x48, 0xdf, 0x4d, 0x64, 0x9e, 0x09, 0x97, 0xf0, 0x7f, 0x03, 0xc4, 0xe0,
+	0xd7, 0xd3, 0x48, 0x9e, 0x80, 0x6b, 0x4b, 0x02, 0x34, 0xed, 0x17, 0xd0, 0xca, 0x1e, 0xf3, 0x6f,
+	0xf7, 0x93, 0x92, 0xa2, 0x7b, 0xf6, 0xce, 0xd5, 0x25, 0x84, 0xbc, 0x36, 0x8e, 0x2b, 0xe2, 0x37,
+	0x80, 0x87, 0xff, 0x1e, 0x00, 0xe5, 0x33, 0xb7, 0xcc, 0x6c, 0x20, 0x00, 0x00,
 }
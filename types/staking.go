@@ -5,3 +5,7 @@ import "math/big"
 func (s *Staking) GetAmountBigInt() *big.Int {
 	return new(big.Int).SetBytes(s.GetAmount())
 }
+
+func (u *Unbonding) GetAmountBigInt() *big.Int {
+	return new(big.Int).SetBytes(u.GetAmount())
+}
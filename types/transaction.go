@@ -17,6 +17,9 @@ import (
 const SetContractOwner = "v1setOwner"
 const NameCreate = "v1createName"
 const NameUpdate = "v1updateName"
+const NameRenew = "v1renewName"
+const NameSetReverse = "v1setReverse"
+const NameSetSub = "v1setSubName"
 
 const TxMaxSize = 200 * 1024
 
@@ -49,7 +52,7 @@ type Transaction interface {
 	GetBody() *TxBody
 	GetHash() []byte
 	CalculateTxHash() []byte
-	Validate([]byte, bool) error
+	Validate([]byte, bool, int32) error
 	ValidateWithSenderState(senderState *State, gasPrice *big.Int, version int32) error
 	HasVerifedAccount() bool
 	GetVerifedAccount() Address
@@ -88,7 +91,7 @@ func (tx *transaction) CalculateTxHash() []byte {
 	return tx.Tx.CalculateTxHash()
 }
 
-func (tx *transaction) Validate(chainidhash []byte, isPublic bool) error {
+func (tx *transaction) Validate(chainidhash []byte, isPublic bool, version int32) error {
 	if tx.GetTx() == nil || tx.GetTx().GetBody() == nil {
 		return ErrTxFormatInvalid
 	}
@@ -128,7 +131,9 @@ func (tx *transaction) Validate(chainidhash []byte, isPublic bool) error {
 
 	switch tx.GetBody().Type {
 	case TxType_REDEPLOY:
-		if isPublic {
+		// since V3, an upgradeable contract can be redeployed on a public
+		// chain, which is checked on execution
+		if isPublic && version < 3 {
 			return ErrTxInvalidType
 		}
 		if tx.GetBody().GetRecipient() == nil {
@@ -188,7 +193,9 @@ func ValidateSystemTx(tx *TxBody) error {
 	op := GetOpSysTx(ci.Name)
 	switch op {
 	case Opstake,
-		Opunstake:
+		Opunstake,
+		Opunbond,
+		Opwithdraw:
 	case OpvoteBP:
 		unique := map[string]int{}
 		for i, v := range ci.Args {
@@ -227,6 +234,22 @@ func ValidateSystemTx(tx *TxBody) error {
 			}
 			unique[encoded]++
 		}
+	case OpcreateProposal:
+		if len(ci.Args) != 6 {
+			return fmt.Errorf("the number of args should be 6")
+		}
+		for _, v := range ci.Args {
+			if _, ok := v.(string); !ok {
+				return ErrTxInvalidPayload
+			}
+		}
+	case OpexecuteProposal:
+		if len(ci.Args) != 1 {
+			return fmt.Errorf("the number of args should be 1")
+		}
+		if _, ok := ci.Args[0].(string); !ok {
+			return ErrTxInvalidPayload
+		}
 	default:
 		return ErrTxInvalidPayload
 	}
@@ -260,6 +283,32 @@ func validateNameTx(tx *TxBody) error {
 		if len(to) > AddressLength {
 			return fmt.Errorf("too long name %s", string(tx.GetPayload()))
 		}
+	case NameRenew, NameSetReverse:
+		if err := _validateNameTx(tx, &ci); err != nil {
+			return err
+		}
+		if len(ci.Args) != 1 {
+			return fmt.Errorf("invalid arguments in %s", ci)
+		}
+	case NameSetSub:
+		if len(ci.Args) != 2 && len(ci.Args) != 3 {
+			return fmt.Errorf("invalid arguments in %s", ci)
+		}
+		for _, arg := range ci.Args {
+			if _, ok := arg.(string); !ok {
+				return fmt.Errorf("invalid arguments in %s", ci)
+			}
+		}
+		if err := validateSubName(ci.Args[0].(string)); err != nil {
+			return err
+		}
+		// names are not resolved for the destination and the owner of a subname
+		for _, arg := range ci.Args[1:] {
+			addr, err := DecodeAddress(arg.(string))
+			if err != nil || len(addr) != AddressLength {
+				return fmt.Errorf("invalid address in %s", ci)
+			}
+		}
 	case SetContractOwner:
 		owner, ok := ci.Args[0].(string)
 		if !ok {
@@ -296,6 +345,27 @@ func _validateNameTx(tx *TxBody, ci *CallInfo) error {
 	return nil
 }
 
+// validateSubName checks that every label of the subname is made of allowed
+// characters and that it ends with a name of NameLength.
+func validateSubName(subname string) error {
+	if !IsSubName([]byte(subname)) {
+		return fmt.Errorf("invalid subname %s", subname)
+	}
+	labels := strings.Split(subname, ".")
+	for _, label := range labels {
+		if len(label) == 0 {
+			return fmt.Errorf("empty label in %s", subname)
+		}
+		if err := validateAllowedChar([]byte(label)); err != nil {
+			return err
+		}
+	}
+	if len(labels[len(labels)-1]) != NameLength {
+		return fmt.Errorf("not supported yet")
+	}
+	return nil
+}
+
 func (tx *transaction) ValidateWithSenderState(senderState *State, gasPrice *big.Int, version int32) error {
 	if (senderState.GetNonce() + 1) > tx.GetBody().GetNonce() {
 		return ErrTxNonceTooLow
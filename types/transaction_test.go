@@ -37,76 +37,103 @@ func TestGovernanceTypeTransaction(t *testing.T) {
 	transaction := NewTransaction(tx)
 
 	transaction.GetBody().ChainIdHash = fakechainid
-	err = transaction.Validate(chainid, false)
+	err = transaction.Validate(chainid, false, 0)
 	assert.EqualError(t, ErrTxInvalidChainIdHash, err.Error(), "invalid chainid")
 
 	transaction.GetBody().ChainIdHash = chainid
-	err = transaction.Validate(chainid, false)
+	err = transaction.Validate(chainid, false, 0)
 	assert.EqualError(t, ErrTxHasInvalidHash, err.Error(), "empty hash")
 
 	transaction.GetTx().Hash = transaction.CalculateTxHash()
-	err = transaction.Validate(chainid, false)
+	err = transaction.Validate(chainid, false, 0)
 	assert.EqualError(t, ErrTxInvalidRecipient, err.Error(), "recipient null")
 
 	transaction.GetTx().GetBody().Recipient = tx.Body.Payload
 	transaction.GetTx().Hash = transaction.CalculateTxHash()
-	err = transaction.Validate(chainid, false)
+	err = transaction.Validate(chainid, false, 0)
 	assert.EqualError(t, ErrTxInvalidRecipient, err.Error(), "wrong recipient case")
 
 	transaction.GetTx().GetBody().Recipient = recipient
 	transaction.GetTx().Hash = transaction.CalculateTxHash()
-	err = transaction.Validate(chainid, false)
+	err = transaction.Validate(chainid, false, 0)
 	assert.EqualError(t, ErrTxInvalidRecipient, err.Error(), "recipient should be aergo.*")
 
 	transaction.GetTx().GetBody().Recipient = []byte(AergoSystem)
 	transaction.GetTx().Hash = transaction.CalculateTxHash()
-	err = transaction.Validate(chainid, false)
+	err = transaction.Validate(chainid, false, 0)
 	assert.NoError(t, err, "should success")
 
 	transaction.GetTx().GetBody().Amount = StakingMinimum.Bytes()
 	transaction.GetTx().Hash = transaction.CalculateTxHash()
-	err = transaction.Validate(chainid, false)
+	err = transaction.Validate(chainid, false, 0)
 	assert.NoError(t, err, "should success")
 
 	transaction.GetTx().GetBody().Payload = buildVoteBPPayloadEx(2, TestInvalidString)
 	transaction.GetTx().Hash = transaction.CalculateTxHash()
-	err = transaction.Validate(chainid, false)
+	err = transaction.Validate(chainid, false, 0)
 	assert.EqualError(t, err, ErrTxInvalidPayload.Error(), "invalid string")
 
 	transaction.GetTx().GetBody().Payload = buildVoteBPPayloadEx(2, TestInvalidPeerID)
 	transaction.GetTx().Hash = transaction.CalculateTxHash()
-	err = transaction.Validate(chainid, false)
+	err = transaction.Validate(chainid, false, 0)
 	assert.EqualError(t, err, ErrTxInvalidPayload.Error(), "invalid peer id")
 
 	transaction.GetTx().GetBody().Payload = buildVoteBPPayloadEx(2, TestDuplicatePeerID)
 	transaction.GetTx().Hash = transaction.CalculateTxHash()
-	err = transaction.Validate(chainid, false)
+	err = transaction.Validate(chainid, false, 0)
 	assert.EqualError(t, err, ErrTxInvalidPayload.Error(), "dup peer id")
 
 	transaction.GetTx().GetBody().Payload = buildVoteBPPayloadEx(2, TestNormal)
 	transaction.GetTx().Hash = transaction.CalculateTxHash()
-	err = transaction.Validate(chainid, false)
+	err = transaction.Validate(chainid, false, 0)
 	t.Log(string(transaction.GetTx().GetBody().Payload))
 	assert.NoError(t, err, "should success")
 
 	transaction.GetTx().GetBody().Recipient = []byte(`aergo.name`)
 	transaction.GetTx().GetBody().Payload = []byte(`{"Name":"v1createName", "Args":["1"]}`)
 	transaction.GetTx().Hash = transaction.CalculateTxHash()
-	err = transaction.Validate(chainid, false)
+	err = transaction.Validate(chainid, false, 0)
 	assert.Error(t, err, "invalid name length in create")
 
 	transaction.GetTx().GetBody().Payload = []byte(`{"Name":"v1updateName", "Args":["1234567890","AmPNYHyzyh9zweLwDyuoiUuTVCdrdksxkRWDjVJS76WQLExa2Jr4"]}`)
 	transaction.GetTx().Hash = transaction.CalculateTxHash()
-	err = transaction.Validate(chainid, false)
+	err = transaction.Validate(chainid, false, 0)
 	assert.Error(t, err, "invalid name length in update")
 
+	transaction.GetTx().GetBody().Payload = []byte(`{"Name":"v1setSubName", "Args":["shop.ab1234567890","ab0987654321"]}`)
+	transaction.GetTx().Hash = transaction.CalculateTxHash()
+	err = transaction.Validate(chainid, false, 0)
+	assert.Error(t, err, "name as the destination of subname")
+
 	transaction.GetTx().GetBody().Payload = []byte(`{"Name":"v1voteDAO", "Args":["BPCOUNT","3", "3"]}`)
 	transaction.GetTx().Hash = transaction.CalculateTxHash()
-	err = transaction.Validate(chainid, false)
+	err = transaction.Validate(chainid, false, 0)
 	assert.Error(t, err, "duplicate arguments")
 
 }
 
+func TestRedeployTransaction(t *testing.T) {
+	account, err := DecodeAddress("AmPNYHyzyh9zweLwDyuoiUuTVCdrdksxkRWDjVJS76WQLExa2Jr4")
+	assert.NoError(t, err, "should success to decode test address")
+	recipient, err := DecodeAddress("AmNhXiU3s2BN26v5B5hT2bbEjvSjqyrBY7DGnD9UqVcwkTrDYyJN")
+	assert.NoError(t, err, "should success to decode test address")
+	chainid := []byte("chainid")
+	transaction := NewTransaction(&Tx{
+		Body: &TxBody{
+			Account:     account,
+			Recipient:   recipient,
+			Payload:     []byte("code"),
+			Type:        TxType_REDEPLOY,
+			ChainIdHash: chainid,
+		},
+	})
+	transaction.GetTx().Hash = transaction.CalculateTxHash()
+
+	assert.NoError(t, transaction.Validate(chainid, false, 2), "private chain")
+	assert.Equal(t, ErrTxInvalidType, transaction.Validate(chainid, true, 2), "public chain before v3")
+	assert.NoError(t, transaction.Validate(chainid, true, 3), "public chain since v3")
+}
+
 func buildVoteBPPayloadEx(count int, err int) []byte {
 	var ci CallInfo
 	ci.Name = OpvoteBP.Cmd()
@@ -57,6 +57,14 @@ const (
 	Opstake
 	// Opunstake represents a unstaking tranaction.
 	Opunstake
+	// Opunbond represents a unstaking request entering the unbonding queue.
+	Opunbond
+	// Opwithdraw represents a withdrawal of the released unbonding requests.
+	Opwithdraw
+	// OpcreateProposal represents a creation of a governance proposal.
+	OpcreateProposal
+	// OpexecuteProposal represents an execution of an approved governance proposal.
+	OpexecuteProposal
 	// OpSysTxMax is the maximum of system tx OP numbers.
 	OpSysTxMax
 